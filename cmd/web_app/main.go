@@ -25,7 +25,14 @@ import (
 //	@BasePath	/api/v1
 
 //	@securitydefinitions.oauth2.password	OAuth2Password
-//	@tokenUrl								https://example.com/oauth/token
+//	@tokenUrl								/api/v1/oauth/token
+//	@scope.read								Grants read access
+//	@scope.write							Grants write access
+//	@scope.admin							Grants read and write access to administrative information
+
+//	@securitydefinitions.oauth2.accessCode	OAuth2AccessCode
+//	@tokenUrl								/api/v1/oauth/token
+//	@authorizationUrl						/api/v1/oauth/authorize
 //	@scope.read								Grants read access
 //	@scope.write							Grants write access
 //	@scope.admin							Grants read and write access to administrative information
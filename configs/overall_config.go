@@ -2,11 +2,23 @@ package configs
 
 import (
 	"io/ioutil"
+	"os"
+	"strings"
+	"time"
 
+	"github.com/joho/godotenv"
 	"gopkg.in/yaml.v2"
 )
 
+// Environment variables holding secrets, they take precedence over secret files
+const (
+	EmailVerificationSecretEnv = "EMAIL_VERIFICATION_SECRET"
+)
+
 func parseConfig(cfgPath string) (*Config, error) {
+	// Secrets can be set in the .env file, which is optional
+	_ = godotenv.Load()
+
 	yamlFile, err := ioutil.ReadFile(cfgPath)
 	if err != nil {
 		return &Config{}, err
@@ -25,7 +37,15 @@ func GetWebConfig(cfgPath string) (*WebConfig, error) {
 	if err != nil {
 		return &WebConfig{}, err
 	}
-	return &config.Web, nil
+	cfg := &config.Web
+	for i := range cfg.Token.SigningKeys {
+		key := &cfg.Token.SigningKeys[i]
+		key.Secret, err = loadSecret(key.SecretEnv, key.SecretFile)
+		if err != nil {
+			return &WebConfig{}, err
+		}
+	}
+	return cfg, nil
 }
 
 func GetNewsfeedPublishingConfig(cfgPath string) (*NewsfeedPublishingConfig, error) {
@@ -44,12 +64,40 @@ func GetNewsfeedConfig(cfgPath string) (*NewsfeedConfig, error) {
 	return &config.Newsfeed, nil
 }
 
+func GetMessengerConfig(cfgPath string) (*MessengerConfig, error) {
+	config, err := parseConfig(cfgPath)
+	if err != nil {
+		return &MessengerConfig{}, err
+	}
+	return &config.Messenger, nil
+}
+
 func GetAuthenticateAndPostConfig(cfgPath string) (*AuthenticateAndPostConfig, error) {
 	config, err := parseConfig(cfgPath)
 	if err != nil {
 		return &AuthenticateAndPostConfig{}, err
 	}
-	return &config.AuthenticateAndPost, nil
+	cfg := &config.AuthenticateAndPost
+	cfg.EmailVerification.Secret, err = loadSecret(EmailVerificationSecretEnv, cfg.EmailVerification.SecretFile)
+	if err != nil {
+		return &AuthenticateAndPostConfig{}, err
+	}
+	return cfg, nil
+}
+
+// loadSecret reads a secret from the environment variable env, or from file when env is not set
+func loadSecret(env string, file string) (string, error) {
+	if secret := os.Getenv(env); env != "" && secret != "" {
+		return secret, nil
+	}
+	if file == "" {
+		return "", nil
+	}
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
 }
 
 type Config struct {
@@ -58,6 +106,7 @@ type Config struct {
 	AuthenticateAndPost AuthenticateAndPostConfig `yaml:"authenticate_and_post_config"`
 	Newsfeed            NewsfeedConfig            `yaml:"newsfeed_config"`
 	NewsfeedPublishing  NewsfeedPublishingConfig  `yaml:"newsfeed_publishing_config"`
+	Messenger           MessengerConfig           `yaml:"messenger_config"`
 	Web                 WebConfig                 `yaml:"web_config"`
 }
 
@@ -80,11 +129,108 @@ type KafkaConfig struct {
 }
 
 type AuthenticateAndPostConfig struct {
-	Port               int          `yaml:"port"`
-	Logger             LoggerConfig `yaml:"logger"`
-	MySQL              MySQLConfig  `yaml:"mysql"`
-	Redis              RedisConfig  `yaml:"redis"`
-	NewsfeedPublishing HostConfig   `yaml:"newsfeed_publishing"`
+	Port               int                     `yaml:"port"`
+	Logger             LoggerConfig            `yaml:"logger"`
+	MySQL              MySQLConfig             `yaml:"mysql"`
+	Redis              RedisConfig             `yaml:"redis"`
+	NewsfeedPublishing HostConfig              `yaml:"newsfeed_publishing"`
+	MaxPinnedPosts     int                     `yaml:"max_pinned_posts"`
+	LinkPreview        LinkPreviewConfig       `yaml:"link_preview"`
+	Mail               MailConfig              `yaml:"mail"`
+	EmailVerification  EmailVerificationConfig `yaml:"email_verification"`
+	PasswordReset      PasswordResetConfig     `yaml:"password_reset"`
+	PasswordHashing    PasswordHashingConfig   `yaml:"password_hashing"`
+	LoginLimiter       LoginLimiterConfig      `yaml:"login_limiter"`
+	TwoFactor          TwoFactorConfig         `yaml:"two_factor"`
+	AccountDeletion    AccountDeletionConfig   `yaml:"account_deletion"`
+	DataExport         DataExportConfig        `yaml:"data_export"`
+	Suggestions        SuggestionsConfig       `yaml:"suggestions"`
+	UserNameChange     UserNameChangeConfig    `yaml:"user_name_change"`
+}
+
+type LinkPreviewConfig struct {
+	Enabled     bool          `yaml:"enabled"`
+	Timeout     time.Duration `yaml:"timeout"`
+	MaxBodySize int64         `yaml:"max_body_size"`
+}
+
+type MailConfig struct {
+	Type string     `yaml:"type"`
+	From string     `yaml:"from"`
+	Dir  string     `yaml:"dir"`
+	SMTP SMTPConfig `yaml:"smtp"`
+}
+
+type SMTPConfig struct {
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+}
+
+type EmailVerificationConfig struct {
+	// Secret is read from EMAIL_VERIFICATION_SECRET or SecretFile, never from configuration files
+	Secret         string        `yaml:"-"`
+	SecretFile     string        `yaml:"secret_file"`
+	TokenLifetime  time.Duration `yaml:"token_lifetime"`
+	VerifyURL      string        `yaml:"verify_url"`
+	RequiredToPost bool          `yaml:"required_to_post"`
+}
+
+type PasswordResetConfig struct {
+	TokenLifetime time.Duration `yaml:"token_lifetime"`
+	ResetURL      string        `yaml:"reset_url"`
+}
+
+type PasswordHashingConfig struct {
+	Algorithm  string       `yaml:"algorithm"` // argon2id, bcrypt-sha256
+	Argon2     Argon2Config `yaml:"argon2"`
+	BcryptCost int          `yaml:"bcrypt_cost"`
+}
+
+type Argon2Config struct {
+	Memory      uint32 `yaml:"memory"` // in KiB
+	Iterations  uint32 `yaml:"iterations"`
+	Parallelism uint8  `yaml:"parallelism"`
+}
+
+type LoginLimiterConfig struct {
+	Window             time.Duration `yaml:"window"`
+	MaxFailuresPerUser int           `yaml:"max_failures_per_user"`
+	MaxFailuresPerIP   int           `yaml:"max_failures_per_ip"`
+	BaseLockout        time.Duration `yaml:"base_lockout"`
+	MaxLockout         time.Duration `yaml:"max_lockout"`
+}
+
+type TwoFactorConfig struct {
+	// Issuer is the account issuer shown by authenticator apps
+	Issuer string `yaml:"issuer"`
+}
+
+type AccountDeletionConfig struct {
+	// GracePeriod is how long the deletion of an account can be cancelled
+	GracePeriod   time.Duration `yaml:"grace_period"`
+	PurgeInterval time.Duration `yaml:"purge_interval"`
+}
+
+type DataExportConfig struct {
+	WorkerInterval time.Duration `yaml:"worker_interval"`
+	// ArchiveLifetime is how long a ready archive is kept
+	ArchiveLifetime      time.Duration `yaml:"archive_lifetime"`
+	DownloadLinkLifetime time.Duration `yaml:"download_link_lifetime"`
+}
+
+type SuggestionsConfig struct {
+	RefreshInterval time.Duration `yaml:"refresh_interval"`
+	// Size is the number of follow suggestions kept for each user
+	Size int `yaml:"size"`
+}
+
+type UserNameChangeConfig struct {
+	// Cooldown is the minimum time between two changes of the user name of an user
+	Cooldown time.Duration `yaml:"cooldown"`
+	// RedirectGracePeriod is how long a previous user name redirects to its user and can not be taken by others
+	RedirectGracePeriod time.Duration `yaml:"redirect_grace_period"`
 }
 
 type NewsfeedConfig struct {
@@ -97,12 +243,47 @@ type NewsfeedConfig struct {
 }
 
 type WebConfig struct {
-	Port                int          `yaml:"port"`
-	Logger              LoggerConfig `yaml:"logger"`
-	APIVersions         []string     `yaml:"api_version"`
-	AuthenticateAndPost HostConfig   `yaml:"authenticate_and_post"`
-	Newsfeed            HostConfig   `yaml:"newsfeed"`
-	Redis               RedisConfig  `yaml:"redis"`
+	Port                int           `yaml:"port"`
+	Logger              LoggerConfig  `yaml:"logger"`
+	APIVersions         []string      `yaml:"api_version"`
+	AuthenticateAndPost HostConfig    `yaml:"authenticate_and_post"`
+	Newsfeed            HostConfig    `yaml:"newsfeed"`
+	Messenger           HostConfig    `yaml:"messenger"`
+	Redis               RedisConfig   `yaml:"redis"`
+	Session             SessionConfig `yaml:"session"`
+	Token               TokenConfig   `yaml:"token"`
+	OAuth               OAuthConfig   `yaml:"oauth"`
+	// TrustedProxies are the addresses or CIDR ranges of the reverse proxies in front of the web app,
+	// client IPs are only read from X-Forwarded-For headers set by them
+	TrustedProxies []string `yaml:"trusted_proxies"`
+	// AllowedOrigins are the origins of the frontend, the only ones allowed to make credentialed
+	// cross-origin requests and to approve OAuth2 authorization requests
+	AllowedOrigins []string `yaml:"allowed_origins"`
+}
+
+type TokenConfig struct {
+	AccessTokenLifetime  time.Duration      `yaml:"access_token_lifetime"`
+	RefreshTokenLifetime time.Duration      `yaml:"refresh_token_lifetime"`
+	CurrentKid           string             `yaml:"current_kid"`
+	SigningKeys          []SigningKeyConfig `yaml:"signing_keys"`
+}
+
+// SigningKeyConfig is a key signing access tokens, its secret is read from the environment
+// variable SecretEnv or from SecretFile, never from configuration files
+type SigningKeyConfig struct {
+	Kid        string `yaml:"kid"`
+	Secret     string `yaml:"-"`
+	SecretEnv  string `yaml:"secret_env"`
+	SecretFile string `yaml:"secret_file"`
+}
+
+type OAuthConfig struct {
+	AuthorizationCodeLifetime time.Duration `yaml:"authorization_code_lifetime"`
+}
+
+type SessionConfig struct {
+	IdleTimeout time.Duration `yaml:"idle_timeout"`
+	MaxLifetime time.Duration `yaml:"max_lifetime"`
 }
 
 type NewsfeedPublishingConfig struct {
@@ -113,6 +294,12 @@ type NewsfeedPublishingConfig struct {
 	AuthenticateAndPost HostConfig   `yaml:"authenticate_and_post"`
 }
 
+type MessengerConfig struct {
+	Port   int          `yaml:"port"`
+	Logger LoggerConfig `yaml:"logger"`
+	MySQL  MySQLConfig  `yaml:"mysql"`
+}
+
 type HostConfig struct {
 	Hosts []string `yaml:"hosts"`
 }
@@ -0,0 +1,318 @@
+package authen_and_post_svc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultAccountDeletionGracePeriod   = 30 * 24 * time.Hour
+	defaultAccountDeletionPurgeInterval = time.Hour
+	accountDeletionBatchSize            = 20
+)
+
+func (a *AuthenticateAndPostService) DeleteUser(ctx context.Context, info *pb_aap.DeleteUserRequest) (*pb_aap.DeleteUserResponse, error) {
+	a.logger.Debug("start scheduling user deletion")
+	defer a.logger.Debug("end scheduling user deletion")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.DeleteUserResponse{Status: pb_aap.DeleteUserResponse_USER_NOT_FOUND}, nil
+	}
+	if user.DeletionScheduledAt.Valid {
+		return &pb_aap.DeleteUserResponse{
+			Status:              pb_aap.DeleteUserResponse_ALREADY_SCHEDULED,
+			DeletionScheduledAt: timestamppb.New(user.DeletionScheduledAt.Time),
+		}, nil
+	}
+
+	// The password is asked again, wrong passwords count as failed logins of the user
+	limitKeys := loginLimitKeys(user.UserName, "")
+	if !a.checkLoginLocked(ctx, limitKeys).IsZero() {
+		return &pb_aap.DeleteUserResponse{Status: pb_aap.DeleteUserResponse_WRONG_PASSWORD}, nil
+	}
+	match, _, err := a.passwordHasher.Verify(user.HashedPassword, info.GetUserPassword(), user.Salt)
+	if err != nil {
+		return nil, err
+	}
+	if !match {
+		a.recordLoginFailure(ctx, limitKeys)
+		return &pb_aap.DeleteUserResponse{Status: pb_aap.DeleteUserResponse_WRONG_PASSWORD}, nil
+	}
+
+	enabled, ok, err := a.checkSecondFactorLimited(ctx, info.GetUserId(), info.GetOtpCode())
+	if err != nil {
+		return nil, err
+	}
+	if enabled && info.GetOtpCode() == "" {
+		return &pb_aap.DeleteUserResponse{Status: pb_aap.DeleteUserResponse_TWO_FACTOR_REQUIRED}, nil
+	}
+	if enabled && !ok {
+		return &pb_aap.DeleteUserResponse{Status: pb_aap.DeleteUserResponse_WRONG_OTP_CODE}, nil
+	}
+
+	deletionScheduledAt := time.Now().Add(a.accountDeletionGracePeriod())
+	err = a.db.Model(&user).Update("deletion_scheduled_at", sql.NullTime{Time: deletionScheduledAt, Valid: true}).Error
+	if err != nil {
+		return nil, err
+	}
+	a.redisClient.Del(ctx, fmt.Sprintf("user:%d", user.ID))
+
+	return &pb_aap.DeleteUserResponse{
+		Status:              pb_aap.DeleteUserResponse_OK,
+		DeletionScheduledAt: timestamppb.New(deletionScheduledAt),
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) CancelUserDeletion(ctx context.Context, info *pb_aap.CancelUserDeletionRequest) (*pb_aap.CancelUserDeletionResponse, error) {
+	a.logger.Debug("start cancelling user deletion")
+	defer a.logger.Debug("end cancelling user deletion")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.CancelUserDeletionResponse{Status: pb_aap.CancelUserDeletionResponse_USER_NOT_FOUND}, nil
+	}
+	if !user.DeletionScheduledAt.Valid {
+		return &pb_aap.CancelUserDeletionResponse{Status: pb_aap.CancelUserDeletionResponse_NOT_SCHEDULED}, nil
+	}
+
+	err := a.db.Model(&user).Update("deletion_scheduled_at", sql.NullTime{}).Error
+	if err != nil {
+		return nil, err
+	}
+	a.redisClient.Del(ctx, fmt.Sprintf("user:%d", user.ID))
+
+	return &pb_aap.CancelUserDeletionResponse{Status: pb_aap.CancelUserDeletionResponse_OK}, nil
+}
+
+// runAccountDeletion periodically purges the accounts whose grace period is over
+func (a *AuthenticateAndPostService) runAccountDeletion() {
+	interval := a.cfg.AccountDeletion.PurgeInterval
+	if interval <= 0 {
+		interval = defaultAccountDeletionPurgeInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := a.purgeScheduledUsers()
+		if err != nil {
+			a.logger.Error(err.Error())
+		}
+		<-ticker.C
+	}
+}
+
+// purgeScheduledUsers purges due accounts in batches
+func (a *AuthenticateAndPostService) purgeScheduledUsers() error {
+	for {
+		var users []types.User
+		err := a.db.Where("deletion_scheduled_at <= ?", time.Now()).Limit(accountDeletionBatchSize).Find(&users).Error
+		if err != nil {
+			return err
+		}
+		for _, user := range users {
+			err = a.purgeUser(user)
+			if err != nil {
+				return err
+			}
+		}
+		if len(users) < accountDeletionBatchSize {
+			return nil
+		}
+	}
+}
+
+// purgeUser deletes an user with everything it owns: posts with their comments, likes, polls and link previews,
+// its own comments, likes, votes and bookmarks, stories, data export, follow edges, audience lists, conversations, two-factor data, media and cached data
+func (a *AuthenticateAndPostService) purgeUser(user types.User) error {
+	userId := int64(user.ID)
+
+	var stories []types.Story
+	err := a.db.Where("user_id = ?", userId).Find(&stories).Error
+	if err != nil {
+		return err
+	}
+	if len(stories) > 0 {
+		err = a.deleteStories(stories)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = a.deleteUserDataExport(userId)
+	if err != nil {
+		return err
+	}
+
+	var posts []types.Post
+	var postsIds, touchedPostsIds, followersIds, followingsIds []int64
+	err = a.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Where("user_id = ?", userId).Find(&posts).Error
+		if err != nil {
+			return err
+		}
+		for _, post := range posts {
+			postsIds = append(postsIds, int64(post.ID))
+		}
+
+		// Posts of other users whose caches contain data of the user
+		var ids []int64
+		err = tx.Raw("select post_id from comment where user_id = ? union select post_id from `like` where user_id = ? union select post_id from poll_vote where user_id = ?",
+			userId, userId, userId).Scan(&ids).Error
+		if err != nil {
+			return err
+		}
+		touchedPostsIds = append(ids, postsIds...)
+
+		err = tx.Raw("select follower_id from following where user_id = ?", userId).Scan(&followersIds).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Raw("select user_id from following where follower_id = ?", userId).Scan(&followingsIds).Error
+		if err != nil {
+			return err
+		}
+
+		// Rows referencing posts of the user or the user itself, children first
+		statements := []string{
+			"delete from poll_vote_option where user_id = ? or post_id in (select id from post where user_id = ?)",
+			"delete from poll_vote where user_id = ? or post_id in (select id from post where user_id = ?)",
+			"delete from poll_option where post_id in (select id from post where user_id = ?)",
+			"delete from poll where post_id in (select id from post where user_id = ?)",
+			"delete from `like` where user_id = ? or post_id in (select id from post where user_id = ?)",
+			"delete from comment where user_id = ? or post_id in (select id from post where user_id = ?)",
+			"delete from bookmark where user_id = ? or post_id in (select id from post where user_id = ?)",
+			"delete from pinned_post where user_id = ?",
+			"delete from link_preview where post_id in (select id from post where user_id = ?)",
+			"delete from story_view where user_id = ?",
+			"delete from following where user_id = ? or follower_id = ?",
+			"delete from follow_request where user_id = ? or follower_id = ?",
+			"delete from block where user_id = ? or blocked_user_id = ?",
+			"delete from mute where user_id = ? or muted_user_id = ?",
+			"delete from audience_list_member where user_id = ? or audience_list_id in (select id from audience_list where user_id = ?)",
+			"delete from message where conversation_id in (select conversation_id from conversation_member where user_id = ?)",
+			"delete from conversation_member where conversation_id in (select id from conversation where first_user_id = ? or second_user_id = ?)",
+			"delete from conversation where first_user_id = ? or second_user_id = ?",
+			"delete from recovery_code where user_id = ?",
+			"delete from two_factor where user_id = ?",
+			"delete from user_name_history where user_id = ?",
+			"delete from post where user_id = ?",
+			"delete from audience_list where user_id = ?",
+			"delete from `user` where id = ?",
+		}
+		for _, statement := range statements {
+			args := make([]interface{}, strings.Count(statement, "?"))
+			for i := range args {
+				args[i] = userId
+			}
+			err = tx.Exec(statement, args...).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		// Counters of the users the user followed or was followed by
+		err = a.updateUserCounter(tx, "followings_count", -1, followersIds...)
+		if err != nil {
+			return err
+		}
+		return a.updateUserCounter(tx, "followers_count", -1, followingsIds...)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Media
+	imgPaths := []string{user.ProfilePicture, user.CoverPicture}
+	for _, post := range posts {
+		imgPaths = append(imgPaths, strings.Split(post.ContentImagePath, " ")...)
+	}
+	a.deleteImages(userId, imgPaths)
+	err = a.db.Where("user_id = ?", userId).Delete(&types.UploadedImage{}).Error
+	if err != nil {
+		a.logger.Error(err.Error())
+	}
+
+	a.purgeUserCache(userId, postsIds, touchedPostsIds, followersIds, followingsIds)
+	a.updateUserSearchIndex(context.Background(), &user, nil)
+	a.logger.Info(fmt.Sprintf("purged user %d", userId))
+	return nil
+}
+
+// purgeUserCache removes the cached data of a purged user, including its sessions and tokens
+func (a *AuthenticateAndPostService) purgeUserCache(userId int64, postsIds, touchedPostsIds, followersIds, followingsIds []int64) {
+	ctx := context.Background()
+
+	keys := []string{
+		fmt.Sprintf("user:%d", userId),
+		fmt.Sprintf("followers:%d", userId),
+		fmt.Sprintf("followings:%d", userId),
+		fmt.Sprintf("newsfeed:%d", userId),
+		fmt.Sprintf("password_reset_user:%d", userId),
+		fmt.Sprintf("suggestions:%d", userId),
+	}
+	for _, postId := range touchedPostsIds {
+		keys = append(keys,
+			fmt.Sprintf("post:%d", postId),
+			fmt.Sprintf("comments_ids:%d", postId),
+			fmt.Sprintf("liked_users_ids:%d", postId),
+			fmt.Sprintf("poll_votes:%d", postId),
+		)
+	}
+	a.redisClient.Del(ctx, keys...)
+
+	// Follow edges and newsfeeds of the other users
+	var postsMembers []interface{}
+	for _, postId := range postsIds {
+		postsMembers = append(postsMembers, postId)
+	}
+	for _, followerId := range followersIds {
+		a.redisClient.LRem(ctx, fmt.Sprintf("followings:%d", followerId), 0, userId)
+		if len(postsMembers) > 0 {
+			a.redisClient.ZRem(ctx, fmt.Sprintf("newsfeed:%d", followerId), postsMembers...)
+		}
+	}
+	for _, followingId := range followingsIds {
+		a.redisClient.LRem(ctx, fmt.Sprintf("followers:%d", followingId), 0, userId)
+	}
+
+	// Sessions, refresh tokens and OAuth2 clients of the web service
+	userSessionsKey := fmt.Sprintf("user_sessions:%d", userId)
+	for _, sessionId := range a.redisClient.SMembers(ctx, userSessionsKey).Val() {
+		a.redisClient.Del(ctx, fmt.Sprintf("session:%s", sessionId))
+	}
+	userFamiliesKey := fmt.Sprintf("user_refresh_families:%d", userId)
+	for _, family := range a.redisClient.SMembers(ctx, userFamiliesKey).Val() {
+		familyKey := fmt.Sprintf("refresh_family:%s", family)
+		tokenHash, err := a.redisClient.Get(ctx, familyKey).Result()
+		if err == nil {
+			a.redisClient.Del(ctx, fmt.Sprintf("refresh_token:%s", tokenHash))
+		}
+		a.redisClient.Del(ctx, familyKey)
+	}
+	userClientsKey := fmt.Sprintf("user_oauth_clients:%d", userId)
+	for _, clientId := range a.redisClient.SMembers(ctx, userClientsKey).Val() {
+		a.redisClient.Del(ctx, fmt.Sprintf("oauth_client:%s", clientId))
+	}
+	a.redisClient.Del(ctx, userSessionsKey, userFamiliesKey, userClientsKey)
+
+	// Access tokens issued before are rejected by the web service
+	generationKey := fmt.Sprintf("token_generation:%d", userId)
+	a.redisClient.Incr(ctx, generationKey)
+}
+
+func (a *AuthenticateAndPostService) accountDeletionGracePeriod() time.Duration {
+	if a.cfg.AccountDeletion.GracePeriod <= 0 {
+		return defaultAccountDeletionGracePeriod
+	}
+	return a.cfg.AccountDeletion.GracePeriod
+}
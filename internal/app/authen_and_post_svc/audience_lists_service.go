@@ -0,0 +1,230 @@
+package authen_and_post_svc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func (a *AuthenticateAndPostService) CreateAudienceList(ctx context.Context, info *pb_aap.CreateAudienceListRequest) (*pb_aap.CreateAudienceListResponse, error) {
+	a.logger.Debug("start creating audience list")
+	defer a.logger.Debug("end creating audience list")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.CreateAudienceListResponse{Status: pb_aap.CreateAudienceListResponse_USER_NOT_FOUND}, nil
+	}
+
+	// Names are unique among the lists of an user
+	var count int64
+	err := a.db.Model(&types.AudienceList{}).Where("user_id = ? AND name = ?", info.GetUserId(), info.GetName()).Count(&count).Error
+	if err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return &pb_aap.CreateAudienceListResponse{Status: pb_aap.CreateAudienceListResponse_ALREADY_EXISTS}, nil
+	}
+
+	audienceList := types.AudienceList{
+		UserID: info.GetUserId(),
+		Name:   info.GetName(),
+	}
+	err = a.db.Create(&audienceList).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.CreateAudienceListResponse{
+		Status:         pb_aap.CreateAudienceListResponse_OK,
+		AudienceListId: int64(audienceList.ID),
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) DeleteAudienceList(ctx context.Context, info *pb_aap.DeleteAudienceListRequest) (*pb_aap.DeleteAudienceListResponse, error) {
+	a.logger.Debug("start deleting audience list")
+	defer a.logger.Debug("end deleting audience list")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.DeleteAudienceListResponse{Status: pb_aap.DeleteAudienceListResponse_USER_NOT_FOUND}, nil
+	}
+	exist, audienceList := a.findAudienceList(info.GetUserId(), info.GetAudienceListId())
+	if !exist {
+		return &pb_aap.DeleteAudienceListResponse{Status: pb_aap.DeleteAudienceListResponse_LIST_NOT_FOUND}, nil
+	}
+
+	// The list is soft deleted, posts shared with it stay visible to their author only
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("audience_list_id = ?", audienceList.ID).Delete(&types.AudienceListMember{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Delete(&audienceList).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.DeleteAudienceListResponse{Status: pb_aap.DeleteAudienceListResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) GetAudienceLists(ctx context.Context, info *pb_aap.GetAudienceListsRequest) (*pb_aap.GetAudienceListsResponse, error) {
+	a.logger.Debug("start getting audience lists")
+	defer a.logger.Debug("end getting audience lists")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetAudienceListsResponse{Status: pb_aap.GetAudienceListsResponse_USER_NOT_FOUND}, nil
+	}
+
+	var audienceLists []types.AudienceList
+	err := a.db.Where("user_id = ?", info.GetUserId()).Order("created_at").Find(&audienceLists).Error
+	if err != nil {
+		return nil, err
+	}
+	var membersCounts []struct {
+		AudienceListID int64
+		Count          int64
+	}
+	err = a.db.Raw("select audience_list_member.audience_list_id, count(*) as count from audience_list_member "+
+		"join audience_list on audience_list.id = audience_list_member.audience_list_id "+
+		"where audience_list.user_id = ? group by audience_list_member.audience_list_id", info.GetUserId()).Scan(&membersCounts).Error
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[int64]int64)
+	for _, membersCount := range membersCounts {
+		counts[membersCount.AudienceListID] = membersCount.Count
+	}
+
+	var result []*pb_aap.AudienceListInfo
+	for _, audienceList := range audienceLists {
+		result = append(result, newAudienceListInfo(audienceList, counts[int64(audienceList.ID)]))
+	}
+
+	return &pb_aap.GetAudienceListsResponse{
+		Status:        pb_aap.GetAudienceListsResponse_OK,
+		AudienceLists: result,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) GetAudienceList(ctx context.Context, info *pb_aap.GetAudienceListRequest) (*pb_aap.GetAudienceListResponse, error) {
+	a.logger.Debug("start getting audience list")
+	defer a.logger.Debug("end getting audience list")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetAudienceListResponse{Status: pb_aap.GetAudienceListResponse_USER_NOT_FOUND}, nil
+	}
+	exist, audienceList := a.findAudienceList(info.GetUserId(), info.GetAudienceListId())
+	if !exist {
+		return &pb_aap.GetAudienceListResponse{Status: pb_aap.GetAudienceListResponse_LIST_NOT_FOUND}, nil
+	}
+
+	var membersIds []int64
+	err := a.db.Model(&types.AudienceListMember{}).Where("audience_list_id = ?", audienceList.ID).
+		Order("created_at").Pluck("user_id", &membersIds).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.GetAudienceListResponse{
+		Status:       pb_aap.GetAudienceListResponse_OK,
+		AudienceList: newAudienceListInfo(audienceList, int64(len(membersIds))),
+		MembersIds:   membersIds,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) AddAudienceListMember(ctx context.Context, info *pb_aap.AddAudienceListMemberRequest) (*pb_aap.AddAudienceListMemberResponse, error) {
+	a.logger.Debug("start adding audience list member")
+	defer a.logger.Debug("end adding audience list member")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.AddAudienceListMemberResponse{Status: pb_aap.AddAudienceListMemberResponse_USER_NOT_FOUND}, nil
+	}
+	exist, _ = a.findUserById(info.GetMemberId())
+	if !exist {
+		return &pb_aap.AddAudienceListMemberResponse{Status: pb_aap.AddAudienceListMemberResponse_USER_NOT_FOUND}, nil
+	}
+	exist, audienceList := a.findAudienceList(info.GetUserId(), info.GetAudienceListId())
+	if !exist {
+		return &pb_aap.AddAudienceListMemberResponse{Status: pb_aap.AddAudienceListMemberResponse_LIST_NOT_FOUND}, nil
+	}
+
+	err := a.db.Create(&types.AudienceListMember{
+		CreatedAt:      time.Now(),
+		AudienceListID: int64(audienceList.ID),
+		UserID:         info.GetMemberId(),
+	}).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return &pb_aap.AddAudienceListMemberResponse{Status: pb_aap.AddAudienceListMemberResponse_ALREADY_MEMBER}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.AddAudienceListMemberResponse{Status: pb_aap.AddAudienceListMemberResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) RemoveAudienceListMember(ctx context.Context, info *pb_aap.RemoveAudienceListMemberRequest) (*pb_aap.RemoveAudienceListMemberResponse, error) {
+	a.logger.Debug("start removing audience list member")
+	defer a.logger.Debug("end removing audience list member")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.RemoveAudienceListMemberResponse{Status: pb_aap.RemoveAudienceListMemberResponse_USER_NOT_FOUND}, nil
+	}
+	exist, audienceList := a.findAudienceList(info.GetUserId(), info.GetAudienceListId())
+	if !exist {
+		return &pb_aap.RemoveAudienceListMemberResponse{Status: pb_aap.RemoveAudienceListMemberResponse_LIST_NOT_FOUND}, nil
+	}
+
+	result := a.db.Where("audience_list_id = ? AND user_id = ?", audienceList.ID, info.GetMemberId()).Delete(&types.AudienceListMember{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &pb_aap.RemoveAudienceListMemberResponse{Status: pb_aap.RemoveAudienceListMemberResponse_NOT_MEMBER}, nil
+	}
+
+	return &pb_aap.RemoveAudienceListMemberResponse{Status: pb_aap.RemoveAudienceListMemberResponse_OK}, nil
+}
+
+// findAudienceList checks if an audience list with provided id is owned by userId
+func (a *AuthenticateAndPostService) findAudienceList(userId int64, audienceListId int64) (exist bool, audienceList types.AudienceList) {
+	result := a.db.Where("user_id = ?", userId).First(&audienceList, audienceListId)
+	if result.Error != nil {
+		return false, types.AudienceList{}
+	}
+	return true, audienceList
+}
+
+// canViewAudience checks if viewerId can read a post of authorId shared with audienceListId
+func (a *AuthenticateAndPostService) canViewAudience(viewerId int64, authorId int64, audienceListId int64) (bool, error) {
+	if viewerId == authorId {
+		return true, nil
+	}
+	if viewerId == 0 {
+		return false, nil
+	}
+	var count int64
+	err := a.db.Model(&types.AudienceListMember{}).Where("audience_list_id = ? AND user_id = ?", audienceListId, viewerId).Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func newAudienceListInfo(audienceList types.AudienceList, membersCount int64) *pb_aap.AudienceListInfo {
+	return &pb_aap.AudienceListInfo{
+		AudienceListId: int64(audienceList.ID),
+		Name:           audienceList.Name,
+		MembersCount:   membersCount,
+		CreatedAt:      timestamppb.New(audienceList.CreatedAt),
+	}
+}
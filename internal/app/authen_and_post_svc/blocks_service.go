@@ -0,0 +1,174 @@
+package authen_and_post_svc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+	"gorm.io/gorm"
+)
+
+func (a *AuthenticateAndPostService) BlockUser(ctx context.Context, info *pb_aap.BlockUserRequest) (*pb_aap.BlockUserResponse, error) {
+	a.logger.Debug("start blocking user")
+	defer a.logger.Debug("end blocking user")
+
+	if info.GetUserId() == info.GetBlockedUserId() {
+		return &pb_aap.BlockUserResponse{Status: pb_aap.BlockUserResponse_NOT_ALLOWED}, nil
+	}
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.BlockUserResponse{Status: pb_aap.BlockUserResponse_USER_NOT_FOUND}, nil
+	}
+	exist, _ = a.findUserById(info.GetBlockedUserId())
+	if !exist {
+		return &pb_aap.BlockUserResponse{Status: pb_aap.BlockUserResponse_USER_NOT_FOUND}, nil
+	}
+
+	// Blocking removes follow edges and pending follow requests both ways
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&types.Block{
+			CreatedAt:     time.Now(),
+			UserID:        info.GetUserId(),
+			BlockedUserID: info.GetBlockedUserId(),
+		}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Exec("delete from follow_request where (user_id = ? and follower_id = ?) or (user_id = ? and follower_id = ?)",
+			info.GetUserId(), info.GetBlockedUserId(), info.GetBlockedUserId(), info.GetUserId()).Error
+		if err != nil {
+			return err
+		}
+		for _, pair := range [][2]int64{{info.GetUserId(), info.GetBlockedUserId()}, {info.GetBlockedUserId(), info.GetUserId()}} {
+			result := tx.Exec("delete from following where user_id = ? and follower_id = ?", pair[0], pair[1])
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				continue
+			}
+			err = a.updateUserCounter(tx, "followers_count", -1, pair[0])
+			if err != nil {
+				return err
+			}
+			err = a.updateUserCounter(tx, "followings_count", -1, pair[1])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return &pb_aap.BlockUserResponse{Status: pb_aap.BlockUserResponse_ALREADY_BLOCKED}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Update cache
+	for _, pair := range [][2]int64{{info.GetUserId(), info.GetBlockedUserId()}, {info.GetBlockedUserId(), info.GetUserId()}} {
+		a.redisClient.LRem(ctx, fmt.Sprintf("followings:%d", pair[0]), 0, pair[1])
+		a.redisClient.LRem(ctx, fmt.Sprintf("followers:%d", pair[0]), 0, pair[1])
+	}
+
+	return &pb_aap.BlockUserResponse{Status: pb_aap.BlockUserResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) UnblockUser(ctx context.Context, info *pb_aap.UnblockUserRequest) (*pb_aap.UnblockUserResponse, error) {
+	a.logger.Debug("start unblocking user")
+	defer a.logger.Debug("end unblocking user")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.UnblockUserResponse{Status: pb_aap.UnblockUserResponse_USER_NOT_FOUND}, nil
+	}
+
+	result := a.db.Where("user_id = ? AND blocked_user_id = ?", info.GetUserId(), info.GetBlockedUserId()).Delete(&types.Block{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &pb_aap.UnblockUserResponse{Status: pb_aap.UnblockUserResponse_NOT_BLOCKED}, nil
+	}
+
+	return &pb_aap.UnblockUserResponse{Status: pb_aap.UnblockUserResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) MuteUser(ctx context.Context, info *pb_aap.MuteUserRequest) (*pb_aap.MuteUserResponse, error) {
+	a.logger.Debug("start muting user")
+	defer a.logger.Debug("end muting user")
+
+	if info.GetUserId() == info.GetMutedUserId() {
+		return &pb_aap.MuteUserResponse{Status: pb_aap.MuteUserResponse_NOT_ALLOWED}, nil
+	}
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.MuteUserResponse{Status: pb_aap.MuteUserResponse_USER_NOT_FOUND}, nil
+	}
+	exist, _ = a.findUserById(info.GetMutedUserId())
+	if !exist {
+		return &pb_aap.MuteUserResponse{Status: pb_aap.MuteUserResponse_USER_NOT_FOUND}, nil
+	}
+
+	err := a.db.Create(&types.Mute{
+		CreatedAt:   time.Now(),
+		UserID:      info.GetUserId(),
+		MutedUserID: info.GetMutedUserId(),
+	}).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return &pb_aap.MuteUserResponse{Status: pb_aap.MuteUserResponse_ALREADY_MUTED}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.MuteUserResponse{Status: pb_aap.MuteUserResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) UnmuteUser(ctx context.Context, info *pb_aap.UnmuteUserRequest) (*pb_aap.UnmuteUserResponse, error) {
+	a.logger.Debug("start unmuting user")
+	defer a.logger.Debug("end unmuting user")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.UnmuteUserResponse{Status: pb_aap.UnmuteUserResponse_USER_NOT_FOUND}, nil
+	}
+
+	result := a.db.Where("user_id = ? AND muted_user_id = ?", info.GetUserId(), info.GetMutedUserId()).Delete(&types.Mute{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &pb_aap.UnmuteUserResponse{Status: pb_aap.UnmuteUserResponse_NOT_MUTED}, nil
+	}
+
+	return &pb_aap.UnmuteUserResponse{Status: pb_aap.UnmuteUserResponse_OK}, nil
+}
+
+// isBlocked checks if one of two users blocked the other
+func (a *AuthenticateAndPostService) isBlocked(userId int64, otherUserId int64) (bool, error) {
+	var count int64
+	err := a.db.Model(&types.Block{}).
+		Where("(user_id = ? AND blocked_user_id = ?) OR (user_id = ? AND blocked_user_id = ?)", userId, otherUserId, otherUserId, userId).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// findBlockedUsersIds returns the users blocked by or blocking an user
+func (a *AuthenticateAndPostService) findBlockedUsersIds(userId int64) (map[int64]bool, error) {
+	var ids []int64
+	err := a.db.Raw("select blocked_user_id from block where user_id = ? union select user_id from block where blocked_user_id = ?", userId, userId).
+		Scan(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+	blockedUsersIds := make(map[int64]bool)
+	for _, id := range ids {
+		blockedUsersIds[id] = true
+	}
+	return blockedUsersIds, nil
+}
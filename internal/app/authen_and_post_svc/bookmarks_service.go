@@ -0,0 +1,122 @@
+package authen_and_post_svc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+	"gorm.io/gorm"
+)
+
+func (a *AuthenticateAndPostService) SavePost(ctx context.Context, info *pb_aap.SavePostRequest) (*pb_aap.SavePostResponse, error) {
+	a.logger.Debug("start saving post")
+	defer a.logger.Debug("end saving post")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.SavePostResponse{Status: pb_aap.SavePostResponse_USER_NOT_FOUND}, nil
+	}
+	exist, post := a.findPostById(info.GetPostId())
+	if !exist {
+		return &pb_aap.SavePostResponse{Status: pb_aap.SavePostResponse_POST_NOT_FOUND}, nil
+	}
+	// Posts the user can not see can not be saved
+	blocked, err := a.isBlocked(info.GetUserId(), post.UserID)
+	if err != nil {
+		return nil, err
+	}
+	canView, err := a.canViewPost(info.GetUserId(), &post)
+	if err != nil {
+		return nil, err
+	}
+	if blocked || !canView {
+		return &pb_aap.SavePostResponse{Status: pb_aap.SavePostResponse_POST_NOT_FOUND}, nil
+	}
+
+	err = a.db.Create(&types.Bookmark{
+		UserID: info.GetUserId(),
+		PostID: info.GetPostId(),
+	}).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return &pb_aap.SavePostResponse{Status: pb_aap.SavePostResponse_ALREADY_SAVED}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.SavePostResponse{
+		Status: pb_aap.SavePostResponse_OK,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) UnsavePost(ctx context.Context, info *pb_aap.UnsavePostRequest) (*pb_aap.UnsavePostResponse, error) {
+	a.logger.Debug("start unsaving post")
+	defer a.logger.Debug("end unsaving post")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.UnsavePostResponse{Status: pb_aap.UnsavePostResponse_USER_NOT_FOUND}, nil
+	}
+
+	result := a.db.Where(&types.Bookmark{UserID: info.GetUserId(), PostID: info.GetPostId()}).Delete(&types.Bookmark{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &pb_aap.UnsavePostResponse{Status: pb_aap.UnsavePostResponse_NOT_SAVED}, nil
+	}
+
+	return &pb_aap.UnsavePostResponse{
+		Status: pb_aap.UnsavePostResponse_OK,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) ListSavedPosts(ctx context.Context, info *pb_aap.ListSavedPostsRequest) (*pb_aap.ListSavedPostsResponse, error) {
+	a.logger.Debug("start listing saved posts")
+	defer a.logger.Debug("end listing saved posts")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.ListSavedPostsResponse{Status: pb_aap.ListSavedPostsResponse_USER_NOT_FOUND}, nil
+	}
+
+	// Bookmarks are returned newest first, the cursor is the id of the last bookmark of previous page.
+	// Bookmarks of deleted posts and of posts which became invisible to the user are skipped:
+	// posts of users blocked by or blocking the user, of private accounts the user does not follow
+	// and shared with audience lists the user is not a member of.
+	limit := pageLimit(info.GetLimit())
+	query := a.db.Table("bookmark").
+		Select("bookmark.id, bookmark.post_id").
+		Joins("join post on post.id = bookmark.post_id").
+		Joins("join `user` on `user`.id = post.user_id").
+		Where("bookmark.user_id = ? and post.deleted_at is null", info.GetUserId()).
+		Where("post.user_id not in (select blocked_user_id from block where user_id = ?) and "+
+			"post.user_id not in (select user_id from block where blocked_user_id = ?)", info.GetUserId(), info.GetUserId()).
+		Where("(`user`.is_private = false or post.user_id = ? or "+
+			"post.user_id in (select user_id from following where follower_id = ?))", info.GetUserId(), info.GetUserId()).
+		Where("(post.audience_list_id is null or post.user_id = ? or "+
+			"post.audience_list_id in (select audience_list_id from audience_list_member where user_id = ?))", info.GetUserId(), info.GetUserId())
+	if info.GetCursor() > 0 {
+		query = query.Where("bookmark.id < ?", info.GetCursor())
+	}
+	var bookmarks []types.Bookmark
+	err := query.Order("bookmark.id desc").Limit(limit).Scan(&bookmarks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var postsIds []int64
+	for _, bookmark := range bookmarks {
+		postsIds = append(postsIds, bookmark.PostID)
+	}
+	var nextCursor int64
+	if len(bookmarks) == limit {
+		nextCursor = int64(bookmarks[len(bookmarks)-1].ID)
+	}
+
+	return &pb_aap.ListSavedPostsResponse{
+		Status:     pb_aap.ListSavedPostsResponse_OK,
+		PostsIds:   postsIds,
+		NextCursor: nextCursor,
+	}, nil
+}
@@ -0,0 +1,487 @@
+package authen_and_post_svc
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultDataExportWorkerInterval       = time.Minute
+	defaultDataExportArchiveLifetime      = 7 * 24 * time.Hour
+	defaultDataExportDownloadLinkLifetime = 15 * time.Minute
+	dataExportBatchSize                   = 10
+)
+
+// Entries of the personal data archive
+type exportedProfile struct {
+	UserID         int64  `json:"user_id"`
+	UserName       string `json:"user_name"`
+	FirstName      string `json:"first_name"`
+	LastName       string `json:"last_name"`
+	DateOfBirth    string `json:"date_of_birth,omitempty"`
+	Email          string `json:"email"`
+	EmailVerified  bool   `json:"email_verified"`
+	ProfilePicture string `json:"profile_picture,omitempty"`
+	CoverPicture   string `json:"cover_picture,omitempty"`
+	Bio            string `json:"bio,omitempty"`
+	Location       string `json:"location,omitempty"`
+	Website        string `json:"website,omitempty"`
+	Pronouns       string `json:"pronouns,omitempty"`
+	CreatedAt      string `json:"created_at"`
+}
+
+type exportedPost struct {
+	PostID      int64    `json:"post_id"`
+	CreatedAt   string   `json:"created_at"`
+	ContentText string   `json:"content_text"`
+	Media       []string `json:"media,omitempty"`
+}
+
+type exportedComment struct {
+	CommentID   int64  `json:"comment_id"`
+	PostID      int64  `json:"post_id"`
+	CreatedAt   string `json:"created_at"`
+	ContentText string `json:"content_text"`
+}
+
+type exportedLike struct {
+	PostID    int64  `json:"post_id"`
+	CreatedAt string `json:"created_at"`
+}
+
+type exportedUser struct {
+	UserID   int64  `json:"user_id"`
+	UserName string `json:"user_name"`
+}
+
+func (a *AuthenticateAndPostService) RequestDataExport(ctx context.Context, info *pb_aap.RequestDataExportRequest) (*pb_aap.RequestDataExportResponse, error) {
+	a.logger.Debug("start requesting data export")
+	defer a.logger.Debug("end requesting data export")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.RequestDataExportResponse{Status: pb_aap.RequestDataExportResponse_USER_NOT_FOUND}, nil
+	}
+	exist, dataExport := a.findDataExportByUserId(info.GetUserId())
+	if exist && dataExport.Status == types.DataExportPending {
+		return &pb_aap.RequestDataExportResponse{
+			Status: pb_aap.RequestDataExportResponse_ALREADY_PENDING,
+			Export: a.newDataExportInfo(dataExport),
+		}, nil
+	}
+
+	// A new export replaces the previous archive
+	if exist && dataExport.ObjectKey != "" {
+		a.deleteDataExportArchive(dataExport.ObjectKey)
+	}
+	dataExport = types.DataExport{
+		UserID:    info.GetUserId(),
+		CreatedAt: time.Now(),
+		Status:    types.DataExportPending,
+	}
+	err := a.db.Save(&dataExport).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.RequestDataExportResponse{
+		Status: pb_aap.RequestDataExportResponse_OK,
+		Export: a.newDataExportInfo(dataExport),
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) GetDataExport(ctx context.Context, info *pb_aap.GetDataExportRequest) (*pb_aap.GetDataExportResponse, error) {
+	a.logger.Debug("start getting data export")
+	defer a.logger.Debug("end getting data export")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetDataExportResponse{Status: pb_aap.GetDataExportResponse_USER_NOT_FOUND}, nil
+	}
+	exist, dataExport := a.findDataExportByUserId(info.GetUserId())
+	if !exist || (dataExport.ExpiresAt.Valid && !dataExport.ExpiresAt.Time.After(time.Now())) {
+		return &pb_aap.GetDataExportResponse{Status: pb_aap.GetDataExportResponse_NOT_FOUND}, nil
+	}
+
+	return &pb_aap.GetDataExportResponse{
+		Status: pb_aap.GetDataExportResponse_OK,
+		Export: a.newDataExportInfo(dataExport),
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) findDataExportByUserId(userId int64) (bool, types.DataExport) {
+	var dataExport types.DataExport
+	result := a.db.Where("user_id = ?", userId).First(&dataExport)
+	if result.Error != nil {
+		return false, types.DataExport{}
+	}
+	return true, dataExport
+}
+
+// newDataExportInfo converts an export, ready exports get a new presigned download link
+func (a *AuthenticateAndPostService) newDataExportInfo(dataExport types.DataExport) *pb_aap.DataExportInfo {
+	info := &pb_aap.DataExportInfo{
+		State:     pb_aap.DataExportInfo_PENDING,
+		CreatedAt: timestamppb.New(dataExport.CreatedAt),
+	}
+	if dataExport.Status == types.DataExportFailed {
+		info.State = pb_aap.DataExportInfo_FAILED
+	}
+	if dataExport.CompletedAt.Valid {
+		info.CompletedAt = timestamppb.New(dataExport.CompletedAt.Time)
+	}
+	if dataExport.Status != types.DataExportReady {
+		return info
+	}
+
+	info.State = pb_aap.DataExportInfo_READY
+	info.ExpiresAt = timestamppb.New(dataExport.ExpiresAt.Time)
+	lifetime := a.cfg.DataExport.DownloadLinkLifetime
+	if lifetime <= 0 {
+		lifetime = defaultDataExportDownloadLinkLifetime
+	}
+	if remaining := time.Until(dataExport.ExpiresAt.Time); remaining < lifetime {
+		lifetime = remaining
+	}
+	req, _ := a.s3Client.GetObjectRequest(&s3.GetObjectInput{
+		Bucket:                     aws.String(os.Getenv("AWS_S3_BUCKET")),
+		Key:                        aws.String(dataExport.ObjectKey),
+		ResponseContentDisposition: aws.String(`attachment; filename="social-network-data.zip"`),
+	})
+	url, err := req.Presign(lifetime)
+	if err != nil {
+		a.logger.Error(err.Error())
+		return info
+	}
+	info.DownloadUrl = url
+	info.DownloadUrlExpiresAt = timestamppb.New(time.Now().Add(lifetime))
+	return info
+}
+
+// runDataExports periodically builds the pending archives and deletes the expired ones
+func (a *AuthenticateAndPostService) runDataExports() {
+	interval := a.cfg.DataExport.WorkerInterval
+	if interval <= 0 {
+		interval = defaultDataExportWorkerInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := a.buildPendingDataExports()
+		if err != nil {
+			a.logger.Error(err.Error())
+		}
+		err = a.deleteExpiredDataExports()
+		if err != nil {
+			a.logger.Error(err.Error())
+		}
+		<-ticker.C
+	}
+}
+
+func (a *AuthenticateAndPostService) buildPendingDataExports() error {
+	var dataExports []types.DataExport
+	err := a.db.Where("status = ?", types.DataExportPending).Order("created_at").Limit(dataExportBatchSize).Find(&dataExports).Error
+	if err != nil {
+		return err
+	}
+
+	for _, dataExport := range dataExports {
+		status := types.DataExportReady
+		objectKey, err := a.buildDataExport(dataExport.UserID)
+		if err != nil {
+			a.logger.Error("failed to export user data", zap.Int64("user_id", dataExport.UserID), zap.Error(err))
+			status = types.DataExportFailed
+		}
+
+		now := time.Now()
+		updates := map[string]interface{}{
+			"status":       status,
+			"object_key":   objectKey,
+			"completed_at": sql.NullTime{Time: now, Valid: true},
+		}
+		if status == types.DataExportReady {
+			updates["expires_at"] = sql.NullTime{Time: now.Add(a.dataExportArchiveLifetime()), Valid: true}
+		}
+		// The export could have been removed meanwhile with its user, then the archive is dropped
+		result := a.db.Model(&types.DataExport{}).
+			Where("user_id = ? AND status = ? AND created_at = ?", dataExport.UserID, types.DataExportPending, dataExport.CreatedAt).
+			Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 && objectKey != "" {
+			a.deleteDataExportArchive(objectKey)
+		}
+	}
+	return nil
+}
+
+// deleteExpiredDataExports deletes the archives which can not be downloaded anymore
+func (a *AuthenticateAndPostService) deleteExpiredDataExports() error {
+	var dataExports []types.DataExport
+	err := a.db.Where("expires_at <= ?", time.Now()).Find(&dataExports).Error
+	if err != nil {
+		return err
+	}
+	for _, dataExport := range dataExports {
+		a.deleteDataExportArchive(dataExport.ObjectKey)
+		err = a.db.Where("user_id = ? AND created_at = ?", dataExport.UserID, dataExport.CreatedAt).Delete(&types.DataExport{}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildDataExport writes the archive of an user to S3 and returns its key
+func (a *AuthenticateAndPostService) buildDataExport(userId int64) (string, error) {
+	file, err := os.CreateTemp("", "data-export-*.zip")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	err = a.writeDataExport(file, userId)
+	if err != nil {
+		return "", err
+	}
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return "", err
+	}
+
+	keyBytes := make([]byte, 16)
+	_, err = rand.Read(keyBytes)
+	if err != nil {
+		return "", err
+	}
+	objectKey := fmt.Sprintf("data-export-%d-%s.zip", userId, hex.EncodeToString(keyBytes))
+	_, err = a.s3Client.PutObject(&s3.PutObjectInput{
+		Bucket:      aws.String(os.Getenv("AWS_S3_BUCKET")),
+		Key:         aws.String(objectKey),
+		Body:        file,
+		ContentType: aws.String("application/zip"),
+	})
+	if err != nil {
+		return "", err
+	}
+	return objectKey, nil
+}
+
+// writeDataExport writes the ZIP archive of an user: JSON files of its profile, posts, comments, likes,
+// followers and followings, and the media of its profile and posts under media/
+func (a *AuthenticateAndPostService) writeDataExport(w io.Writer, userId int64) error {
+	exist, user := a.findUserById(userId)
+	if !exist {
+		return errors.New("user not found")
+	}
+
+	var posts []types.Post
+	err := a.db.Where("user_id = ?", userId).Order("created_at").Find(&posts).Error
+	if err != nil {
+		return err
+	}
+	var comments []types.Comment
+	err = a.db.Where("user_id = ?", userId).Order("created_at").Find(&comments).Error
+	if err != nil {
+		return err
+	}
+	var likes []types.Like
+	err = a.db.Raw("select post_id, created_at from `like` where user_id = ? order by created_at", userId).Scan(&likes).Error
+	if err != nil {
+		return err
+	}
+	var followers, followings []exportedUser
+	err = a.db.Raw("select u.id as user_id, u.user_name from following f join `user` u on u.id = f.follower_id where f.user_id = ?", userId).Scan(&followers).Error
+	if err != nil {
+		return err
+	}
+	err = a.db.Raw("select u.id as user_id, u.user_name from following f join `user` u on u.id = f.user_id where f.follower_id = ?", userId).Scan(&followings).Error
+	if err != nil {
+		return err
+	}
+
+	archive := zip.NewWriter(w)
+	var media []string
+
+	profile := exportedProfile{
+		UserID:         int64(user.ID),
+		UserName:       user.UserName,
+		FirstName:      user.FirstName,
+		LastName:       user.LastName,
+		Email:          user.Email,
+		EmailVerified:  user.EmailVerifiedAt.Valid,
+		ProfilePicture: exportedMediaName(user.ProfilePicture),
+		CoverPicture:   exportedMediaName(user.CoverPicture),
+		Bio:            user.Bio,
+		Location:       user.Location,
+		Website:        user.Website,
+		Pronouns:       user.Pronouns,
+		CreatedAt:      user.CreatedAt.Format(time.RFC3339),
+	}
+	if user.DateOfBirth.Valid {
+		profile.DateOfBirth = user.DateOfBirth.Time.Format(time.DateOnly)
+	}
+	media = append(media, user.ProfilePicture, user.CoverPicture)
+
+	exportedPosts := []exportedPost{}
+	for _, post := range posts {
+		exported := exportedPost{
+			PostID:      int64(post.ID),
+			CreatedAt:   post.CreatedAt.Format(time.RFC3339),
+			ContentText: post.ContentText,
+		}
+		for _, path := range strings.Split(post.ContentImagePath, " ") {
+			if path == "" {
+				continue
+			}
+			exported.Media = append(exported.Media, exportedMediaName(path))
+			media = append(media, path)
+		}
+		exportedPosts = append(exportedPosts, exported)
+	}
+	exportedComments := []exportedComment{}
+	for _, comment := range comments {
+		exportedComments = append(exportedComments, exportedComment{
+			CommentID:   int64(comment.ID),
+			PostID:      comment.PostID,
+			CreatedAt:   comment.CreatedAt.Format(time.RFC3339),
+			ContentText: comment.ContentText,
+		})
+	}
+	exportedLikes := []exportedLike{}
+	for _, like := range likes {
+		exportedLikes = append(exportedLikes, exportedLike{
+			PostID:    like.PostId,
+			CreatedAt: like.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	if followers == nil {
+		followers = []exportedUser{}
+	}
+	if followings == nil {
+		followings = []exportedUser{}
+	}
+
+	files := []struct {
+		name    string
+		content interface{}
+	}{
+		{"profile.json", profile},
+		{"posts.json", exportedPosts},
+		{"comments.json", exportedComments},
+		{"likes.json", exportedLikes},
+		{"followers.json", followers},
+		{"followings.json", followings},
+	}
+	for _, file := range files {
+		entry, err := archive.Create(file.name)
+		if err != nil {
+			return err
+		}
+		encoder := json.NewEncoder(entry)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(file.content)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The same image can be used by several posts or as profile picture, it is written once
+	written := make(map[string]bool)
+	for _, path := range media {
+		if path == "" || written[exportedMediaName(path)] {
+			continue
+		}
+		written[exportedMediaName(path)] = true
+		err = a.writeExportedMedia(archive, path)
+		if err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+// writeExportedMedia copies an uploaded image from S3 into the archive, missing images are skipped
+func (a *AuthenticateAndPostService) writeExportedMedia(archive *zip.Writer, path string) error {
+	components := strings.Split(path, "/")
+	key := components[len(components)-1]
+	object, err := a.s3Client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(os.Getenv("AWS_S3_BUCKET")),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		a.logger.Debug("failed to export media", zap.String("path", path), zap.Error(err))
+		return nil
+	}
+	defer object.Body.Close()
+
+	entry, err := archive.Create(exportedMediaName(path))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(entry, object.Body)
+	return err
+}
+
+// exportedMediaName is the name of an uploaded image in the archive
+func exportedMediaName(path string) string {
+	if path == "" {
+		return ""
+	}
+	components := strings.Split(path, "/")
+	return "media/" + components[len(components)-1]
+}
+
+func (a *AuthenticateAndPostService) deleteDataExportArchive(objectKey string) {
+	_, err := a.s3Client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(os.Getenv("AWS_S3_BUCKET")),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		a.logger.Error(err.Error())
+	}
+}
+
+func (a *AuthenticateAndPostService) dataExportArchiveLifetime() time.Duration {
+	if a.cfg.DataExport.ArchiveLifetime <= 0 {
+		return defaultDataExportArchiveLifetime
+	}
+	return a.cfg.DataExport.ArchiveLifetime
+}
+
+// deleteUserDataExport removes the export of an user with its archive
+func (a *AuthenticateAndPostService) deleteUserDataExport(userId int64) error {
+	var dataExport types.DataExport
+	result := a.db.Where("user_id = ?", userId).Limit(1).Find(&dataExport)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil
+	}
+	if dataExport.ObjectKey != "" {
+		a.deleteDataExportArchive(dataExport.ObjectKey)
+	}
+	return a.db.Where("user_id = ?", userId).Delete(&types.DataExport{}).Error
+}
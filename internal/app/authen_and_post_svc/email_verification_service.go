@@ -0,0 +1,101 @@
+package authen_and_post_svc
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/maxuanquang/social-network/internal/auth"
+	"github.com/maxuanquang/social-network/internal/pkg/mail"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+)
+
+const (
+	emailVerificationPurpose              = "email_verification"
+	defaultEmailVerificationTokenLifetime = 24 * time.Hour
+)
+
+func (a *AuthenticateAndPostService) SendVerificationEmail(ctx context.Context, info *pb_aap.SendVerificationEmailRequest) (*pb_aap.SendVerificationEmailResponse, error) {
+	a.logger.Debug("start sending verification email")
+	defer a.logger.Debug("end sending verification email")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.SendVerificationEmailResponse{Status: pb_aap.SendVerificationEmailResponse_USER_NOT_FOUND}, nil
+	}
+	if user.EmailVerifiedAt.Valid {
+		return &pb_aap.SendVerificationEmailResponse{Status: pb_aap.SendVerificationEmailResponse_ALREADY_VERIFIED}, nil
+	}
+
+	err := a.sendVerificationEmail(ctx, &user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.SendVerificationEmailResponse{
+		Status: pb_aap.SendVerificationEmailResponse_OK,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) VerifyEmail(ctx context.Context, info *pb_aap.VerifyEmailRequest) (*pb_aap.VerifyEmailResponse, error) {
+	a.logger.Debug("start verifying email")
+	defer a.logger.Debug("end verifying email")
+
+	token, err := auth.ParseSignedToken([]byte(a.cfg.EmailVerification.Secret), emailVerificationPurpose, info.GetToken())
+	if err != nil {
+		return &pb_aap.VerifyEmailResponse{Status: pb_aap.VerifyEmailResponse_INVALID_TOKEN}, nil
+	}
+
+	// A token can be used only once, its nonce is removed when it is used
+	tokenKey := fmt.Sprintf("email_verification:%s", token.Nonce)
+	if a.redisClient.Del(ctx, tokenKey).Val() != 1 {
+		return &pb_aap.VerifyEmailResponse{Status: pb_aap.VerifyEmailResponse_INVALID_TOKEN}, nil
+	}
+
+	exist, user := a.findUserById(token.UserID)
+	if !exist {
+		return &pb_aap.VerifyEmailResponse{Status: pb_aap.VerifyEmailResponse_INVALID_TOKEN}, nil
+	}
+	if user.EmailVerifiedAt.Valid {
+		return &pb_aap.VerifyEmailResponse{Status: pb_aap.VerifyEmailResponse_ALREADY_VERIFIED}, nil
+	}
+
+	err = a.db.Model(&user).Update("email_verified_at", time.Now()).Error
+	if err != nil {
+		return nil, err
+	}
+	a.redisClient.Del(ctx, fmt.Sprintf("user:%d", user.ID))
+
+	return &pb_aap.VerifyEmailResponse{
+		Status: pb_aap.VerifyEmailResponse_OK,
+	}, nil
+}
+
+// sendVerificationEmail sends a link with a new single-use verification token to user's email
+func (a *AuthenticateAndPostService) sendVerificationEmail(ctx context.Context, user *types.User) error {
+	lifetime := a.cfg.EmailVerification.TokenLifetime
+	if lifetime <= 0 {
+		lifetime = defaultEmailVerificationTokenLifetime
+	}
+
+	tokenString, token, err := auth.NewSignedToken([]byte(a.cfg.EmailVerification.Secret), emailVerificationPurpose, int64(user.ID), time.Now().Add(lifetime))
+	if err != nil {
+		return err
+	}
+	tokenKey := fmt.Sprintf("email_verification:%s", token.Nonce)
+	err = a.redisClient.Set(ctx, tokenKey, strconv.FormatUint(uint64(user.ID), 10), lifetime).Err()
+	if err != nil {
+		return err
+	}
+
+	link := a.cfg.EmailVerification.VerifyURL + "?token=" + url.QueryEscape(tokenString)
+	return a.mailSender.Send(ctx, mail.Message{
+		To:      user.Email,
+		Subject: "Verify your email",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease verify your email by opening the link below. The link expires in %s.\n\n%s\n",
+			user.UserName, lifetime, link),
+	})
+}
@@ -0,0 +1,131 @@
+package authen_and_post_svc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+	"gorm.io/gorm"
+)
+
+func (a *AuthenticateAndPostService) GetFollowRequests(ctx context.Context, info *pb_aap.GetFollowRequestsRequest) (*pb_aap.GetFollowRequestsResponse, error) {
+	a.logger.Debug("start getting follow requests")
+	defer a.logger.Debug("end getting follow requests")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetFollowRequestsResponse{Status: pb_aap.GetFollowRequestsResponse_USER_NOT_FOUND}, nil
+	}
+
+	var followersIds []int64
+	err := a.db.Model(&types.FollowRequest{}).Where("user_id = ?", info.GetUserId()).Order("created_at").Pluck("follower_id", &followersIds).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.GetFollowRequestsResponse{
+		Status:       pb_aap.GetFollowRequestsResponse_OK,
+		FollowersIds: followersIds,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) ApproveFollowRequest(ctx context.Context, info *pb_aap.ApproveFollowRequestRequest) (*pb_aap.ApproveFollowRequestResponse, error) {
+	a.logger.Debug("start approving follow request")
+	defer a.logger.Debug("end approving follow request")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.ApproveFollowRequestResponse{Status: pb_aap.ApproveFollowRequestResponse_USER_NOT_FOUND}, nil
+	}
+
+	approved, err := a.approveFollowRequests(info.GetUserId(), []int64{info.GetFollowerId()})
+	if err != nil {
+		return nil, err
+	}
+	if approved == 0 {
+		return &pb_aap.ApproveFollowRequestResponse{Status: pb_aap.ApproveFollowRequestResponse_REQUEST_NOT_FOUND}, nil
+	}
+
+	return &pb_aap.ApproveFollowRequestResponse{Status: pb_aap.ApproveFollowRequestResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) RejectFollowRequest(ctx context.Context, info *pb_aap.RejectFollowRequestRequest) (*pb_aap.RejectFollowRequestResponse, error) {
+	a.logger.Debug("start rejecting follow request")
+	defer a.logger.Debug("end rejecting follow request")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.RejectFollowRequestResponse{Status: pb_aap.RejectFollowRequestResponse_USER_NOT_FOUND}, nil
+	}
+
+	result := a.db.Where("user_id = ? AND follower_id = ?", info.GetUserId(), info.GetFollowerId()).Delete(&types.FollowRequest{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &pb_aap.RejectFollowRequestResponse{Status: pb_aap.RejectFollowRequestResponse_REQUEST_NOT_FOUND}, nil
+	}
+
+	return &pb_aap.RejectFollowRequestResponse{Status: pb_aap.RejectFollowRequestResponse_OK}, nil
+}
+
+// approveFollowRequests turns pending requests of followersIds into follows of userId, it returns the number of approved requests
+func (a *AuthenticateAndPostService) approveFollowRequests(userId int64, followersIds []int64) (int, error) {
+	var approvedIds, insertedIds []int64
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&types.FollowRequest{}).Where("user_id = ? AND follower_id in ?", userId, followersIds).Pluck("follower_id", &approvedIds).Error
+		if err != nil || len(approvedIds) == 0 {
+			return err
+		}
+		err = tx.Where("user_id = ? AND follower_id in ?", userId, approvedIds).Delete(&types.FollowRequest{}).Error
+		if err != nil {
+			return err
+		}
+		for _, followerId := range approvedIds {
+			result := tx.Exec("insert ignore into following (user_id, follower_id) values (?, ?)", userId, followerId)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected > 0 {
+				insertedIds = append(insertedIds, followerId)
+			}
+		}
+		err = a.updateUserCounter(tx, "followings_count", 1, insertedIds...)
+		if err != nil {
+			return err
+		}
+		if len(insertedIds) == 0 {
+			return nil
+		}
+		return a.updateUserCounter(tx, "followers_count", len(insertedIds), userId)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// Update cache
+	for _, followerId := range insertedIds {
+		followingsKey := fmt.Sprintf("followings:%d", followerId)
+		if a.redisClient.Exists(context.Background(), followingsKey).Val() == 1 {
+			a.redisClient.RPush(context.Background(), followingsKey, userId)
+		}
+		followersKey := fmt.Sprintf("followers:%d", userId)
+		if a.redisClient.Exists(context.Background(), followersKey).Val() == 1 {
+			a.redisClient.RPush(context.Background(), followersKey, followerId)
+		}
+	}
+	return len(approvedIds), nil
+}
+
+// canViewPosts checks if viewerId can read the posts of owner, posts of private accounts are only readable
+// by their owner and approved followers. viewerId is 0 for anonymous viewers.
+func (a *AuthenticateAndPostService) canViewPosts(viewerId int64, owner *types.User) (bool, error) {
+	if !owner.IsPrivate || viewerId == int64(owner.ID) {
+		return true, nil
+	}
+	if viewerId == 0 {
+		return false, nil
+	}
+	return a.isFollowing(viewerId, int64(owner.ID))
+}
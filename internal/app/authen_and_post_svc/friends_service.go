@@ -2,68 +2,161 @@ package authen_and_post_svc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/maxuanquang/social-network/internal/pkg/types"
 	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+	"gorm.io/gorm"
 )
 
 func (a *AuthenticateAndPostService) GetUserFollower(ctx context.Context, info *pb_aap.GetUserFollowerRequest) (*pb_aap.GetUserFollowerResponse, error) {
-	followersKey := fmt.Sprintf("followers:%d", info.GetUserId())
-	keyExist := (a.redisClient.Exists(context.Background(), followersKey).Val() == 1)
-	if keyExist {
-		a.redisClient.Expire(context.Background(), followersKey, 15*time.Minute)
-
-		var followersIds []int64
-		for _, id := range a.redisClient.LRange(context.Background(), followersKey, 0, -1).Val() {
-			intId, err := strconv.Atoi(id)
-			if err != nil {
-				a.logger.Debug(err.Error())
-				continue
-			}
-			followersIds = append(followersIds, int64(intId))
-		}
-		return &pb_aap.GetUserFollowerResponse{
-			Status:       pb_aap.GetUserFollowerResponse_OK,
-			FollowersIds: followersIds,
-		}, nil
-	}
-
-	exist, _ := a.findUserById(info.GetUserId())
+	exist, user := a.findUserById(info.GetUserId())
 	if !exist {
 		return &pb_aap.GetUserFollowerResponse{
 			Status: pb_aap.GetUserFollowerResponse_USER_NOT_FOUND,
 		}, nil
 	}
 
-	var user types.User
-	result := a.db.Preload("Followers").First(&user, info.GetUserId())
-	if result.Error != nil {
-		return nil, result.Error
+	// Followers are returned by descending id, the cursor is the id of the last follower of previous page
+	limit := pageLimit(info.GetLimit())
+	query := a.db.Table("following").Where("user_id = ?", info.GetUserId())
+	if info.GetCursor() > 0 {
+		query = query.Where("follower_id < ?", info.GetCursor())
 	}
-
-	// Caching
-	var cacheFollowersIds []interface{}
-	for _, follower := range user.Followers {
-		cacheFollowersIds = append(cacheFollowersIds, follower.ID)
-	}
-	a.redisClient.RPush(context.Background(), followersKey, cacheFollowersIds...)
-	a.redisClient.Expire(context.Background(), followersKey, 15*time.Minute)
-
 	var followersIds []int64
-	for _, follower := range user.Followers {
-		followersIds = append(followersIds, int64(follower.ID))
+	err := query.Order("follower_id desc").Limit(limit).Pluck("follower_id", &followersIds).Error
+	if err != nil {
+		return nil, err
+	}
+	var nextCursor int64
+	if len(followersIds) == limit {
+		nextCursor = followersIds[len(followersIds)-1]
 	}
+
 	return &pb_aap.GetUserFollowerResponse{
 		Status:       pb_aap.GetUserFollowerResponse_OK,
 		FollowersIds: followersIds,
+		NextCursor:   nextCursor,
+		TotalCount:   user.FollowersCount,
 	}, nil
 }
 
 func (a *AuthenticateAndPostService) GetUserFollowing(ctx context.Context, info *pb_aap.GetUserFollowingRequest) (*pb_aap.GetUserFollowingResponse, error) {
-	followingsKey := fmt.Sprintf("followings:%d", info.GetUserId())
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetUserFollowingResponse{
+			Status: pb_aap.GetUserFollowingResponse_USER_NOT_FOUND,
+		}, nil
+	}
+
+	// Followings are returned by descending id, the cursor is the id of the last following of previous page
+	limit := pageLimit(info.GetLimit())
+	query := a.db.Table("following").Where("follower_id = ?", info.GetUserId())
+	if info.GetCursor() > 0 {
+		query = query.Where("user_id < ?", info.GetCursor())
+	}
+	var followingsIds []int64
+	err := query.Order("user_id desc").Limit(limit).Pluck("user_id", &followingsIds).Error
+	if err != nil {
+		return nil, err
+	}
+	var nextCursor int64
+	if len(followingsIds) == limit {
+		nextCursor = followingsIds[len(followingsIds)-1]
+	}
+
+	return &pb_aap.GetUserFollowingResponse{
+		Status:        pb_aap.GetUserFollowingResponse_OK,
+		FollowingsIds: followingsIds,
+		NextCursor:    nextCursor,
+		TotalCount:    user.FollowingsCount,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) GetRelationship(ctx context.Context, info *pb_aap.GetRelationshipRequest) (*pb_aap.GetRelationshipResponse, error) {
+	a.logger.Debug("start getting relationships")
+	defer a.logger.Debug("end getting relationships")
+
+	if len(info.GetTargetsIds()) > maxPageLimit {
+		return &pb_aap.GetRelationshipResponse{Status: pb_aap.GetRelationshipResponse_TOO_MANY_TARGETS}, nil
+	}
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetRelationshipResponse{Status: pb_aap.GetRelationshipResponse_USER_NOT_FOUND}, nil
+	}
+	if len(info.GetTargetsIds()) == 0 {
+		return &pb_aap.GetRelationshipResponse{Status: pb_aap.GetRelationshipResponse_OK}, nil
+	}
+
+	userId := info.GetUserId()
+	targetsIds := info.GetTargetsIds()
+	var followingIds, followedByIds, blockedIds, requestedIds []int64
+	err := a.db.Table("following").Where("follower_id = ? AND user_id in ?", userId, targetsIds).Pluck("user_id", &followingIds).Error
+	if err != nil {
+		return nil, err
+	}
+	err = a.db.Table("following").Where("user_id = ? AND follower_id in ?", userId, targetsIds).Pluck("follower_id", &followedByIds).Error
+	if err != nil {
+		return nil, err
+	}
+	err = a.db.Model(&types.Block{}).Where("user_id = ? AND blocked_user_id in ?", userId, targetsIds).Pluck("blocked_user_id", &blockedIds).Error
+	if err != nil {
+		return nil, err
+	}
+	err = a.db.Model(&types.FollowRequest{}).Where("follower_id = ? AND user_id in ?", userId, targetsIds).Pluck("user_id", &requestedIds).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// Users followed by both, self follows are not counted
+	var mutualCounts []struct {
+		TargetID int64
+		Count    int64
+	}
+	err = a.db.Raw("select f2.follower_id as target_id, count(*) as count from following f1 join following f2 on f1.user_id = f2.user_id "+
+		"where f1.follower_id = ? and f2.follower_id in ? and f1.user_id != f1.follower_id and f2.user_id != f2.follower_id "+
+		"group by f2.follower_id", userId, targetsIds).Scan(&mutualCounts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	relationships := make(map[int64]*pb_aap.Relationship)
+	var result []*pb_aap.Relationship
+	for _, targetId := range targetsIds {
+		if relationships[targetId] != nil {
+			continue
+		}
+		relationships[targetId] = &pb_aap.Relationship{TargetId: targetId}
+		result = append(result, relationships[targetId])
+	}
+	for _, id := range followingIds {
+		relationships[id].Following = true
+	}
+	for _, id := range followedByIds {
+		relationships[id].FollowedBy = true
+	}
+	for _, id := range blockedIds {
+		relationships[id].Blocked = true
+	}
+	for _, id := range requestedIds {
+		relationships[id].Requested = true
+	}
+	for _, mutualCount := range mutualCounts {
+		relationships[mutualCount.TargetID].MutualCount = mutualCount.Count
+	}
+
+	return &pb_aap.GetRelationshipResponse{
+		Status:        pb_aap.GetRelationshipResponse_OK,
+		Relationships: result,
+	}, nil
+}
+
+// findFollowingsIds returns all followings of an user, they are cached as a list
+func (a *AuthenticateAndPostService) findFollowingsIds(userId int64) ([]int64, error) {
+	followingsKey := fmt.Sprintf("followings:%d", userId)
 	keyExist := (a.redisClient.Exists(context.Background(), followingsKey).Val() == 1)
 	if keyExist {
 		a.redisClient.Expire(context.Background(), followingsKey, 15*time.Minute)
@@ -77,41 +170,50 @@ func (a *AuthenticateAndPostService) GetUserFollowing(ctx context.Context, info
 			}
 			followingsIds = append(followingsIds, int64(intId))
 		}
-		return &pb_aap.GetUserFollowingResponse{
-			Status:        pb_aap.GetUserFollowingResponse_OK,
-			FollowingsIds: followingsIds,
-		}, nil
-	}
-
-	exist, _ := a.findUserById(info.GetUserId())
-	if !exist {
-		return &pb_aap.GetUserFollowingResponse{
-			Status: pb_aap.GetUserFollowingResponse_USER_NOT_FOUND,
-		}, nil
+		return followingsIds, nil
 	}
 
-	var user types.User
-	result := a.db.Preload("Followings").First(&user, info.GetUserId())
-	if result.Error != nil {
-		return nil, result.Error
+	var followingsIds []int64
+	err := a.db.Table("following").Where("follower_id = ?", userId).Pluck("user_id", &followingsIds).Error
+	if err != nil {
+		return nil, err
 	}
 
 	// Caching
 	var cacheFollowingsIds []interface{}
-	for _, following := range user.Followings {
-		cacheFollowingsIds = append(cacheFollowingsIds, following.ID)
+	for _, id := range followingsIds {
+		cacheFollowingsIds = append(cacheFollowingsIds, id)
 	}
-	a.redisClient.RPush(context.Background(), followingsKey, cacheFollowingsIds...)
-	a.redisClient.Expire(context.Background(), followingsKey, 15*time.Minute)
+	if len(cacheFollowingsIds) > 0 {
+		a.redisClient.RPush(context.Background(), followingsKey, cacheFollowingsIds...)
+		a.redisClient.Expire(context.Background(), followingsKey, 15*time.Minute)
+	}
+	return followingsIds, nil
+}
 
-	var followingsIds []int64
-	for _, following := range user.Followings {
-		followingsIds = append(followingsIds, int64(following.ID))
+// isFollowing checks if followerId follows userId
+func (a *AuthenticateAndPostService) isFollowing(followerId int64, userId int64) (bool, error) {
+	var count int64
+	err := a.db.Table("following").Where("user_id = ? AND follower_id = ?", userId, followerId).Count(&count).Error
+	if err != nil {
+		return false, err
 	}
-	return &pb_aap.GetUserFollowingResponse{
-		Status:        pb_aap.GetUserFollowingResponse_OK,
-		FollowingsIds: followingsIds,
-	}, nil
+	return count > 0, nil
+}
+
+// updateUserCounter adds delta to a counter column of users, their cached data is dropped to show the new value
+func (a *AuthenticateAndPostService) updateUserCounter(db *gorm.DB, column string, delta int, usersIds ...int64) error {
+	if len(usersIds) == 0 {
+		return nil
+	}
+	err := db.Model(&types.User{}).Where("id in ?", usersIds).UpdateColumn(column, gorm.Expr(column+" + ?", delta)).Error
+	if err != nil {
+		return err
+	}
+	for _, userId := range usersIds {
+		a.redisClient.Del(context.Background(), fmt.Sprintf("user:%d", userId))
+	}
+	return nil
 }
 
 func (a *AuthenticateAndPostService) FollowUser(ctx context.Context, info *pb_aap.FollowUserRequest) (*pb_aap.FollowUserResponse, error) {
@@ -124,16 +226,53 @@ func (a *AuthenticateAndPostService) FollowUser(ctx context.Context, info *pb_aa
 	if !exist {
 		return &pb_aap.FollowUserResponse{Status: pb_aap.FollowUserResponse_USER_NOT_FOUND}, nil
 	}
+	blocked, err := a.isBlocked(info.GetUserId(), info.GetFollowingId())
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return &pb_aap.FollowUserResponse{Status: pb_aap.FollowUserResponse_NOT_ALLOWED}, nil
+	}
 
-	var user types.User
-	a.db.Preload("Followings").First(&user, info.GetUserId())
-	for _, following := range user.Followings {
-		if following.ID == uint(info.GetFollowingId()) {
-			return &pb_aap.FollowUserResponse{Status: pb_aap.FollowUserResponse_ALREADY_FOLLOWED}, nil
+	following, err := a.isFollowing(info.GetUserId(), info.GetFollowingId())
+	if err != nil {
+		return nil, err
+	}
+	if following {
+		return &pb_aap.FollowUserResponse{Status: pb_aap.FollowUserResponse_ALREADY_FOLLOWED}, nil
+	}
+
+	// Private accounts have to approve their followers, except themselves
+	selfFollow := info.GetUserId() == info.GetFollowingId()
+	if friend.IsPrivate && !selfFollow {
+		err = a.db.Create(&types.FollowRequest{
+			CreatedAt:  time.Now(),
+			UserID:     info.GetFollowingId(),
+			FollowerID: info.GetUserId(),
+		}).Error
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return &pb_aap.FollowUserResponse{Status: pb_aap.FollowUserResponse_ALREADY_REQUESTED}, nil
+		} else if err != nil {
+			return nil, err
 		}
+		return &pb_aap.FollowUserResponse{Status: pb_aap.FollowUserResponse_REQUESTED}, nil
 	}
 
-	err := a.db.Model(&user).Association("Followings").Append(&friend)
+	err = a.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("insert into following (user_id, follower_id) values (?, ?)", info.GetFollowingId(), info.GetUserId()).Error
+		if err != nil {
+			return err
+		}
+		// Users follow themselves so that their posts are in their newsfeed, this edge is not counted
+		if selfFollow {
+			return nil
+		}
+		err = a.updateUserCounter(tx, "followings_count", 1, info.GetUserId())
+		if err != nil {
+			return err
+		}
+		return a.updateUserCounter(tx, "followers_count", 1, info.GetFollowingId())
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -160,25 +299,41 @@ func (a *AuthenticateAndPostService) UnfollowUser(ctx context.Context, info *pb_
 	if !exist {
 		return &pb_aap.UnfollowUserResponse{Status: pb_aap.UnfollowUserResponse_USER_NOT_FOUND}, nil
 	}
-	exist, friend := a.findUserById(info.GetFollowingId())
+	exist, _ = a.findUserById(info.GetFollowingId())
 	if !exist {
 		return &pb_aap.UnfollowUserResponse{Status: pb_aap.UnfollowUserResponse_USER_NOT_FOUND}, nil
 	}
 
-	var user types.User
-	a.db.Preload("Followings").First(&user, info.GetUserId())
-	currentlyFollowing := false
-	for _, following := range user.Followings {
-		if following.ID == uint(info.GetFollowingId()) {
-			currentlyFollowing = true
-			break
-		}
+	currentlyFollowing, err := a.isFollowing(info.GetUserId(), info.GetFollowingId())
+	if err != nil {
+		return nil, err
 	}
 	if !currentlyFollowing {
+		// Unfollowing a private account cancels the pending follow request
+		result := a.db.Where("user_id = ? AND follower_id = ?", info.GetFollowingId(), info.GetUserId()).Delete(&types.FollowRequest{})
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected > 0 {
+			return &pb_aap.UnfollowUserResponse{Status: pb_aap.UnfollowUserResponse_OK}, nil
+		}
 		return &pb_aap.UnfollowUserResponse{Status: pb_aap.UnfollowUserResponse_NOT_FOLLOWED}, nil
 	}
 
-	err := a.db.Model(&user).Association("Followings").Delete(&friend)
+	err = a.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("delete from following where user_id = ? and follower_id = ?", info.GetFollowingId(), info.GetUserId()).Error
+		if err != nil {
+			return err
+		}
+		if info.GetUserId() == info.GetFollowingId() {
+			return nil
+		}
+		err = a.updateUserCounter(tx, "followings_count", -1, info.GetUserId())
+		if err != nil {
+			return err
+		}
+		return a.updateUserCounter(tx, "followers_count", -1, info.GetFollowingId())
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -201,22 +356,48 @@ func (a *AuthenticateAndPostService) UnfollowUser(ctx context.Context, info *pb_
 }
 
 func (a *AuthenticateAndPostService) GetUserPosts(ctx context.Context, info *pb_aap.GetUserPostsRequest) (*pb_aap.GetUserPostsResponse, error) {
-	exist, _ := a.findUserById(info.GetUserId())
+	exist, owner := a.findUserById(info.GetUserId())
 	if !exist {
 		return &pb_aap.GetUserPostsResponse{Status: pb_aap.GetUserPostsResponse_USER_NOT_FOUND}, nil
 	}
+	canView, err := a.canViewPosts(info.GetViewerId(), &owner)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		return &pb_aap.GetUserPostsResponse{Status: pb_aap.GetUserPostsResponse_PRIVATE_ACCOUNT}, nil
+	}
+
+	// Posts shared with an audience list are only listed to its members
+	audienceCondition := "(post.audience_list_id is null or post.user_id = ? or " +
+		"post.audience_list_id in (select audience_list_id from audience_list_member where user_id = ?))"
 
 	var user types.User
-	a.db.Raw("select * from post where user_id = ? order by created_at desc", info.GetUserId()).Scan(&user.Posts)
+	a.db.Raw("select * from post where user_id = ? and "+audienceCondition+" order by created_at desc",
+		info.GetUserId(), info.GetViewerId(), info.GetViewerId()).Scan(&user.Posts)
+
+	// Pinned posts go first, the most recently pinned one on top
+	var pinned_posts_ids []int64
+	a.db.Raw("select pinned_post.post_id from pinned_post join post on post.id = pinned_post.post_id "+
+		"where pinned_post.user_id = ? and post.deleted_at is null and "+audienceCondition+" order by pinned_post.created_at desc",
+		info.GetUserId(), info.GetViewerId(), info.GetViewerId()).Scan(&pinned_posts_ids)
+	pinned := make(map[int64]bool)
+	for _, id := range pinned_posts_ids {
+		pinned[id] = true
+	}
 
 	// Return
-	var posts_ids []int64
+	posts_ids := append([]int64{}, pinned_posts_ids...)
 	for _, post := range user.Posts {
+		if pinned[int64(post.ID)] {
+			continue
+		}
 		posts_ids = append(posts_ids, int64(post.ID))
 	}
 
 	return &pb_aap.GetUserPostsResponse{
-		Status:   pb_aap.GetUserPostsResponse_OK,
-		PostsIds: posts_ids,
+		Status:         pb_aap.GetUserPostsResponse_OK,
+		PostsIds:       posts_ids,
+		PinnedPostsIds: pinned_posts_ids,
 	}, nil
 }
@@ -0,0 +1,73 @@
+package authen_and_post_svc
+
+import (
+	"context"
+
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+	"github.com/maxuanquang/social-network/internal/pkg/unfurl"
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+	"go.uber.org/zap"
+)
+
+// linkPreviewQueueSize is the number of posts waiting to be unfurled, new posts are not
+// unfurled when the queue is full
+const linkPreviewQueueSize = 100
+
+type linkPreviewJob struct {
+	postId int64
+	url    string
+}
+
+// enqueueLinkPreview schedules fetching the preview of the first url in a new post's text
+func (a *AuthenticateAndPostService) enqueueLinkPreview(postId int64, contentText string) {
+	if a.linkPreviewJobs == nil {
+		return
+	}
+	url := unfurl.FirstURL(contentText)
+	if url == "" {
+		return
+	}
+
+	select {
+	case a.linkPreviewJobs <- linkPreviewJob{postId: postId, url: url}:
+	default:
+		a.logger.Warn("link preview queue is full", zap.Int64("post_id", postId))
+	}
+}
+
+// runLinkPreviewWorker fetches and stores link previews of queued posts
+func (a *AuthenticateAndPostService) runLinkPreviewWorker() {
+	for job := range a.linkPreviewJobs {
+		preview, err := a.unfurlFetcher.Fetch(context.Background(), job.url)
+		if err != nil {
+			a.logger.Debug("failed to unfurl link", zap.Int64("post_id", job.postId), zap.String("url", job.url), zap.Error(err))
+			continue
+		}
+
+		err = a.db.Create(&types.LinkPreview{
+			PostID:      job.postId,
+			URL:         preview.URL,
+			Title:       preview.Title,
+			Description: preview.Description,
+			ImageURL:    preview.ImageURL,
+		}).Error
+		if err != nil {
+			a.logger.Error(err.Error())
+		}
+	}
+}
+
+// findLinkPreviewByPostId returns the link preview of a post, or nil if there is none
+func (a *AuthenticateAndPostService) findLinkPreviewByPostId(postId int64) *pb_aap.LinkPreview {
+	var preview types.LinkPreview
+	result := a.db.Limit(1).Find(&preview, "post_id = ?", postId)
+	if result.Error != nil || result.RowsAffected == 0 {
+		return nil
+	}
+	return &pb_aap.LinkPreview{
+		Url:         preview.URL,
+		Title:       preview.Title,
+		Description: preview.Description,
+		ImageUrl:    preview.ImageURL,
+	}
+}
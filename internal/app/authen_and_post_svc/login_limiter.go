@@ -0,0 +1,112 @@
+package authen_and_post_svc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	defaultLoginWindow             = 15 * time.Minute
+	defaultMaxLoginFailuresPerUser = 5
+	defaultMaxLoginFailuresPerIP   = 20
+	defaultBaseLoginLockout        = time.Minute
+	defaultMaxLoginLockout         = time.Hour
+
+	// loginLockoutsMemory is how long previous lockouts make the next one longer
+	loginLockoutsMemory = 24 * time.Hour
+)
+
+// loginLimitKey identifies what login attempts are counted by, e.g. an user name or a client ip
+type loginLimitKey struct {
+	kind string
+	id   string
+}
+
+// loginLimitKeys returns keys of an attempt, attempts without client ip are only limited per user name
+func loginLimitKeys(userName string, clientIp string) []loginLimitKey {
+	keys := []loginLimitKey{{kind: "user", id: userName}}
+	if clientIp != "" {
+		keys = append(keys, loginLimitKey{kind: "ip", id: clientIp})
+	}
+	return keys
+}
+
+// checkLoginLocked returns the end of the longest lockout of the keys, or zero time if none is locked
+func (a *AuthenticateAndPostService) checkLoginLocked(ctx context.Context, keys []loginLimitKey) time.Time {
+	var lockedUntil time.Time
+	for _, key := range keys {
+		ttl := a.redisClient.PTTL(ctx, fmt.Sprintf("login_lock:%s:%s", key.kind, key.id)).Val()
+		if ttl > 0 && time.Now().Add(ttl).After(lockedUntil) {
+			lockedUntil = time.Now().Add(ttl)
+		}
+	}
+	return lockedUntil
+}
+
+// recordLoginFailure counts a failed attempt in a sliding window for every key.
+// A key reaching its limit is locked, each lockout within loginLockoutsMemory doubles the duration.
+func (a *AuthenticateAndPostService) recordLoginFailure(ctx context.Context, keys []loginLimitKey) {
+	cfg := a.cfg.LoginLimiter
+	window := durationOrDefault(cfg.Window, defaultLoginWindow)
+	baseLockout := durationOrDefault(cfg.BaseLockout, defaultBaseLoginLockout)
+	maxLockout := durationOrDefault(cfg.MaxLockout, defaultMaxLoginLockout)
+
+	now := time.Now()
+	for _, key := range keys {
+		maxFailures := cfg.MaxFailuresPerUser
+		if maxFailures <= 0 {
+			maxFailures = defaultMaxLoginFailuresPerUser
+		}
+		if key.kind == "ip" {
+			maxFailures = cfg.MaxFailuresPerIP
+			if maxFailures <= 0 {
+				maxFailures = defaultMaxLoginFailuresPerIP
+			}
+		}
+
+		failuresKey := fmt.Sprintf("login_failures:%s:%s", key.kind, key.id)
+		pipe := a.redisClient.TxPipeline()
+		pipe.ZAdd(ctx, failuresKey, &redis.Z{Score: float64(now.UnixNano()), Member: now.UnixNano()})
+		pipe.ZRemRangeByScore(ctx, failuresKey, "-inf", strconv.FormatInt(now.Add(-window).UnixNano(), 10))
+		failures := pipe.ZCard(ctx, failuresKey)
+		pipe.Expire(ctx, failuresKey, window)
+		_, err := pipe.Exec(ctx)
+		if err != nil {
+			a.logger.Error(err.Error())
+			continue
+		}
+		if failures.Val() < int64(maxFailures) {
+			continue
+		}
+
+		lockoutsKey := fmt.Sprintf("login_lockouts:%s:%s", key.kind, key.id)
+		lockouts := a.redisClient.Incr(ctx, lockoutsKey).Val()
+		a.redisClient.Expire(ctx, lockoutsKey, loginLockoutsMemory)
+		lockout := baseLockout
+		for i := int64(1); i < lockouts && lockout < maxLockout; i++ {
+			lockout *= 2
+		}
+		if lockout > maxLockout {
+			lockout = maxLockout
+		}
+		a.redisClient.Set(ctx, fmt.Sprintf("login_lock:%s:%s", key.kind, key.id), 1, lockout)
+		a.redisClient.Del(ctx, failuresKey)
+		a.logger.Info(fmt.Sprintf("login locked for %s %s during %s", key.kind, key.id, lockout))
+	}
+}
+
+// resetLoginFailures forgets failed attempts of an user name after a successful login
+func (a *AuthenticateAndPostService) resetLoginFailures(ctx context.Context, userName string) {
+	a.redisClient.Del(ctx, fmt.Sprintf("login_failures:user:%s", userName))
+}
+
+func durationOrDefault(d time.Duration, defaultDuration time.Duration) time.Duration {
+	if d <= 0 {
+		return defaultDuration
+	}
+	return d
+}
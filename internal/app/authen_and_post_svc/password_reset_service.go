@@ -0,0 +1,118 @@
+package authen_and_post_svc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/maxuanquang/social-network/internal/pkg/mail"
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+)
+
+const defaultPasswordResetTokenLifetime = time.Hour
+
+func (a *AuthenticateAndPostService) RequestPasswordReset(ctx context.Context, info *pb_aap.RequestPasswordResetRequest) (*pb_aap.RequestPasswordResetResponse, error) {
+	a.logger.Debug("start requesting password reset")
+	defer a.logger.Debug("end requesting password reset")
+
+	exist, user := a.findUserByEmail(strings.ToLower(strings.TrimSpace(info.GetEmail())))
+	if !exist {
+		return &pb_aap.RequestPasswordResetResponse{Status: pb_aap.RequestPasswordResetResponse_USER_NOT_FOUND}, nil
+	}
+
+	lifetime := a.cfg.PasswordReset.TokenLifetime
+	if lifetime <= 0 {
+		lifetime = defaultPasswordResetTokenLifetime
+	}
+
+	// Only the hash of the token is stored, a user has at most one valid token
+	token, err := newPasswordResetToken()
+	if err != nil {
+		return nil, err
+	}
+	tokenHash := hashPasswordResetToken(token)
+	userTokenKey := fmt.Sprintf("password_reset_user:%d", user.ID)
+	oldTokenHash, err := a.redisClient.Get(ctx, userTokenKey).Result()
+	if err == nil {
+		a.redisClient.Del(ctx, fmt.Sprintf("password_reset:%s", oldTokenHash))
+	}
+	err = a.redisClient.Set(ctx, fmt.Sprintf("password_reset:%s", tokenHash), int64(user.ID), lifetime).Err()
+	if err != nil {
+		return nil, err
+	}
+	a.redisClient.Set(ctx, userTokenKey, tokenHash, lifetime)
+
+	body := fmt.Sprintf("Hi %s,\n\nSomebody asked to reset the password of your account. If it was not you, ignore this email.\n\nYour reset token expires in %s:\n\n%s\n",
+		user.UserName, lifetime, token)
+	if a.cfg.PasswordReset.ResetURL != "" {
+		body += fmt.Sprintf("\nOr open the link below:\n\n%s?token=%s\n", a.cfg.PasswordReset.ResetURL, url.QueryEscape(token))
+	}
+	err = a.mailSender.Send(ctx, mail.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body:    body,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.RequestPasswordResetResponse{
+		Status: pb_aap.RequestPasswordResetResponse_OK,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) ConfirmPasswordReset(ctx context.Context, info *pb_aap.ConfirmPasswordResetRequest) (*pb_aap.ConfirmPasswordResetResponse, error) {
+	a.logger.Debug("start confirming password reset")
+	defer a.logger.Debug("end confirming password reset")
+
+	// A token can be used only once, it is removed when it is used
+	tokenKey := fmt.Sprintf("password_reset:%s", hashPasswordResetToken(info.GetToken()))
+	userId, err := a.redisClient.Get(ctx, tokenKey).Int64()
+	if err != nil {
+		return &pb_aap.ConfirmPasswordResetResponse{Status: pb_aap.ConfirmPasswordResetResponse_INVALID_TOKEN}, nil
+	}
+	if a.redisClient.Del(ctx, tokenKey).Val() != 1 {
+		return &pb_aap.ConfirmPasswordResetResponse{Status: pb_aap.ConfirmPasswordResetResponse_INVALID_TOKEN}, nil
+	}
+	a.redisClient.Del(ctx, fmt.Sprintf("password_reset_user:%d", userId))
+
+	exist, user := a.findUserById(userId)
+	if !exist {
+		return &pb_aap.ConfirmPasswordResetResponse{Status: pb_aap.ConfirmPasswordResetResponse_INVALID_TOKEN}, nil
+	}
+
+	hashedPassword, err := a.passwordHasher.Hash(info.GetNewPassword())
+	if err != nil {
+		return nil, err
+	}
+	err = a.db.Model(&user).Updates(map[string]interface{}{"hashed_password": hashedPassword, "salt": []byte{}}).Error
+	if err != nil {
+		return nil, err
+	}
+	a.redisClient.Del(ctx, fmt.Sprintf("user:%d", user.ID))
+
+	return &pb_aap.ConfirmPasswordResetResponse{
+		Status: pb_aap.ConfirmPasswordResetResponse_OK,
+		UserId: int64(user.ID),
+	}, nil
+}
+
+func newPasswordResetToken() (string, error) {
+	tokenBytes := make([]byte, 32)
+	_, err := rand.Read(tokenBytes)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(tokenBytes), nil
+}
+
+func hashPasswordResetToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,281 @@
+package authen_and_post_svc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	minPollOptions          = 2
+	maxPollOptions          = 10
+	maxPollOptionTextLength = 200
+	// Tallies are cached for a fixed time from their computation, a tally computed while a vote
+	// was committing is not kept longer than this
+	pollTalliesCacheTime = time.Minute
+)
+
+func (a *AuthenticateAndPostService) VotePoll(ctx context.Context, info *pb_aap.VotePollRequest) (*pb_aap.VotePollResponse, error) {
+	a.logger.Debug("start voting poll")
+	defer a.logger.Debug("end voting poll")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.VotePollResponse{Status: pb_aap.VotePollResponse_USER_NOT_FOUND}, nil
+	}
+	exist, post := a.findPostById(info.GetPostId())
+	if !exist {
+		return &pb_aap.VotePollResponse{Status: pb_aap.VotePollResponse_POLL_NOT_FOUND}, nil
+	}
+	canView, err := a.canViewPost(info.GetUserId(), &post)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		return &pb_aap.VotePollResponse{Status: pb_aap.VotePollResponse_POLL_NOT_FOUND}, nil
+	}
+	exist, poll := a.findPollByPostId(info.GetPostId())
+	if !exist {
+		return &pb_aap.VotePollResponse{Status: pb_aap.VotePollResponse_POLL_NOT_FOUND}, nil
+	}
+	if isPollClosed(&poll) {
+		return &pb_aap.VotePollResponse{Status: pb_aap.VotePollResponse_POLL_CLOSED}, nil
+	}
+
+	// Every chosen option must belong to the poll and be chosen only once
+	optionsIds := info.GetOptionsIds()
+	if len(optionsIds) == 0 || (!poll.MultipleChoice && len(optionsIds) > 1) {
+		return &pb_aap.VotePollResponse{Status: pb_aap.VotePollResponse_INVALID_OPTIONS}, nil
+	}
+	pollOptions := make(map[int64]bool)
+	for _, option := range poll.Options {
+		pollOptions[int64(option.ID)] = true
+	}
+	chosen := make(map[int64]bool)
+	for _, id := range optionsIds {
+		if !pollOptions[id] || chosen[id] {
+			return &pb_aap.VotePollResponse{Status: pb_aap.VotePollResponse_INVALID_OPTIONS}, nil
+		}
+		chosen[id] = true
+	}
+
+	// The primary key of poll_vote allows only one vote per user and poll
+	err = a.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&types.PollVote{
+			PostID: poll.PostID,
+			UserID: info.GetUserId(),
+		}).Error
+		if err != nil {
+			return err
+		}
+		for _, id := range optionsIds {
+			err = tx.Create(&types.PollVoteOption{
+				PostID:   poll.PostID,
+				UserID:   info.GetUserId(),
+				OptionID: id,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return &pb_aap.VotePollResponse{Status: pb_aap.VotePollResponse_ALREADY_VOTED}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Cached tallies are dropped and computed again by the next read
+	a.redisClient.Del(context.Background(), fmt.Sprintf("poll_votes:%d", poll.PostID))
+
+	return &pb_aap.VotePollResponse{
+		Status: pb_aap.VotePollResponse_OK,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) GetPollResults(ctx context.Context, info *pb_aap.GetPollResultsRequest) (*pb_aap.GetPollResultsResponse, error) {
+	a.logger.Debug("start getting poll results")
+	defer a.logger.Debug("end getting poll results")
+
+	exist, post := a.findPostById(info.GetPostId())
+	if !exist {
+		return &pb_aap.GetPollResultsResponse{Status: pb_aap.GetPollResultsResponse_POLL_NOT_FOUND}, nil
+	}
+	canView, err := a.canViewPost(info.GetUserId(), &post)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		return &pb_aap.GetPollResultsResponse{Status: pb_aap.GetPollResultsResponse_POLL_NOT_FOUND}, nil
+	}
+	exist, poll := a.findPollByPostId(info.GetPostId())
+	if !exist {
+		return &pb_aap.GetPollResultsResponse{Status: pb_aap.GetPollResultsResponse_POLL_NOT_FOUND}, nil
+	}
+
+	pbPoll, err := a.newPbPoll(&poll)
+	if err != nil {
+		return nil, err
+	}
+
+	var votedOptionsIds []int64
+	if info.GetUserId() != 0 {
+		err = a.db.Model(&types.PollVoteOption{}).
+			Where(&types.PollVoteOption{PostID: poll.PostID, UserID: info.GetUserId()}).
+			Pluck("option_id", &votedOptionsIds).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb_aap.GetPollResultsResponse{
+		Status:          pb_aap.GetPollResultsResponse_OK,
+		Poll:            pbPoll,
+		VotedOptionsIds: votedOptionsIds,
+	}, nil
+}
+
+// validatePoll checks the poll attached to a new post
+func validatePoll(poll *pb_aap.NewPoll) bool {
+	if len(poll.GetOptions()) < minPollOptions || len(poll.GetOptions()) > maxPollOptions {
+		return false
+	}
+	for _, option := range poll.GetOptions() {
+		text := strings.TrimSpace(option)
+		if len(text) == 0 || len(text) > maxPollOptionTextLength {
+			return false
+		}
+	}
+	if poll.ClosesAt != nil && !poll.GetClosesAt().AsTime().After(time.Now()) {
+		return false
+	}
+	return true
+}
+
+// newPoll converts the poll of a CreatePostRequest into a database model
+func newPoll(postId int64, poll *pb_aap.NewPoll) *types.Poll {
+	newPoll := types.Poll{
+		PostID:         postId,
+		MultipleChoice: poll.GetMultipleChoice(),
+	}
+	if poll.ClosesAt != nil {
+		newPoll.ClosesAt.Valid = true
+		newPoll.ClosesAt.Time = poll.GetClosesAt().AsTime()
+	}
+	for i, option := range poll.GetOptions() {
+		newPoll.Options = append(newPoll.Options, &types.PollOption{
+			PostID:      postId,
+			Position:    i,
+			ContentText: strings.TrimSpace(option),
+		})
+	}
+	return &newPoll
+}
+
+// findPollByPostId checks if a visible post with provided postId has a poll
+func (a *AuthenticateAndPostService) findPollByPostId(postId int64) (exist bool, poll types.Poll) {
+	exist, _ = a.findPostById(postId)
+	if !exist {
+		return false, types.Poll{}
+	}
+	result := a.db.Preload("Options", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position")
+	}).First(&poll, "post_id = ?", postId)
+	if result.Error != nil {
+		return false, types.Poll{}
+	}
+	return true, poll
+}
+
+// newPbPoll returns the poll with its current tallies
+func (a *AuthenticateAndPostService) newPbPoll(poll *types.Poll) (*pb_aap.Poll, error) {
+	tallies, err := a.getPollTallies(poll.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	pbPoll := &pb_aap.Poll{
+		PostId:         poll.PostID,
+		MultipleChoice: poll.MultipleChoice,
+		Closed:         isPollClosed(poll),
+		TotalVoters:    tallies["voters"],
+	}
+	if poll.ClosesAt.Valid {
+		pbPoll.ClosesAt = timestamppb.New(poll.ClosesAt.Time)
+	}
+	for _, option := range poll.Options {
+		pbPoll.Options = append(pbPoll.Options, &pb_aap.PollOption{
+			OptionId:    int64(option.ID),
+			ContentText: option.ContentText,
+			Votes:       tallies[strconv.FormatInt(int64(option.ID), 10)],
+		})
+	}
+	return pbPoll, nil
+}
+
+// getPollTallies returns number of votes per option id and number of voters under key "voters".
+// Tallies are served from cache and counted from database when the cache is missing.
+func (a *AuthenticateAndPostService) getPollTallies(postId int64) (map[string]int64, error) {
+	pollVotesKey := fmt.Sprintf("poll_votes:%d", postId)
+	tallies := make(map[string]int64)
+
+	cached, err := a.redisClient.HGetAll(context.Background(), pollVotesKey).Result()
+	if err == nil && len(cached) > 0 {
+		for field, value := range cached {
+			count, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				a.logger.Debug(err.Error())
+				continue
+			}
+			tallies[field] = count
+		}
+		return tallies, nil
+	}
+
+	var voters int64
+	err = a.db.Model(&types.PollVote{}).Where(&types.PollVote{PostID: postId}).Count(&voters).Error
+	if err != nil {
+		return nil, err
+	}
+	tallies["voters"] = voters
+
+	var rows []struct {
+		OptionID int64
+		Votes    int64
+	}
+	err = a.db.Raw("select option_id, count(*) as votes from poll_vote_option where post_id = ? group by option_id", postId).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		tallies[strconv.FormatInt(row.OptionID, 10)] = row.Votes
+	}
+
+	// Caching
+	var cacheTallies []interface{}
+	for field, count := range tallies {
+		cacheTallies = append(cacheTallies, field, count)
+	}
+	pipe := a.redisClient.TxPipeline()
+	pipe.HSet(context.Background(), pollVotesKey, cacheTallies...)
+	pipe.Expire(context.Background(), pollVotesKey, pollTalliesCacheTime)
+	_, err = pipe.Exec(context.Background())
+	if err != nil {
+		a.logger.Debug(err.Error())
+	}
+
+	return tallies, nil
+}
+
+func isPollClosed(poll *types.Poll) bool {
+	return poll.ClosesAt.Valid && !poll.ClosesAt.Time.After(time.Now())
+}
@@ -3,6 +3,7 @@ package authen_and_post_svc
 import (
 	"context"
 	"crypto/rand"
+	"database/sql"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -19,38 +20,74 @@ import (
 	pb_nfp "github.com/maxuanquang/social-network/pkg/types/proto/pb/newsfeed_publishing"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// defaultMaxPinnedPosts is used when max_pinned_posts is not configured
+const defaultMaxPinnedPosts = 3
+
 func (a *AuthenticateAndPostService) CreatePost(ctx context.Context, info *pb_aap.CreatePostRequest) (*pb_aap.CreatePostResponse, error) {
 	a.logger.Debug("start creating post")
 	defer a.logger.Debug("end creating post")
 
-	exist, _ := a.findUserById(info.GetUserId())
+	exist, user := a.findUserById(info.GetUserId())
 	if !exist {
 		return &pb_aap.CreatePostResponse{Status: pb_aap.CreatePostResponse_USER_NOT_FOUND}, nil
 	}
+	if a.cfg.EmailVerification.RequiredToPost && !user.EmailVerifiedAt.Valid {
+		return &pb_aap.CreatePostResponse{Status: pb_aap.CreatePostResponse_EMAIL_NOT_VERIFIED}, nil
+	}
+	if info.GetPoll() != nil && !validatePoll(info.GetPoll()) {
+		return &pb_aap.CreatePostResponse{Status: pb_aap.CreatePostResponse_INVALID_POLL}, nil
+	}
 
 	newPost := types.Post{
 		UserID:           info.GetUserId(),
 		ContentText:      info.GetContentText(),
 		ContentImagePath: strings.Join(info.GetContentImagePath(), " "),
 	}
+	if info.AudienceListId != nil {
+		exist, _ := a.findAudienceList(info.GetUserId(), info.GetAudienceListId())
+		if !exist {
+			return &pb_aap.CreatePostResponse{Status: pb_aap.CreatePostResponse_AUDIENCE_LIST_NOT_FOUND}, nil
+		}
+		newPost.AudienceListID = sql.NullInt64{Int64: info.GetAudienceListId(), Valid: true}
+	}
 	if !info.GetVisible() {
 		newPost.DeletedAt.Valid = true
 		newPost.DeletedAt.Time = time.Now()
 	}
-	result := a.db.Create(&newPost)
-	if result.Error != nil {
-		return nil, result.Error
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&newPost).Error
+		if err != nil {
+			return err
+		}
+		if info.GetVisible() {
+			err = a.updateUserCounter(tx, "posts_count", 1, info.GetUserId())
+			if err != nil {
+				return err
+			}
+		}
+		if info.GetPoll() != nil {
+			return tx.Create(newPoll(int64(newPost.ID), info.GetPoll())).Error
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Send user_id and post_id to NewsfeedPublishingClient to announce to followers
 	a.nfPubClient.PublishPost(ctx, &pb_nfp.PublishPostRequest{
-		UserId:    newPost.UserID,
-		PostId:    int64(newPost.ID),
-		CreatedAt: timestamppb.New(newPost.CreatedAt),
+		UserId:         newPost.UserID,
+		PostId:         int64(newPost.ID),
+		CreatedAt:      timestamppb.New(newPost.CreatedAt),
+		AudienceListId: newPost.AudienceListID.Int64,
 	})
 
+	// Link preview is fetched in background, the post is shown as plain text until it is ready
+	a.enqueueLinkPreview(int64(newPost.ID), newPost.ContentText)
+
 	return &pb_aap.CreatePostResponse{
 		Status: pb_aap.CreatePostResponse_OK,
 		PostId: int64(newPost.ID),
@@ -80,6 +117,7 @@ func (a *AuthenticateAndPostService) EditPost(ctx context.Context, info *pb_aap.
 	if info.ContentImagePath != nil {
 		post.ContentImagePath = info.GetContentImagePath()
 	}
+	wasVisible := !post.DeletedAt.Valid
 	if info.Visible != nil {
 		if info.GetVisible() {
 			post.DeletedAt.Valid = false
@@ -89,7 +127,18 @@ func (a *AuthenticateAndPostService) EditPost(ctx context.Context, info *pb_aap.
 		}
 	}
 
-	err := a.db.Save(&post).Error
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Save(&post).Error
+		if err != nil {
+			return err
+		}
+		if wasVisible && post.DeletedAt.Valid {
+			return a.updateUserCounter(tx, "posts_count", -1, post.UserID)
+		} else if !wasVisible && !post.DeletedAt.Valid {
+			return a.updateUserCounter(tx, "posts_count", 1, post.UserID)
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -117,22 +166,24 @@ func (a *AuthenticateAndPostService) DeletePost(ctx context.Context, info *pb_aa
 	}
 
 	// Delete image on S3
-	imgPaths := strings.Split(post.ContentImagePath, " ")
-	for _, path := range imgPaths {
-		components := strings.Split(path, "/")
-		key := components[len(components)-1]
+	a.deleteImages(post.UserID, strings.Split(post.ContentImagePath, " "))
 
-		_, err := a.s3Client.DeleteObject(&s3.DeleteObjectInput{
-			Bucket: aws.String(os.Getenv("AWS_S3_BUCKET")),
-			Key:    aws.String(key),
-		})
+	// Delete post in db
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Delete(&post).Error
 		if err != nil {
-			a.logger.Error(err.Error())
+			return err
 		}
+		return a.updateUserCounter(tx, "posts_count", -1, post.UserID)
+	})
+	if err != nil {
+		return nil, err
 	}
-
-	// Delete post in db
-	err := a.db.Delete(&post).Error
+	err = a.db.Where(&types.PinnedPost{PostID: int64(post.ID)}).Delete(&types.PinnedPost{}).Error
+	if err != nil {
+		return nil, err
+	}
+	err = a.db.Where(&types.LinkPreview{PostID: int64(post.ID)}).Delete(&types.LinkPreview{}).Error
 	if err != nil {
 		return nil, err
 	}
@@ -165,8 +216,32 @@ func (a *AuthenticateAndPostService) GetPostDetailInfo(ctx context.Context, info
 		a.cachePost(&post)
 	}
 
+	// Content of users blocked by or blocking the viewer is hidden
+	blockedUsersIds := make(map[int64]bool)
+	if info.GetViewerId() != 0 {
+		var err error
+		blockedUsersIds, err = a.findBlockedUsersIds(info.GetViewerId())
+		if err != nil {
+			return nil, err
+		}
+		if blockedUsersIds[post.UserID] {
+			return &pb_aap.GetPostDetailInfoResponse{Status: pb_aap.GetPostDetailInfoResponse_POST_NOT_FOUND}, nil
+		}
+	}
+
+	canView, err := a.canViewPost(info.GetViewerId(), &post)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		return &pb_aap.GetPostDetailInfoResponse{Status: pb_aap.GetPostDetailInfoResponse_POST_NOT_FOUND}, nil
+	}
+
 	var comments []*pb_aap.Comment
 	for i := range post.Comments {
+		if blockedUsersIds[post.Comments[i].UserID] {
+			continue
+		}
 		comments = append(comments, &pb_aap.Comment{
 			CommentId:   int64(post.Comments[i].ID),
 			UserId:      post.Comments[i].UserID,
@@ -177,12 +252,25 @@ func (a *AuthenticateAndPostService) GetPostDetailInfo(ctx context.Context, info
 
 	var likedUsers []*pb_aap.Like
 	for i := range post.LikedUsers {
+		if blockedUsersIds[int64(post.LikedUsers[i].ID)] {
+			continue
+		}
 		likedUsers = append(likedUsers, &pb_aap.Like{
 			UserId: int64(post.LikedUsers[i].ID),
 			PostId: int64(post.ID),
 		})
 	}
 
+	var poll *pb_aap.Poll
+	exist, postPoll := a.findPollByPostId(int64(post.ID))
+	if exist {
+		var err error
+		poll, err = a.newPbPoll(&postPoll)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &pb_aap.GetPostDetailInfoResponse{
 		Status: pb_aap.GetPostDetailInfoResponse_OK,
 		Post: &pb_aap.PostDetailInfo{
@@ -193,6 +281,8 @@ func (a *AuthenticateAndPostService) GetPostDetailInfo(ctx context.Context, info
 			CreatedAt:        timestamppb.New(post.CreatedAt),
 			Comments:         comments,
 			LikedUsers:       likedUsers,
+			Poll:             poll,
+			LinkPreview:      a.findLinkPreviewByPostId(int64(post.ID)),
 		},
 	}, nil
 }
@@ -205,17 +295,31 @@ func (a *AuthenticateAndPostService) CommentPost(ctx context.Context, info *pb_a
 	if !exist {
 		return &pb_aap.CommentPostResponse{Status: pb_aap.CommentPostResponse_USER_NOT_FOUND}, nil
 	}
-	exist, _ = a.findPostById(info.GetPostId())
+	exist, post := a.findPostById(info.GetPostId())
 	if !exist {
 		return &pb_aap.CommentPostResponse{Status: pb_aap.CommentPostResponse_POST_NOT_FOUND}, nil
 	}
+	blocked, err := a.isBlocked(info.GetUserId(), post.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return &pb_aap.CommentPostResponse{Status: pb_aap.CommentPostResponse_NOT_ALLOWED}, nil
+	}
+	canView, err := a.canViewPost(info.GetUserId(), &post)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		return &pb_aap.CommentPostResponse{Status: pb_aap.CommentPostResponse_POST_NOT_FOUND}, nil
+	}
 
 	var newComment = types.Comment{
 		PostID:      info.GetPostId(),
 		UserID:      info.GetUserId(),
 		ContentText: info.GetContentText(),
 	}
-	err := a.db.Create(&newComment).Error
+	err = a.db.Create(&newComment).Error
 	if err != nil {
 		return nil, err
 	}
@@ -246,10 +350,24 @@ func (a *AuthenticateAndPostService) LikePost(ctx context.Context, info *pb_aap.
 	if !exist {
 		return &pb_aap.LikePostResponse{Status: pb_aap.LikePostResponse_USER_NOT_FOUND}, nil
 	}
-	exist, _ = a.findPostById(info.GetPostId())
+	exist, post := a.findPostById(info.GetPostId())
 	if !exist {
 		return &pb_aap.LikePostResponse{Status: pb_aap.LikePostResponse_POST_NOT_FOUND}, nil
 	}
+	blocked, err := a.isBlocked(info.GetUserId(), post.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return &pb_aap.LikePostResponse{Status: pb_aap.LikePostResponse_NOT_ALLOWED}, nil
+	}
+	canView, err := a.canViewPost(info.GetUserId(), &post)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		return &pb_aap.LikePostResponse{Status: pb_aap.LikePostResponse_POST_NOT_FOUND}, nil
+	}
 
 	var like types.Like
 	a.db.Raw("select * from `like` where user_id = ? and post_id = ?", info.GetUserId(), info.GetPostId()).Scan(&like)
@@ -288,14 +406,21 @@ func (a *AuthenticateAndPostService) GetS3PresignedUrl(ctx context.Context, info
 	a.logger.Debug("start getting s3 presigned url")
 	defer a.logger.Debug("end getting s3 presigned url")
 
-	// userExist, _ := a.findUserById(info.GetUserId())
-	// if !userExist {
-	// 	return &pb_aap.GetS3PresignedUrlResponse{Status: pb_aap.GetS3PresignedUrlResponse_USER_NOT_FOUND}, nil
-	// }
+	userExist, _ := a.findUserById(info.GetUserId())
+	if !userExist {
+		return &pb_aap.GetS3PresignedUrlResponse{Status: pb_aap.GetS3PresignedUrlResponse_USER_NOT_FOUND}, nil
+	}
+
+	// Remember who the key was issued to, images can only be deleted on behalf of their uploader
+	key := a.getRandomS3Key(64)
+	err := a.db.Create(&types.UploadedImage{S3Key: key, UserID: info.GetUserId()}).Error
+	if err != nil {
+		return nil, err
+	}
 
 	req, _ := a.s3Client.PutObjectRequest(&s3.PutObjectInput{
 		Bucket: aws.String(os.Getenv("AWS_S3_BUCKET")),
-		Key:    aws.String(a.getRandomS3Key(64)),
+		Key:    aws.String(key),
 	})
 	url, err := req.Presign(time.Minute)
 	expirationTime := time.Now().Add(time.Minute)
@@ -311,6 +436,94 @@ func (a *AuthenticateAndPostService) GetS3PresignedUrl(ctx context.Context, info
 	}, nil
 }
 
+func (a *AuthenticateAndPostService) PinPost(ctx context.Context, info *pb_aap.PinPostRequest) (*pb_aap.PinPostResponse, error) {
+	a.logger.Debug("start pinning post")
+	defer a.logger.Debug("end pinning post")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.PinPostResponse{Status: pb_aap.PinPostResponse_USER_NOT_FOUND}, nil
+	}
+	exist, post := a.findPostById(info.GetPostId())
+	if !exist {
+		return &pb_aap.PinPostResponse{Status: pb_aap.PinPostResponse_POST_NOT_FOUND}, nil
+	}
+	if user.ID != uint(post.UserID) {
+		return &pb_aap.PinPostResponse{Status: pb_aap.PinPostResponse_NOT_ALLOWED}, nil
+	}
+
+	maxPinnedPosts := a.cfg.MaxPinnedPosts
+	if maxPinnedPosts <= 0 {
+		maxPinnedPosts = defaultMaxPinnedPosts
+	}
+	status := pb_aap.PinPostResponse_OK
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		// Locking the user row serializes pins of the user, so concurrent pins can not exceed the limit
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&types.User{}, info.GetUserId()).Error
+		if err != nil {
+			return err
+		}
+
+		var pinnedPosts []types.PinnedPost
+		err = tx.Where(&types.PinnedPost{UserID: info.GetUserId()}).Find(&pinnedPosts).Error
+		if err != nil {
+			return err
+		}
+		for _, pinnedPost := range pinnedPosts {
+			if pinnedPost.PostID == info.GetPostId() {
+				status = pb_aap.PinPostResponse_ALREADY_PINNED
+				return nil
+			}
+		}
+		if len(pinnedPosts) >= maxPinnedPosts {
+			status = pb_aap.PinPostResponse_PIN_LIMIT_REACHED
+			return nil
+		}
+
+		return tx.Create(&types.PinnedPost{
+			UserID: info.GetUserId(),
+			PostID: info.GetPostId(),
+		}).Error
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return &pb_aap.PinPostResponse{Status: pb_aap.PinPostResponse_ALREADY_PINNED}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.PinPostResponse{
+		Status: status,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) UnpinPost(ctx context.Context, info *pb_aap.UnpinPostRequest) (*pb_aap.UnpinPostResponse, error) {
+	a.logger.Debug("start unpinning post")
+	defer a.logger.Debug("end unpinning post")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.UnpinPostResponse{Status: pb_aap.UnpinPostResponse_USER_NOT_FOUND}, nil
+	}
+	// Invisible posts can still be unpinned, so soft deleted posts are included here
+	var post types.Post
+	result := a.db.Unscoped().First(&post, info.GetPostId())
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return &pb_aap.UnpinPostResponse{Status: pb_aap.UnpinPostResponse_POST_NOT_FOUND}, nil
+	}
+
+	result = a.db.Where(&types.PinnedPost{UserID: info.GetUserId(), PostID: info.GetPostId()}).Delete(&types.PinnedPost{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &pb_aap.UnpinPostResponse{Status: pb_aap.UnpinPostResponse_NOT_PINNED}, nil
+	}
+
+	return &pb_aap.UnpinPostResponse{
+		Status: pb_aap.UnpinPostResponse_OK,
+	}, nil
+}
+
 // findPostById checks if a post with provided postId exists in database
 func (a *AuthenticateAndPostService) findPostById(postId int64) (exist bool, post types.Post) {
 	result := a.db.First(&post, postId)
@@ -320,6 +533,20 @@ func (a *AuthenticateAndPostService) findPostById(postId int64) (exist bool, pos
 	return true, post
 }
 
+// canViewPost checks if viewerId can read post, posts of private accounts are only visible to approved followers
+// and posts shared with an audience list to its members. Blocks are checked by the callers since they are reported differently.
+func (a *AuthenticateAndPostService) canViewPost(viewerId int64, post *types.Post) (bool, error) {
+	_, author := a.findUserById(post.UserID)
+	canView, err := a.canViewPosts(viewerId, &author)
+	if err != nil || !canView {
+		return false, err
+	}
+	if post.AudienceListID.Valid {
+		return a.canViewAudience(viewerId, post.UserID, post.AudienceListID.Int64)
+	}
+	return true, nil
+}
+
 // cachPost caches post and relevant information in cache
 func (a *AuthenticateAndPostService) cachePost(post *types.Post) error {
 	a.logger.Debug("start caching post")
@@ -367,6 +594,7 @@ func (a *AuthenticateAndPostService) cachePost(post *types.Post) error {
 		ContentText:      post.ContentText,
 		ContentImagePath: post.ContentImagePath,
 		UserID:           post.UserID,
+		AudienceListID:   post.AudienceListID,
 	}, postKey, 15*time.Minute)
 
 	return nil
@@ -487,5 +715,48 @@ func (a *AuthenticateAndPostService) getRandomS3Key(length int) string {
 	if err != nil {
 		panic(err)
 	}
-	return base64.StdEncoding.EncodeToString(randomBytes)[:length]
+	// The url-safe alphabet keeps the key usable as the last component of an image path
+	return base64.RawURLEncoding.EncodeToString(randomBytes)[:length]
+}
+
+// deleteImages deletes images uploaded by userId on S3, paths of images uploaded by other users are skipped.
+// Failures are only logged.
+func (a *AuthenticateAndPostService) deleteImages(userId int64, imgPaths []string) {
+	var keys []string
+	for _, path := range imgPaths {
+		if path == "" {
+			continue
+		}
+		components := strings.Split(path, "/")
+		keys = append(keys, components[len(components)-1])
+	}
+	if len(keys) == 0 {
+		return
+	}
+
+	var ownedKeys []string
+	err := a.db.Model(&types.UploadedImage{}).Where("user_id = ? and s3_key in ?", userId, keys).Pluck("s3_key", &ownedKeys).Error
+	if err != nil {
+		a.logger.Error(err.Error())
+		return
+	}
+
+	var deletedKeys []string
+	for _, key := range ownedKeys {
+		_, err := a.s3Client.DeleteObject(&s3.DeleteObjectInput{
+			Bucket: aws.String(os.Getenv("AWS_S3_BUCKET")),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			a.logger.Error(err.Error())
+			continue
+		}
+		deletedKeys = append(deletedKeys, key)
+	}
+	if len(deletedKeys) > 0 {
+		err = a.db.Where("s3_key in ?", deletedKeys).Delete(&types.UploadedImage{}).Error
+		if err != nil {
+			a.logger.Error(err.Error())
+		}
+	}
 }
@@ -0,0 +1,125 @@
+package authen_and_post_svc
+
+import (
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+)
+
+var visibilitiesToProto = map[string]pb_aap.ProfileVisibility_Visibility{
+	types.VisibilityPublic:    pb_aap.ProfileVisibility_PUBLIC,
+	types.VisibilityFollowers: pb_aap.ProfileVisibility_FOLLOWERS,
+	types.VisibilityOnlyMe:    pb_aap.ProfileVisibility_ONLY_ME,
+}
+
+var visibilitiesFromProto = map[pb_aap.ProfileVisibility_Visibility]string{
+	pb_aap.ProfileVisibility_PUBLIC:    types.VisibilityPublic,
+	pb_aap.ProfileVisibility_FOLLOWERS: types.VisibilityFollowers,
+	pb_aap.ProfileVisibility_ONLY_ME:   types.VisibilityOnlyMe,
+}
+
+// profileFieldVisibility returns the visibility of a profile field, users cached before the visibility
+// settings existed have no value and get the default of the field
+func profileFieldVisibility(visibility string, defaultVisibility string) string {
+	if _, ok := visibilitiesToProto[visibility]; !ok {
+		return defaultVisibility
+	}
+	return visibility
+}
+
+func newProfileVisibility(user types.User) *pb_aap.ProfileVisibility {
+	email := visibilitiesToProto[profileFieldVisibility(user.EmailVisibility, types.VisibilityOnlyMe)]
+	dateOfBirth := visibilitiesToProto[profileFieldVisibility(user.DateOfBirthVisibility, types.VisibilityOnlyMe)]
+	bio := visibilitiesToProto[profileFieldVisibility(user.BioVisibility, types.VisibilityPublic)]
+	location := visibilitiesToProto[profileFieldVisibility(user.LocationVisibility, types.VisibilityPublic)]
+	website := visibilitiesToProto[profileFieldVisibility(user.WebsiteVisibility, types.VisibilityPublic)]
+	pronouns := visibilitiesToProto[profileFieldVisibility(user.PronounsVisibility, types.VisibilityPublic)]
+	return &pb_aap.ProfileVisibility{
+		Email:       &email,
+		DateOfBirth: &dateOfBirth,
+		Bio:         &bio,
+		Location:    &location,
+		Website:     &website,
+		Pronouns:    &pronouns,
+	}
+}
+
+// setProfileVisibility changes the visibility of the profile fields set in visibility
+func setProfileVisibility(user *types.User, visibility *pb_aap.ProfileVisibility) {
+	if visibility.Email != nil {
+		user.EmailVisibility = visibilitiesFromProto[visibility.GetEmail()]
+	}
+	if visibility.DateOfBirth != nil {
+		user.DateOfBirthVisibility = visibilitiesFromProto[visibility.GetDateOfBirth()]
+	}
+	if visibility.Bio != nil {
+		user.BioVisibility = visibilitiesFromProto[visibility.GetBio()]
+	}
+	if visibility.Location != nil {
+		user.LocationVisibility = visibilitiesFromProto[visibility.GetLocation()]
+	}
+	if visibility.Website != nil {
+		user.WebsiteVisibility = visibilitiesFromProto[visibility.GetWebsite()]
+	}
+	if visibility.Pronouns != nil {
+		user.PronounsVisibility = visibilitiesFromProto[visibility.GetPronouns()]
+	}
+}
+
+// filterProfileFields clears the fields of info viewerId is not allowed to see, viewerId is 0 for anonymous viewers.
+// The user itself sees every field along with their visibility.
+func (a *AuthenticateAndPostService) filterProfileFields(info *pb_aap.UserDetailInfo, user types.User, viewerId int64) error {
+	if viewerId == int64(user.ID) {
+		return nil
+	}
+	info.ProfileVisibility = nil
+
+	// The follow edge is only looked up when a field needs it
+	var following, checked bool
+	canView := func(visibility string) (bool, error) {
+		switch visibility {
+		case types.VisibilityPublic:
+			return true, nil
+		case types.VisibilityFollowers:
+			if viewerId == 0 {
+				return false, nil
+			}
+			if !checked {
+				var err error
+				following, err = a.isFollowing(viewerId, int64(user.ID))
+				if err != nil {
+					return false, err
+				}
+				checked = true
+			}
+			return following, nil
+		default:
+			return false, nil
+		}
+	}
+
+	fields := []struct {
+		visibility string
+		clear      func()
+	}{
+		{profileFieldVisibility(user.EmailVisibility, types.VisibilityOnlyMe), func() { info.Email = "" }},
+		{profileFieldVisibility(user.DateOfBirthVisibility, types.VisibilityOnlyMe), func() { info.DateOfBirth = nil }},
+		{profileFieldVisibility(user.BioVisibility, types.VisibilityPublic), func() { info.Bio = "" }},
+		{profileFieldVisibility(user.LocationVisibility, types.VisibilityPublic), func() { info.Location = "" }},
+		{profileFieldVisibility(user.WebsiteVisibility, types.VisibilityPublic), func() { info.Website = "" }},
+		{profileFieldVisibility(user.PronounsVisibility, types.VisibilityPublic), func() { info.Pronouns = "" }},
+	}
+	for _, field := range fields {
+		ok, err := canView(field.visibility)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			field.clear()
+		}
+	}
+	// Whether the email is verified tells nothing once the email is hidden
+	if info.Email == "" {
+		info.EmailVerified = false
+	}
+	return nil
+}
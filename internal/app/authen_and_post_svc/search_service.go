@@ -0,0 +1,200 @@
+package authen_and_post_svc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+)
+
+const (
+	// userSearchKey is a sorted set whose members are "<lowercase name>:<user id>" with the same score,
+	// so that a prefix is looked up as a lexicographical range
+	userSearchKey = "users:search"
+	// userSearchBatchSize is the number of index entries read at once, an user has up to 4 entries
+	userSearchBatchSize = 100
+)
+
+func (a *AuthenticateAndPostService) SearchUsers(ctx context.Context, info *pb_aap.SearchUsersRequest) (*pb_aap.SearchUsersResponse, error) {
+	a.logger.Debug("start searching users")
+	defer a.logger.Debug("end searching users")
+
+	prefix := normalizeSearchTerm(info.GetQuery())
+	if prefix == "" {
+		return &pb_aap.SearchUsersResponse{Status: pb_aap.SearchUsersResponse_OK}, nil
+	}
+
+	var blockedUsersIds map[int64]bool
+	if info.GetViewerId() != 0 {
+		var err error
+		blockedUsersIds, err = a.findBlockedUsersIds(info.GetViewerId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// An user matching several names is returned once, in the order of its first match
+	limit := pageLimit(info.GetLimit())
+	seen := make(map[int64]bool)
+	var usersIds []int64
+	var offset int64
+	for len(usersIds) < limit {
+		members, err := a.redisClient.ZRangeByLex(ctx, userSearchKey, &redis.ZRangeBy{
+			Min:    "[" + prefix,
+			Max:    "[" + prefix + "\xff",
+			Offset: offset,
+			Count:  userSearchBatchSize,
+		}).Result()
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			userId, err := strconv.ParseInt(member[strings.LastIndex(member, ":")+1:], 10, 64)
+			if err != nil {
+				a.logger.Debug(err.Error())
+				continue
+			}
+			if seen[userId] || blockedUsersIds[userId] {
+				continue
+			}
+			seen[userId] = true
+			usersIds = append(usersIds, userId)
+		}
+		if len(members) < userSearchBatchSize {
+			break
+		}
+		offset += userSearchBatchSize
+	}
+	if len(usersIds) > limit {
+		usersIds = usersIds[:limit]
+	}
+	if len(usersIds) == 0 {
+		return &pb_aap.SearchUsersResponse{Status: pb_aap.SearchUsersResponse_OK}, nil
+	}
+
+	// Users scheduled for deletion are not searchable
+	var users []types.User
+	err := a.db.Where("id in ? and deletion_scheduled_at is null", usersIds).Find(&users).Error
+	if err != nil {
+		return nil, err
+	}
+	usersById := make(map[int64]types.User)
+	for _, user := range users {
+		usersById[int64(user.ID)] = user
+	}
+
+	var results []*pb_aap.UserSearchResult
+	for _, userId := range usersIds {
+		user, ok := usersById[userId]
+		if !ok {
+			continue
+		}
+		results = append(results, &pb_aap.UserSearchResult{
+			UserId:         int64(user.ID),
+			UserName:       user.UserName,
+			FirstName:      user.FirstName,
+			LastName:       user.LastName,
+			ProfilePicture: user.ProfilePicture,
+		})
+	}
+
+	return &pb_aap.SearchUsersResponse{
+		Status: pb_aap.SearchUsersResponse_OK,
+		Users:  results,
+	}, nil
+}
+
+// updateUserSearchIndex replaces the index entries of previous user with the ones of current user,
+// previous is nil for new users and current is nil for purged users
+func (a *AuthenticateAndPostService) updateUserSearchIndex(ctx context.Context, previous *types.User, current *types.User) {
+	var previousMembers, currentMembers []string
+	if previous != nil {
+		previousMembers = userSearchMembers(previous)
+	}
+	if current != nil {
+		currentMembers = userSearchMembers(current)
+	}
+
+	kept := make(map[string]bool)
+	for _, member := range currentMembers {
+		kept[member] = true
+	}
+	var removed []interface{}
+	for _, member := range previousMembers {
+		if !kept[member] {
+			removed = append(removed, member)
+		}
+	}
+	var added []*redis.Z
+	for _, member := range currentMembers {
+		added = append(added, &redis.Z{Score: 0, Member: member})
+	}
+
+	pipe := a.redisClient.TxPipeline()
+	if len(removed) > 0 {
+		pipe.ZRem(ctx, userSearchKey, removed...)
+	}
+	if len(added) > 0 {
+		pipe.ZAdd(ctx, userSearchKey, added...)
+	}
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		a.logger.Error(err.Error())
+	}
+}
+
+// buildUserSearchIndex indexes all users when the index does not exist yet, e.g. on the first start
+func (a *AuthenticateAndPostService) buildUserSearchIndex() {
+	ctx := context.Background()
+	if a.redisClient.Exists(ctx, userSearchKey).Val() == 1 {
+		return
+	}
+
+	var lastId int64
+	for {
+		var users []types.User
+		err := a.db.Select("id", "user_name", "first_name", "last_name").Where("id > ?", lastId).
+			Order("id").Limit(userSearchBatchSize).Find(&users).Error
+		if err != nil {
+			a.logger.Error(err.Error())
+			return
+		}
+		for i := range users {
+			a.updateUserSearchIndex(ctx, nil, &users[i])
+		}
+		if len(users) < userSearchBatchSize {
+			return
+		}
+		lastId = int64(users[len(users)-1].ID)
+	}
+}
+
+// userSearchMembers returns the index entries of an user: its user name, first name, last name and full name
+func userSearchMembers(user *types.User) []string {
+	terms := []string{
+		user.UserName,
+		user.FirstName,
+		user.LastName,
+		user.FirstName + " " + user.LastName,
+	}
+	seen := make(map[string]bool)
+	var members []string
+	for _, term := range terms {
+		term = normalizeSearchTerm(term)
+		if term == "" || seen[term] {
+			continue
+		}
+		seen[term] = true
+		members = append(members, fmt.Sprintf("%s:%d", term, user.ID))
+	}
+	return members
+}
+
+// normalizeSearchTerm makes lookups case insensitive and ignores surrounding and repeated spaces
+func normalizeSearchTerm(term string) string {
+	return strings.ToLower(strings.Join(strings.Fields(term), " "))
+}
@@ -2,13 +2,17 @@ package authen_and_post_svc
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/go-redis/redis/v8"
 	"github.com/joho/godotenv"
 	"github.com/maxuanquang/social-network/configs"
+	"github.com/maxuanquang/social-network/internal/auth"
+	"github.com/maxuanquang/social-network/internal/pkg/mail"
 	"github.com/maxuanquang/social-network/internal/pkg/types"
+	"github.com/maxuanquang/social-network/internal/pkg/unfurl"
 	"github.com/maxuanquang/social-network/internal/utils"
 	client_nfp "github.com/maxuanquang/social-network/pkg/client/newsfeed_publishing"
 	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
@@ -18,12 +22,24 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
 type AuthenticateAndPostService struct {
 	pb_aap.UnimplementedAuthenticateAndPostServer
 	db          *gorm.DB
 	nfPubClient pb_nfp.NewsfeedPublishingClient
 	redisClient *redis.Client
 	s3Client    *s3.S3
+	cfg         *configs.AuthenticateAndPostConfig
+	mailSender  mail.Sender
+
+	passwordHasher *auth.PasswordHasher
+
+	unfurlFetcher   *unfurl.Fetcher
+	linkPreviewJobs chan linkPreviewJob
 
 	logger *zap.Logger
 }
@@ -33,7 +49,9 @@ func NewAuthenticateAndPostService(cfg *configs.AuthenticateAndPostConfig) (*Aut
 	mysqlConfig := mysql.Config{
 		DSN: cfg.MySQL.DSN,
 	}
-	db, err := gorm.Open(mysql.New(mysqlConfig), &gorm.Config{})
+	// TranslateError makes every query return gorm.ErrDuplicatedKey and gorm.ErrForeignKeyViolated
+	// instead of raw MySQL errors, unique constraint violations are detected with them
+	db, err := gorm.Open(mysql.New(mysqlConfig), &gorm.Config{TranslateError: true})
 	if err != nil {
 		return nil, err
 	}
@@ -59,19 +77,65 @@ func NewAuthenticateAndPostService(cfg *configs.AuthenticateAndPostConfig) (*Aut
 		return nil, err
 	}
 
+	// Establish mail sender
+	mailSender, err := mail.NewSender(&cfg.Mail)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens signed with a weak secret could be forged
+	err = auth.CheckSecret([]byte(cfg.EmailVerification.Secret))
+	if err != nil {
+		return nil, fmt.Errorf("email verification secret: %w", err)
+	}
+
+	// Establish password hasher
+	passwordHasher, err := auth.NewPasswordHasher(&cfg.PasswordHashing)
+	if err != nil {
+		return nil, err
+	}
+
 	// Establish logger
 	logger, err := utils.NewLogger(&cfg.Logger)
 	if err != nil {
 		return nil, err
 	}
 
-	return &AuthenticateAndPostService{
+	service := &AuthenticateAndPostService{
 		db:          db,
 		nfPubClient: nfPubClient,
 		redisClient: redisClient,
 		s3Client:    s3Client,
+		cfg:         cfg,
+		mailSender:  mailSender,
 		logger:      logger,
-	}, nil
+
+		passwordHasher: passwordHasher,
+	}
+
+	// Start link preview worker
+	if cfg.LinkPreview.Enabled {
+		service.unfurlFetcher = unfurl.NewFetcher(cfg.LinkPreview.Timeout, cfg.LinkPreview.MaxBodySize)
+		service.linkPreviewJobs = make(chan linkPreviewJob, linkPreviewQueueSize)
+		go service.runLinkPreviewWorker()
+	}
+
+	// Start deleting expired stories
+	go service.runStoriesCleanup()
+
+	// Start purging accounts whose deletion grace period is over
+	go service.runAccountDeletion()
+
+	// Start building requested data exports
+	go service.runDataExports()
+
+	// Start recomputing follow suggestions
+	go service.runSuggestions()
+
+	// Start indexing existing users for search
+	go service.buildUserSearchIndex()
+
+	return service, nil
 }
 
 func NewS3Client() (*s3.S3, error) {
@@ -90,6 +154,17 @@ func NewS3Client() (*s3.S3, error) {
 	return s3.New(sess), nil
 }
 
+// pageLimit returns the number of items a paginated request can get
+func pageLimit(limit int32) int {
+	if limit <= 0 {
+		return defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		return maxPageLimit
+	}
+	return int(limit)
+}
+
 // findUserById checks if an user with provided userId exists in database
 func (a *AuthenticateAndPostService) findUserById(userId int64) (exist bool, user types.User) {
 	result := a.db.First(&user, userId)
@@ -99,6 +174,15 @@ func (a *AuthenticateAndPostService) findUserById(userId int64) (exist bool, use
 	return true, user
 }
 
+// findUserByEmail checks if an user with provided email exists in database
+func (a *AuthenticateAndPostService) findUserByEmail(email string) (exist bool, user types.User) {
+	result := a.db.Where("email = ?", email).First(&user)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return false, types.User{}
+	}
+	return true, user
+}
+
 // findUserByUserName checks if an user with provided username exists in database
 func (a *AuthenticateAndPostService) findUserByUserName(userName string) (exist bool, user types.User) {
 	result := a.db.Where(&types.User{UserName: userName}).First(&user)
@@ -0,0 +1,315 @@
+package authen_and_post_svc
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	storyLifetime             = 24 * time.Hour
+	storiesCleanupInterval    = 10 * time.Minute
+	storiesCleanupBatchSize   = 100
+	maxStoryContentTextLength = 1000
+)
+
+func (a *AuthenticateAndPostService) CreateStory(ctx context.Context, info *pb_aap.CreateStoryRequest) (*pb_aap.CreateStoryResponse, error) {
+	a.logger.Debug("start creating story")
+	defer a.logger.Debug("end creating story")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.CreateStoryResponse{Status: pb_aap.CreateStoryResponse_USER_NOT_FOUND}, nil
+	}
+	contentText := strings.TrimSpace(info.GetContentText())
+	if (contentText == "" && info.GetContentImagePath() == "") || len(contentText) > maxStoryContentTextLength {
+		return &pb_aap.CreateStoryResponse{Status: pb_aap.CreateStoryResponse_INVALID_STORY}, nil
+	}
+
+	now := time.Now()
+	newStory := types.Story{
+		CreatedAt:        now,
+		ExpiresAt:        now.Add(storyLifetime),
+		UserID:           info.GetUserId(),
+		ContentText:      contentText,
+		ContentImagePath: info.GetContentImagePath(),
+	}
+	err := a.db.Create(&newStory).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.CreateStoryResponse{
+		Status:  pb_aap.CreateStoryResponse_OK,
+		StoryId: int64(newStory.ID),
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) GetStory(ctx context.Context, info *pb_aap.GetStoryRequest) (*pb_aap.GetStoryResponse, error) {
+	a.logger.Debug("start getting story")
+	defer a.logger.Debug("end getting story")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetStoryResponse{Status: pb_aap.GetStoryResponse_USER_NOT_FOUND}, nil
+	}
+	exist, story := a.findActiveStoryById(info.GetStoryId())
+	if !exist {
+		return &pb_aap.GetStoryResponse{Status: pb_aap.GetStoryResponse_STORY_NOT_FOUND}, nil
+	}
+
+	// Stories follow the visibility of posts: hidden between blocked users, and only shown to
+	// approved followers of private accounts
+	blockedUsersIds, err := a.findBlockedUsersIds(info.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if blockedUsersIds[story.UserID] {
+		return &pb_aap.GetStoryResponse{Status: pb_aap.GetStoryResponse_STORY_NOT_FOUND}, nil
+	}
+	_, author := a.findUserById(story.UserID)
+	canView, err := a.canViewPosts(info.GetUserId(), &author)
+	if err != nil {
+		return nil, err
+	}
+	if !canView {
+		return &pb_aap.GetStoryResponse{Status: pb_aap.GetStoryResponse_STORY_NOT_FOUND}, nil
+	}
+
+	// Record the view, views of the owner are not recorded
+	if story.UserID != info.GetUserId() {
+		err = a.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&types.StoryView{
+			StoryID: int64(story.ID),
+			UserID:  info.GetUserId(),
+		}).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb_aap.GetStoryResponse{
+		Status: pb_aap.GetStoryResponse_OK,
+		Story: &pb_aap.Story{
+			StoryId:          int64(story.ID),
+			UserId:           story.UserID,
+			ContentText:      story.ContentText,
+			ContentImagePath: story.ContentImagePath,
+			CreatedAt:        timestamppb.New(story.CreatedAt),
+			ExpiresAt:        timestamppb.New(story.ExpiresAt),
+		},
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) DeleteStory(ctx context.Context, info *pb_aap.DeleteStoryRequest) (*pb_aap.DeleteStoryResponse, error) {
+	a.logger.Debug("start deleting story")
+	defer a.logger.Debug("end deleting story")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.DeleteStoryResponse{Status: pb_aap.DeleteStoryResponse_USER_NOT_FOUND}, nil
+	}
+	exist, story := a.findActiveStoryById(info.GetStoryId())
+	if !exist {
+		return &pb_aap.DeleteStoryResponse{Status: pb_aap.DeleteStoryResponse_STORY_NOT_FOUND}, nil
+	}
+	if story.UserID != info.GetUserId() {
+		return &pb_aap.DeleteStoryResponse{Status: pb_aap.DeleteStoryResponse_NOT_ALLOWED}, nil
+	}
+
+	err := a.deleteStories([]types.Story{story})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.DeleteStoryResponse{
+		Status: pb_aap.DeleteStoryResponse_OK,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) GetStoriesTray(ctx context.Context, info *pb_aap.GetStoriesTrayRequest) (*pb_aap.GetStoriesTrayResponse, error) {
+	a.logger.Debug("start getting stories tray")
+	defer a.logger.Debug("end getting stories tray")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetStoriesTrayResponse{Status: pb_aap.GetStoriesTrayResponse_USER_NOT_FOUND}, nil
+	}
+
+	followingsIds, err := a.findFollowingsIds(info.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if len(followingsIds) == 0 {
+		return &pb_aap.GetStoriesTrayResponse{Status: pb_aap.GetStoriesTrayResponse_OK}, nil
+	}
+
+	var stories []types.Story
+	err = a.db.Where("user_id in ? and expires_at > ?", followingsIds, time.Now()).
+		Order("created_at").
+		Find(&stories).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(stories) == 0 {
+		return &pb_aap.GetStoriesTrayResponse{Status: pb_aap.GetStoriesTrayResponse_OK}, nil
+	}
+
+	var storiesIds []int64
+	for _, story := range stories {
+		storiesIds = append(storiesIds, int64(story.ID))
+	}
+	var viewedStoriesIds []int64
+	err = a.db.Model(&types.StoryView{}).
+		Where("user_id = ? and story_id in ?", info.GetUserId(), storiesIds).
+		Pluck("story_id", &viewedStoriesIds).Error
+	if err != nil {
+		return nil, err
+	}
+	viewed := make(map[int64]bool)
+	for _, id := range viewedStoriesIds {
+		viewed[id] = true
+	}
+
+	// Group stories by author, an author is seen when all of their stories were viewed
+	var items []*pb_aap.StoriesTrayItem
+	itemsByUser := make(map[int64]*pb_aap.StoriesTrayItem)
+	for _, story := range stories {
+		item, ok := itemsByUser[story.UserID]
+		if !ok {
+			item = &pb_aap.StoriesTrayItem{UserId: story.UserID, Seen: true}
+			itemsByUser[story.UserID] = item
+			items = append(items, item)
+		}
+		item.StoriesIds = append(item.StoriesIds, int64(story.ID))
+		item.LatestCreatedAt = timestamppb.New(story.CreatedAt)
+		if !viewed[int64(story.ID)] {
+			item.Seen = false
+		}
+	}
+
+	// Unseen authors come first, then the most recent ones
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].GetSeen() != items[j].GetSeen() {
+			return !items[i].GetSeen()
+		}
+		return items[i].GetLatestCreatedAt().AsTime().After(items[j].GetLatestCreatedAt().AsTime())
+	})
+
+	return &pb_aap.GetStoriesTrayResponse{
+		Status: pb_aap.GetStoriesTrayResponse_OK,
+		Items:  items,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) GetStoryViewers(ctx context.Context, info *pb_aap.GetStoryViewersRequest) (*pb_aap.GetStoryViewersResponse, error) {
+	a.logger.Debug("start getting story viewers")
+	defer a.logger.Debug("end getting story viewers")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetStoryViewersResponse{Status: pb_aap.GetStoryViewersResponse_USER_NOT_FOUND}, nil
+	}
+	exist, story := a.findActiveStoryById(info.GetStoryId())
+	if !exist {
+		return &pb_aap.GetStoryViewersResponse{Status: pb_aap.GetStoryViewersResponse_STORY_NOT_FOUND}, nil
+	}
+	if story.UserID != info.GetUserId() {
+		return &pb_aap.GetStoryViewersResponse{Status: pb_aap.GetStoryViewersResponse_NOT_ALLOWED}, nil
+	}
+
+	var views []types.StoryView
+	err := a.db.Where(&types.StoryView{StoryID: int64(story.ID)}).Order("created_at desc").Find(&views).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var viewers []*pb_aap.StoryViewer
+	for _, view := range views {
+		viewers = append(viewers, &pb_aap.StoryViewer{
+			UserId:   view.UserID,
+			ViewedAt: timestamppb.New(view.CreatedAt),
+		})
+	}
+
+	return &pb_aap.GetStoryViewersResponse{
+		Status:  pb_aap.GetStoryViewersResponse_OK,
+		Viewers: viewers,
+	}, nil
+}
+
+// findActiveStoryById checks if a story with provided storyId exists and has not expired
+func (a *AuthenticateAndPostService) findActiveStoryById(storyId int64) (exist bool, story types.Story) {
+	result := a.db.Where("expires_at > ?", time.Now()).First(&story, storyId)
+	if result.Error != nil {
+		return false, types.Story{}
+	}
+	return true, story
+}
+
+// runStoriesCleanup periodically deletes expired stories
+func (a *AuthenticateAndPostService) runStoriesCleanup() {
+	ticker := time.NewTicker(storiesCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		err := a.deleteExpiredStories()
+		if err != nil {
+			a.logger.Error(err.Error())
+		}
+		<-ticker.C
+	}
+}
+
+// deleteExpiredStories deletes expired stories in batches, together with their views and images
+func (a *AuthenticateAndPostService) deleteExpiredStories() error {
+	for {
+		var stories []types.Story
+		err := a.db.Where("expires_at <= ?", time.Now()).Limit(storiesCleanupBatchSize).Find(&stories).Error
+		if err != nil {
+			return err
+		}
+		if len(stories) == 0 {
+			return nil
+		}
+
+		err = a.deleteStories(stories)
+		if err != nil {
+			return err
+		}
+		if len(stories) < storiesCleanupBatchSize {
+			return nil
+		}
+	}
+}
+
+func (a *AuthenticateAndPostService) deleteStories(stories []types.Story) error {
+	var storiesIds []int64
+	imgPaths := make(map[int64][]string)
+	for _, story := range stories {
+		storiesIds = append(storiesIds, int64(story.ID))
+		imgPaths[story.UserID] = append(imgPaths[story.UserID], story.ContentImagePath)
+	}
+
+	err := a.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("story_id in ?", storiesIds).Delete(&types.StoryView{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Delete(&types.Story{}, storiesIds).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	for userId, paths := range imgPaths {
+		a.deleteImages(userId, paths)
+	}
+	return nil
+}
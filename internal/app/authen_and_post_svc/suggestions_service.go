@@ -0,0 +1,343 @@
+package authen_and_post_svc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+)
+
+const (
+	defaultSuggestionsRefreshInterval = time.Hour
+	defaultSuggestionsSize            = 50
+	suggestionsBatchSize              = 100
+	// Hashtags of posts created during this window are compared
+	suggestionsHashtagWindow = 30 * 24 * time.Hour
+	// Posts are read in batches, only their hashtags are kept
+	suggestionsPostsBatchSize = 1000
+	// Only the latest users of a hashtag are candidates, popular hashtags would make every user a candidate of everyone
+	suggestionsHashtagMaxUsers = 100
+	// Most followed users are candidates of everyone, it gives suggestions to new users
+	suggestionsPopularUsers = 100
+
+	suggestionsMutualWeight     = 3
+	suggestionsHashtagWeight    = 2
+	suggestionsPopularityWeight = 1
+)
+
+var hashtagRegexp = regexp.MustCompile(`#(\w+)`)
+
+// suggestionsIndex holds the data shared by the suggestions of all users
+type suggestionsIndex struct {
+	hashtagUsers map[string][]int64
+	userHashtags map[int64][]string
+	popularUsers []int64
+}
+
+func (a *AuthenticateAndPostService) GetFollowSuggestions(ctx context.Context, info *pb_aap.GetFollowSuggestionsRequest) (*pb_aap.GetFollowSuggestionsResponse, error) {
+	a.logger.Debug("start getting follow suggestions")
+	defer a.logger.Debug("end getting follow suggestions")
+
+	exist, _ := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.GetFollowSuggestionsResponse{Status: pb_aap.GetFollowSuggestionsResponse_USER_NOT_FOUND}, nil
+	}
+
+	// Suggestions of users who joined after the last refresh are computed on demand, without hashtags since reading
+	// them scans recent posts. The next refresh completes them.
+	suggestionsKey := fmt.Sprintf("suggestions:%d", info.GetUserId())
+	if a.redisClient.Exists(ctx, suggestionsKey).Val() == 0 {
+		popularUsers, err := a.findPopularUsersIds()
+		if err != nil {
+			return nil, err
+		}
+		err = a.refreshSuggestions(info.GetUserId(), &suggestionsIndex{popularUsers: popularUsers})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var candidatesIds []int64
+	for _, id := range a.redisClient.ZRevRange(ctx, suggestionsKey, 0, -1).Val() {
+		intId, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			a.logger.Debug(err.Error())
+			continue
+		}
+		candidatesIds = append(candidatesIds, intId)
+	}
+	if len(candidatesIds) == 0 {
+		return &pb_aap.GetFollowSuggestionsResponse{Status: pb_aap.GetFollowSuggestionsResponse_OK}, nil
+	}
+
+	// Users followed, requested, blocked or deleted since the last refresh are skipped
+	excludedUsersIds, err := a.findSuggestionsExclusions(info.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	var activeIds []int64
+	err = a.db.Model(&types.User{}).Where("id in ? and deletion_scheduled_at is null", candidatesIds).Pluck("id", &activeIds).Error
+	if err != nil {
+		return nil, err
+	}
+	active := make(map[int64]bool)
+	for _, id := range activeIds {
+		active[id] = true
+	}
+
+	limit := pageLimit(info.GetLimit())
+	var usersIds []int64
+	for _, id := range candidatesIds {
+		if len(usersIds) == limit {
+			break
+		}
+		if active[id] && !excludedUsersIds[id] {
+			usersIds = append(usersIds, id)
+		}
+	}
+
+	return &pb_aap.GetFollowSuggestionsResponse{
+		Status:   pb_aap.GetFollowSuggestionsResponse_OK,
+		UsersIds: usersIds,
+	}, nil
+}
+
+// runSuggestions periodically recomputes the follow suggestions of all users
+func (a *AuthenticateAndPostService) runSuggestions() {
+	ticker := time.NewTicker(a.suggestionsRefreshInterval())
+	defer ticker.Stop()
+
+	for {
+		err := a.refreshAllSuggestions()
+		if err != nil {
+			a.logger.Error(err.Error())
+		}
+		<-ticker.C
+	}
+}
+
+// refreshAllSuggestions recomputes suggestions of users in batches
+func (a *AuthenticateAndPostService) refreshAllSuggestions() error {
+	index, err := a.loadSuggestionsIndex()
+	if err != nil {
+		return err
+	}
+
+	var lastId int64
+	for {
+		var usersIds []int64
+		err = a.db.Model(&types.User{}).Where("id > ? and deletion_scheduled_at is null", lastId).
+			Order("id").Limit(suggestionsBatchSize).Pluck("id", &usersIds).Error
+		if err != nil {
+			return err
+		}
+		for _, userId := range usersIds {
+			err = a.refreshSuggestions(userId, index)
+			if err != nil {
+				return err
+			}
+		}
+		if len(usersIds) < suggestionsBatchSize {
+			return nil
+		}
+		lastId = usersIds[len(usersIds)-1]
+	}
+}
+
+// loadSuggestionsIndex reads hashtags of recent public posts and the most followed users
+func (a *AuthenticateAndPostService) loadSuggestionsIndex() (*suggestionsIndex, error) {
+	index := &suggestionsIndex{
+		hashtagUsers: make(map[string][]int64),
+		userHashtags: make(map[int64][]string),
+	}
+
+	// Hashtags of posts readable by everyone only, newest posts first
+	since := time.Now().Add(-suggestionsHashtagWindow)
+	seen := make(map[string]bool)
+	var lastId uint
+	for {
+		query := a.db.Model(&types.Post{}).Select("post.id", "post.user_id", "post.content_text").
+			Joins("join `user` on `user`.id = post.user_id").
+			Where("post.created_at > ? and post.audience_list_id is null and post.content_text like ?", since, "%#%").
+			Where("`user`.is_private = false and `user`.deletion_scheduled_at is null")
+		if lastId > 0 {
+			query = query.Where("post.id < ?", lastId)
+		}
+		var posts []types.Post
+		err := query.Order("post.id desc").Limit(suggestionsPostsBatchSize).Find(&posts).Error
+		if err != nil {
+			return nil, err
+		}
+		for _, post := range posts {
+			for _, hashtag := range extractHashtags(post.ContentText) {
+				key := fmt.Sprintf("%d:%s", post.UserID, hashtag)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				if len(index.hashtagUsers[hashtag]) < suggestionsHashtagMaxUsers {
+					index.hashtagUsers[hashtag] = append(index.hashtagUsers[hashtag], post.UserID)
+				}
+				index.userHashtags[post.UserID] = append(index.userHashtags[post.UserID], hashtag)
+			}
+		}
+		if len(posts) < suggestionsPostsBatchSize {
+			break
+		}
+		lastId = posts[len(posts)-1].ID
+	}
+
+	var err error
+	index.popularUsers, err = a.findPopularUsersIds()
+	if err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// findPopularUsersIds returns the most followed users
+func (a *AuthenticateAndPostService) findPopularUsersIds() ([]int64, error) {
+	var usersIds []int64
+	err := a.db.Model(&types.User{}).Where("deletion_scheduled_at is null").
+		Order("followers_count desc").Limit(suggestionsPopularUsers).Pluck("id", &usersIds).Error
+	if err != nil {
+		return nil, err
+	}
+	return usersIds, nil
+}
+
+// refreshSuggestions ranks candidates by followings in common, shared hashtags and popularity
+// and stores the best ones in a sorted set
+func (a *AuthenticateAndPostService) refreshSuggestions(userId int64, index *suggestionsIndex) error {
+	excludedUsersIds, err := a.findSuggestionsExclusions(userId)
+	if err != nil {
+		return err
+	}
+	scores := make(map[int64]float64)
+
+	// Friends of friends, weighted by the number of followings leading to them
+	var mutualCounts []struct {
+		UserID int64
+		Count  int64
+	}
+	err = a.db.Raw("select f2.user_id as user_id, count(*) as count from following f1 join following f2 on f2.follower_id = f1.user_id "+
+		"where f1.follower_id = ? and f1.user_id != f1.follower_id and f2.user_id != f2.follower_id group by f2.user_id", userId).
+		Scan(&mutualCounts).Error
+	if err != nil {
+		return err
+	}
+	for _, mutualCount := range mutualCounts {
+		scores[mutualCount.UserID] += suggestionsMutualWeight * float64(mutualCount.Count)
+	}
+
+	for _, hashtag := range index.userHashtags[userId] {
+		for _, otherUserId := range index.hashtagUsers[hashtag] {
+			scores[otherUserId] += suggestionsHashtagWeight
+		}
+	}
+
+	// Popular users are candidates even without anything in common
+	for _, popularUserId := range index.popularUsers {
+		if _, ok := scores[popularUserId]; !ok {
+			scores[popularUserId] = 0
+		}
+	}
+
+	var candidatesIds []int64
+	for candidateId := range scores {
+		if candidateId != userId && !excludedUsersIds[candidateId] {
+			candidatesIds = append(candidatesIds, candidateId)
+		}
+	}
+
+	// Popularity, it also drops candidates who no longer exist
+	var candidates []types.User
+	if len(candidatesIds) > 0 {
+		err = a.db.Select("id", "followers_count").Where("id in ? and deletion_scheduled_at is null", candidatesIds).Find(&candidates).Error
+		if err != nil {
+			return err
+		}
+	}
+	for _, candidate := range candidates {
+		scores[int64(candidate.ID)] += suggestionsPopularityWeight * math.Log1p(float64(candidate.FollowersCount))
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return scores[int64(candidates[i].ID)] > scores[int64(candidates[j].ID)]
+	})
+	if len(candidates) > a.suggestionsSize() {
+		candidates = candidates[:a.suggestionsSize()]
+	}
+
+	// Replace previous suggestions
+	ctx := context.Background()
+	suggestionsKey := fmt.Sprintf("suggestions:%d", userId)
+	var members []*redis.Z
+	for _, candidate := range candidates {
+		members = append(members, &redis.Z{Score: scores[int64(candidate.ID)], Member: int64(candidate.ID)})
+	}
+	pipe := a.redisClient.TxPipeline()
+	pipe.Del(ctx, suggestionsKey)
+	if len(members) > 0 {
+		pipe.ZAdd(ctx, suggestionsKey, members...)
+	} else {
+		// An empty set is not stored by redis, a placeholder prevents computing it again on each request
+		pipe.ZAdd(ctx, suggestionsKey, &redis.Z{Score: math.Inf(-1), Member: 0})
+	}
+	pipe.Expire(ctx, suggestionsKey, 2*a.suggestionsRefreshInterval())
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// findSuggestionsExclusions returns users who can not be suggested: followings, users with a pending follow request
+// and users blocked by or blocking the user
+func (a *AuthenticateAndPostService) findSuggestionsExclusions(userId int64) (map[int64]bool, error) {
+	excludedUsersIds, err := a.findBlockedUsersIds(userId)
+	if err != nil {
+		return nil, err
+	}
+	var followingsIds, requestedIds []int64
+	err = a.db.Table("following").Where("follower_id = ?", userId).Pluck("user_id", &followingsIds).Error
+	if err != nil {
+		return nil, err
+	}
+	err = a.db.Model(&types.FollowRequest{}).Where("follower_id = ?", userId).Pluck("user_id", &requestedIds).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range append(followingsIds, requestedIds...) {
+		excludedUsersIds[id] = true
+	}
+	excludedUsersIds[userId] = true
+	return excludedUsersIds, nil
+}
+
+// extractHashtags returns the lowercase hashtags of a text
+func extractHashtags(text string) []string {
+	var hashtags []string
+	for _, match := range hashtagRegexp.FindAllStringSubmatch(text, -1) {
+		hashtags = append(hashtags, strings.ToLower(match[1]))
+	}
+	return hashtags
+}
+
+func (a *AuthenticateAndPostService) suggestionsRefreshInterval() time.Duration {
+	if a.cfg.Suggestions.RefreshInterval <= 0 {
+		return defaultSuggestionsRefreshInterval
+	}
+	return a.cfg.Suggestions.RefreshInterval
+}
+
+func (a *AuthenticateAndPostService) suggestionsSize() int {
+	if a.cfg.Suggestions.Size <= 0 {
+		return defaultSuggestionsSize
+	}
+	return a.cfg.Suggestions.Size
+}
@@ -0,0 +1,332 @@
+package authen_and_post_svc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/maxuanquang/social-network/internal/auth"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultTwoFactorIssuer = "Social Network"
+	recoveryCodesCount     = 10
+)
+
+func (a *AuthenticateAndPostService) EnrollTwoFactor(ctx context.Context, info *pb_aap.EnrollTwoFactorRequest) (*pb_aap.EnrollTwoFactorResponse, error) {
+	a.logger.Debug("start enrolling two-factor authentication")
+	defer a.logger.Debug("end enrolling two-factor authentication")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.EnrollTwoFactorResponse{Status: pb_aap.EnrollTwoFactorResponse_USER_NOT_FOUND}, nil
+	}
+	exist, twoFactor := a.findTwoFactorByUserId(info.GetUserId())
+	if exist && twoFactor.EnabledAt.Valid {
+		return &pb_aap.EnrollTwoFactorResponse{Status: pb_aap.EnrollTwoFactorResponse_ALREADY_ENABLED}, nil
+	}
+
+	// Enrolling again replaces the secret which was not confirmed
+	secret, err := auth.NewTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	err = a.db.Save(&types.TwoFactor{
+		UserID:    info.GetUserId(),
+		CreatedAt: time.Now(),
+		Secret:    secret,
+	}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	issuer := a.cfg.TwoFactor.Issuer
+	if issuer == "" {
+		issuer = defaultTwoFactorIssuer
+	}
+	return &pb_aap.EnrollTwoFactorResponse{
+		Status:          pb_aap.EnrollTwoFactorResponse_OK,
+		Secret:          secret,
+		ProvisioningUri: auth.TOTPProvisioningURI(issuer, user.UserName, secret),
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) EnableTwoFactor(ctx context.Context, info *pb_aap.EnableTwoFactorRequest) (*pb_aap.EnableTwoFactorResponse, error) {
+	a.logger.Debug("start enabling two-factor authentication")
+	defer a.logger.Debug("end enabling two-factor authentication")
+
+	exist, twoFactor := a.findTwoFactorByUserId(info.GetUserId())
+	if !exist {
+		return &pb_aap.EnableTwoFactorResponse{Status: pb_aap.EnableTwoFactorResponse_NOT_ENROLLED}, nil
+	}
+	if twoFactor.EnabledAt.Valid {
+		return &pb_aap.EnableTwoFactorResponse{Status: pb_aap.EnableTwoFactorResponse_ALREADY_ENABLED}, nil
+	}
+
+	// The first code proves the authenticator app was set up, recovery codes do not exist yet
+	ok, err := a.useTOTPCode(&twoFactor, info.GetOtpCode())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &pb_aap.EnableTwoFactorResponse{Status: pb_aap.EnableTwoFactorResponse_WRONG_OTP_CODE}, nil
+	}
+
+	var recoveryCodes []string
+	err = a.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&types.TwoFactor{}).Where("user_id = ?", info.GetUserId()).Update("enabled_at", time.Now()).Error
+		if err != nil {
+			return err
+		}
+		recoveryCodes, err = replaceRecoveryCodes(tx, info.GetUserId())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.EnableTwoFactorResponse{
+		Status:        pb_aap.EnableTwoFactorResponse_OK,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) DisableTwoFactor(ctx context.Context, info *pb_aap.DisableTwoFactorRequest) (*pb_aap.DisableTwoFactorResponse, error) {
+	a.logger.Debug("start disabling two-factor authentication")
+	defer a.logger.Debug("end disabling two-factor authentication")
+
+	enabled, ok, err := a.checkSecondFactorLimited(ctx, info.GetUserId(), info.GetOtpCode())
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return &pb_aap.DisableTwoFactorResponse{Status: pb_aap.DisableTwoFactorResponse_NOT_ENABLED}, nil
+	}
+	if !ok {
+		return &pb_aap.DisableTwoFactorResponse{Status: pb_aap.DisableTwoFactorResponse_WRONG_OTP_CODE}, nil
+	}
+
+	err = a.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("user_id = ?", info.GetUserId()).Delete(&types.RecoveryCode{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", info.GetUserId()).Delete(&types.TwoFactor{}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.DisableTwoFactorResponse{Status: pb_aap.DisableTwoFactorResponse_OK}, nil
+}
+
+func (a *AuthenticateAndPostService) VerifyTwoFactor(ctx context.Context, info *pb_aap.VerifyTwoFactorRequest) (*pb_aap.VerifyTwoFactorResponse, error) {
+	a.logger.Debug("start verifying two-factor authentication")
+	defer a.logger.Debug("end verifying two-factor authentication")
+
+	exist, user := a.findUserById(info.GetUserId())
+	if !exist {
+		return &pb_aap.VerifyTwoFactorResponse{Status: pb_aap.VerifyTwoFactorResponse_USER_NOT_FOUND}, nil
+	}
+
+	// Wrong codes count as failed logins, so codes can not be brute forced
+	limitKeys := loginLimitKeys(user.UserName, info.GetClientIp())
+	lockedUntil := a.checkLoginLocked(ctx, limitKeys)
+	if !lockedUntil.IsZero() {
+		return &pb_aap.VerifyTwoFactorResponse{
+			Status:      pb_aap.VerifyTwoFactorResponse_ACCOUNT_LOCKED,
+			LockedUntil: timestamppb.New(lockedUntil),
+		}, nil
+	}
+
+	enabled, ok, err := a.checkSecondFactor(info.GetUserId(), info.GetOtpCode())
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return &pb_aap.VerifyTwoFactorResponse{Status: pb_aap.VerifyTwoFactorResponse_NOT_ENABLED}, nil
+	}
+	if !ok {
+		a.recordLoginFailure(ctx, limitKeys)
+		return &pb_aap.VerifyTwoFactorResponse{Status: pb_aap.VerifyTwoFactorResponse_WRONG_OTP_CODE}, nil
+	}
+	a.resetLoginFailures(ctx, user.UserName)
+
+	var deletionScheduledAt *timestamppb.Timestamp
+	if user.DeletionScheduledAt.Valid {
+		deletionScheduledAt = timestamppb.New(user.DeletionScheduledAt.Time)
+	}
+
+	return &pb_aap.VerifyTwoFactorResponse{
+		Status:              pb_aap.VerifyTwoFactorResponse_OK,
+		DeletionScheduledAt: deletionScheduledAt,
+		User: &pb_aap.UserDetailInfo{
+			UserId:            int64(user.ID),
+			UserName:          user.UserName,
+			FirstName:         user.FirstName,
+			LastName:          user.LastName,
+			DateOfBirth:       timestamppb.New(user.DateOfBirth.Time),
+			Email:             user.Email,
+			ProfilePicture:    user.ProfilePicture,
+			CoverPicture:      user.CoverPicture,
+			EmailVerified:     user.EmailVerifiedAt.Valid,
+			IsPrivate:         user.IsPrivate,
+			FollowersCount:    user.FollowersCount,
+			FollowingsCount:   user.FollowingsCount,
+			PostsCount:        user.PostsCount,
+			Bio:               user.Bio,
+			Location:          user.Location,
+			Website:           user.Website,
+			Pronouns:          user.Pronouns,
+			ProfileVisibility: newProfileVisibility(user),
+		},
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) RegenerateRecoveryCodes(ctx context.Context, info *pb_aap.RegenerateRecoveryCodesRequest) (*pb_aap.RegenerateRecoveryCodesResponse, error) {
+	a.logger.Debug("start regenerating recovery codes")
+	defer a.logger.Debug("end regenerating recovery codes")
+
+	enabled, ok, err := a.checkSecondFactorLimited(ctx, info.GetUserId(), info.GetOtpCode())
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return &pb_aap.RegenerateRecoveryCodesResponse{Status: pb_aap.RegenerateRecoveryCodesResponse_NOT_ENABLED}, nil
+	}
+	if !ok {
+		return &pb_aap.RegenerateRecoveryCodesResponse{Status: pb_aap.RegenerateRecoveryCodesResponse_WRONG_OTP_CODE}, nil
+	}
+
+	var recoveryCodes []string
+	err = a.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		recoveryCodes, err = replaceRecoveryCodes(tx, info.GetUserId())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_aap.RegenerateRecoveryCodesResponse{
+		Status:        pb_aap.RegenerateRecoveryCodesResponse_OK,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func (a *AuthenticateAndPostService) findTwoFactorByUserId(userId int64) (bool, types.TwoFactor) {
+	var twoFactor types.TwoFactor
+	result := a.db.Where("user_id = ?", userId).First(&twoFactor)
+	if result.Error != nil {
+		return false, types.TwoFactor{}
+	}
+	return true, twoFactor
+}
+
+// checkSecondFactor checks a TOTP code or a recovery code of an user.
+// enabled is false when the user has no two-factor authentication, then code is not checked.
+func (a *AuthenticateAndPostService) checkSecondFactor(userId int64, code string) (enabled bool, ok bool, err error) {
+	exist, twoFactor := a.findTwoFactorByUserId(userId)
+	if !exist || !twoFactor.EnabledAt.Valid {
+		return false, false, nil
+	}
+
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return true, false, nil
+	}
+	ok, err = a.useTOTPCode(&twoFactor, code)
+	if err != nil || ok {
+		return true, ok, err
+	}
+	ok, err = a.useRecoveryCode(userId, code)
+	return true, ok, err
+}
+
+// checkSecondFactorLimited is checkSecondFactor for sensitive actions of a logged in user.
+// Wrong codes count as failed logins of the user, codes are not checked while the user is locked out.
+func (a *AuthenticateAndPostService) checkSecondFactorLimited(ctx context.Context, userId int64, code string) (enabled bool, ok bool, err error) {
+	exist, user := a.findUserById(userId)
+	if !exist {
+		return false, false, nil
+	}
+	limitKeys := loginLimitKeys(user.UserName, "")
+	if !a.checkLoginLocked(ctx, limitKeys).IsZero() {
+		_, twoFactor := a.findTwoFactorByUserId(userId)
+		return twoFactor.EnabledAt.Valid, false, nil
+	}
+
+	enabled, ok, err = a.checkSecondFactor(userId, code)
+	if err == nil && enabled && !ok && code != "" {
+		a.recordLoginFailure(ctx, limitKeys)
+	}
+	return enabled, ok, err
+}
+
+// useTOTPCode checks a TOTP code, a code can be used only once even while it is still valid
+func (a *AuthenticateAndPostService) useTOTPCode(twoFactor *types.TwoFactor, code string) (bool, error) {
+	step, ok := auth.ValidateTOTP(twoFactor.Secret, code, time.Now())
+	if !ok {
+		return false, nil
+	}
+	result := a.db.Model(&types.TwoFactor{}).
+		Where("user_id = ? AND last_used_step < ?", twoFactor.UserID, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// useRecoveryCode consumes an unused recovery code
+func (a *AuthenticateAndPostService) useRecoveryCode(userId int64, code string) (bool, error) {
+	var recoveryCode types.RecoveryCode
+	result := a.db.Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userId, auth.HashRecoveryCode(code)).First(&recoveryCode)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return false, nil
+	} else if result.Error != nil {
+		return false, result.Error
+	}
+
+	result = a.db.Model(&types.RecoveryCode{}).
+		Where("id = ? AND used_at IS NULL", recoveryCode.ID).
+		Update("used_at", sql.NullTime{Time: time.Now(), Valid: true})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// replaceRecoveryCodes replaces the recovery codes of an user and returns the new ones, only their hashes are stored
+func replaceRecoveryCodes(tx *gorm.DB, userId int64) ([]string, error) {
+	err := tx.Where("user_id = ?", userId).Delete(&types.RecoveryCode{}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var codes []string
+	var rows []types.RecoveryCode
+	for i := 0; i < recoveryCodesCount; i++ {
+		code, err := auth.NewRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+		rows = append(rows, types.RecoveryCode{
+			CreatedAt: time.Now(),
+			UserID:    userId,
+			CodeHash:  auth.HashRecoveryCode(code),
+		})
+	}
+	err = tx.Create(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
@@ -0,0 +1,82 @@
+package authen_and_post_svc
+
+import (
+	"time"
+
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultUserNameChangeCooldown      = 30 * 24 * time.Hour
+	defaultUserNameRedirectGracePeriod = 30 * 24 * time.Hour
+)
+
+// nextUserNameChangeAt returns when userId can change its user name again, it is zero if it can change it now
+func (a *AuthenticateAndPostService) nextUserNameChangeAt(userId int64) (time.Time, error) {
+	var history []types.UserNameHistory
+	err := a.db.Where("user_id = ?", userId).Order("created_at desc").Limit(1).Find(&history).Error
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(history) == 0 {
+		return time.Time{}, nil
+	}
+	nextChangeAt := history[0].CreatedAt.Add(a.userNameChangeCooldown())
+	if nextChangeAt.Before(time.Now()) {
+		return time.Time{}, nil
+	}
+	return nextChangeAt, nil
+}
+
+// isUserNameReserved checks if userName is a previous user name of another user than userId
+// which still redirects to it, userId is 0 for new users
+func (a *AuthenticateAndPostService) isUserNameReserved(userName string, userId int64) (bool, error) {
+	var count int64
+	err := a.db.Model(&types.UserNameHistory{}).
+		Where("user_name = ? AND user_id != ? AND created_at > ?", userName, userId, time.Now().Add(-a.userNameRedirectGracePeriod())).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// findUserByPreviousUserName finds the user who used userName during the redirect grace period
+func (a *AuthenticateAndPostService) findUserByPreviousUserName(userName string) (exist bool, user types.User) {
+	var history []types.UserNameHistory
+	err := a.db.Where("user_name = ? AND created_at > ?", userName, time.Now().Add(-a.userNameRedirectGracePeriod())).
+		Order("created_at desc").Limit(1).Find(&history).Error
+	if err != nil || len(history) == 0 {
+		return false, types.User{}
+	}
+	return a.findUserById(history[0].UserID)
+}
+
+// saveUserNameChange saves user with its new user name and keeps the previous one for redirects
+func (a *AuthenticateAndPostService) saveUserNameChange(user *types.User, previousUserName string) error {
+	return a.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Save(user).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(&types.UserNameHistory{
+			UserID:   int64(user.ID),
+			UserName: previousUserName,
+		}).Error
+	})
+}
+
+func (a *AuthenticateAndPostService) userNameChangeCooldown() time.Duration {
+	if a.cfg.UserNameChange.Cooldown <= 0 {
+		return defaultUserNameChangeCooldown
+	}
+	return a.cfg.UserNameChange.Cooldown
+}
+
+func (a *AuthenticateAndPostService) userNameRedirectGracePeriod() time.Duration {
+	if a.cfg.UserNameChange.RedirectGracePeriod <= 0 {
+		return defaultUserNameRedirectGracePeriod
+	}
+	return a.cfg.UserNameChange.RedirectGracePeriod
+}
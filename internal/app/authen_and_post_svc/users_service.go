@@ -6,8 +6,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
-	"github.com/maxuanquang/social-network/internal/auth"
 	"github.com/maxuanquang/social-network/internal/pkg/types"
 	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -20,13 +20,23 @@ func (a *AuthenticateAndPostService) CreateUser(ctx context.Context, info *pb_aa
 	if exist {
 		return &pb_aap.CreateUserResponse{Status: pb_aap.CreateUserResponse_USERNAME_EXISTED}, nil
 	}
-
-	// Password hash and salt
-	salt, err := auth.GenerateRandomSalt()
+	reserved, err := a.isUserNameReserved(info.GetUserName(), 0)
 	if err != nil {
 		return nil, err
 	}
-	hashed_password, err := auth.HashPassword(info.GetUserPassword(), salt)
+	if reserved {
+		return &pb_aap.CreateUserResponse{Status: pb_aap.CreateUserResponse_USERNAME_EXISTED}, nil
+	}
+
+	// Check email existence
+	email := strings.ToLower(strings.TrimSpace(info.GetEmail()))
+	exist, _ = a.findUserByEmail(email)
+	if exist {
+		return &pb_aap.CreateUserResponse{Status: pb_aap.CreateUserResponse_EMAIL_EXISTED}, nil
+	}
+
+	// Password hash, the salt is encoded in the hash
+	hashed_password, err := a.passwordHasher.Hash(info.GetUserPassword())
 	if err != nil {
 		return nil, err
 	}
@@ -34,14 +44,22 @@ func (a *AuthenticateAndPostService) CreateUser(ctx context.Context, info *pb_aa
 	// Create user
 	newUser := types.User{
 		HashedPassword: hashed_password,
-		Salt:           salt,
-		Email:          info.GetEmail(),
+		Salt:           []byte{},
+		Email:          email,
 		UserName:       info.GetUserName(),
 	}
 	result := a.db.Create(&newUser)
-	if result.Error != nil {
+	if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+		// Another user signed up with the same user name or email concurrently
+		exist, _ = a.findUserByEmail(email)
+		if exist {
+			return &pb_aap.CreateUserResponse{Status: pb_aap.CreateUserResponse_EMAIL_EXISTED}, nil
+		}
+		return &pb_aap.CreateUserResponse{Status: pb_aap.CreateUserResponse_USERNAME_EXISTED}, nil
+	} else if result.Error != nil {
 		return nil, result.Error
 	}
+	a.updateUserSearchIndex(ctx, nil, &newUser)
 	
 	// The user follows herself in default
 	a.FollowUser(ctx, &pb_aap.FollowUserRequest{
@@ -49,6 +67,12 @@ func (a *AuthenticateAndPostService) CreateUser(ctx context.Context, info *pb_aa
 		FollowingId: int64(newUser.ID),
 	})
 
+	// Signing up succeeds even if the email can not be sent, the user can ask for another one
+	err = a.sendVerificationEmail(ctx, &newUser)
+	if err != nil {
+		a.logger.Error(err.Error())
+	}
+
 	return &pb_aap.CreateUserResponse{
 		Status: pb_aap.CreateUserResponse_OK,
 		UserId: int64(newUser.ID),
@@ -56,32 +80,92 @@ func (a *AuthenticateAndPostService) CreateUser(ctx context.Context, info *pb_aa
 }
 
 func (a *AuthenticateAndPostService) CheckUserAuthentication(ctx context.Context, info *pb_aap.CheckUserAuthenticationRequest) (*pb_aap.CheckUserAuthenticationResponse, error) {
+	// Check lockout of user name and client ip
+	limitKeys := loginLimitKeys(info.GetUserName(), info.GetClientIp())
+	lockedUntil := a.checkLoginLocked(ctx, limitKeys)
+	if !lockedUntil.IsZero() {
+		return &pb_aap.CheckUserAuthenticationResponse{
+			Status:      pb_aap.CheckUserAuthenticationResponse_ACCOUNT_LOCKED,
+			LockedUntil: timestamppb.New(lockedUntil),
+		}, nil
+	}
+
 	// Check user name
 	var user types.User
 	result := a.db.Where(&types.User{UserName: info.GetUserName()}).First(&user)
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		a.recordLoginFailure(ctx, limitKeys)
 		return &pb_aap.CheckUserAuthenticationResponse{Status: pb_aap.CheckUserAuthenticationResponse_USER_NOT_FOUND}, nil
 	} else if result.Error != nil {
 		return nil, result.Error
 	}
 
 	// Password matching
-	err := auth.CheckPasswordHash(user.HashedPassword, info.GetUserPassword(), user.Salt)
+	match, needsRehash, err := a.passwordHasher.Verify(user.HashedPassword, info.GetUserPassword(), user.Salt)
 	if err != nil {
+		return nil, err
+	}
+	if !match {
+		a.recordLoginFailure(ctx, limitKeys)
 		return &pb_aap.CheckUserAuthenticationResponse{Status: pb_aap.CheckUserAuthenticationResponse_WRONG_PASSWORD}, nil
 	}
 
+	// Second factor, failures are only reset after it so that codes can not be brute forced with a known password
+	enabled, ok, err := a.checkSecondFactor(int64(user.ID), info.GetOtpCode())
+	if err != nil {
+		return nil, err
+	}
+	if enabled && info.GetOtpCode() == "" {
+		return &pb_aap.CheckUserAuthenticationResponse{
+			Status: pb_aap.CheckUserAuthenticationResponse_TWO_FACTOR_REQUIRED,
+			UserId: int64(user.ID),
+		}, nil
+	}
+	if enabled && !ok {
+		a.recordLoginFailure(ctx, limitKeys)
+		return &pb_aap.CheckUserAuthenticationResponse{Status: pb_aap.CheckUserAuthenticationResponse_WRONG_OTP_CODE}, nil
+	}
+	a.resetLoginFailures(ctx, info.GetUserName())
+
+	// Hashes made by legacy or outdated configuration are replaced while the raw password is known
+	if needsRehash {
+		hashed_password, err := a.passwordHasher.Hash(info.GetUserPassword())
+		if err != nil {
+			return nil, err
+		}
+		err = a.db.Model(&user).Updates(map[string]interface{}{"hashed_password": hashed_password, "salt": []byte{}}).Error
+		if err != nil {
+			a.logger.Error(err.Error())
+		}
+	}
+
+	var deletionScheduledAt *timestamppb.Timestamp
+	if user.DeletionScheduledAt.Valid {
+		deletionScheduledAt = timestamppb.New(user.DeletionScheduledAt.Time)
+	}
+
 	return &pb_aap.CheckUserAuthenticationResponse{
-		Status: pb_aap.CheckUserAuthenticationResponse_OK,
+		Status:              pb_aap.CheckUserAuthenticationResponse_OK,
+		DeletionScheduledAt: deletionScheduledAt,
 		User: &pb_aap.UserDetailInfo{
-			UserId:         int64(user.ID),
-			UserName:       user.UserName,
-			FirstName:      user.FirstName,
-			LastName:       user.LastName,
-			DateOfBirth:    timestamppb.New(user.DateOfBirth.Time),
-			Email:          user.Email,
-			ProfilePicture: user.ProfilePicture,
-			CoverPicture:   user.CoverPicture,
+			UserId:            int64(user.ID),
+			UserName:          user.UserName,
+			FirstName:         user.FirstName,
+			LastName:          user.LastName,
+			DateOfBirth:       timestamppb.New(user.DateOfBirth.Time),
+			Email:             user.Email,
+			ProfilePicture:    user.ProfilePicture,
+			CoverPicture:      user.CoverPicture,
+			EmailVerified:     user.EmailVerifiedAt.Valid,
+			IsPrivate:         user.IsPrivate,
+			FollowersCount:    user.FollowersCount,
+			FollowingsCount:   user.FollowingsCount,
+			PostsCount:        user.PostsCount,
+			Bio:               user.Bio,
+			Location:          user.Location,
+			Website:           user.Website,
+			Pronouns:          user.Pronouns,
+			ProfileVisibility: newProfileVisibility(user),
 		},
 	}, nil
 }
@@ -91,6 +175,33 @@ func (a *AuthenticateAndPostService) EditUser(ctx context.Context, info *pb_aap.
 	if !exist {
 		return &pb_aap.EditUserResponse{Status: pb_aap.EditUserResponse_USER_NOT_FOUND}, nil
 	}
+	previous := user
+	userNameChanged := info.UserName != nil && info.GetUserName() != user.UserName
+	if userNameChanged {
+		// User names can not be changed too often, and can not be taken while they redirect to another user
+		nextChangeAt, err := a.nextUserNameChangeAt(info.GetUserId())
+		if err != nil {
+			return nil, err
+		}
+		if !nextChangeAt.IsZero() {
+			return &pb_aap.EditUserResponse{
+				Status:               pb_aap.EditUserResponse_USERNAME_CHANGE_TOO_SOON,
+				NextUserNameChangeAt: timestamppb.New(nextChangeAt),
+			}, nil
+		}
+		exist, _ := a.findUserByUserName(info.GetUserName())
+		if exist {
+			return &pb_aap.EditUserResponse{Status: pb_aap.EditUserResponse_USERNAME_EXISTED}, nil
+		}
+		reserved, err := a.isUserNameReserved(info.GetUserName(), info.GetUserId())
+		if err != nil {
+			return nil, err
+		}
+		if reserved {
+			return &pb_aap.EditUserResponse{Status: pb_aap.EditUserResponse_USERNAME_EXISTED}, nil
+		}
+		user.UserName = info.GetUserName()
+	}
 	if info.FirstName != nil {
 		user.FirstName = info.GetFirstName()
 	}
@@ -101,15 +212,23 @@ func (a *AuthenticateAndPostService) EditUser(ctx context.Context, info *pb_aap.
 		user.DateOfBirth = sql.NullTime{Time: info.GetDateOfBirth().AsTime()}
 	}
 	if info.UserPassword != nil {
-		salt, err := auth.GenerateRandomSalt()
+		// Changing password needs the second factor when it is enabled
+		enabled, ok, err := a.checkSecondFactorLimited(ctx, info.GetUserId(), info.GetOtpCode())
 		if err != nil {
 			return nil, err
 		}
-		hashed_password, err := auth.HashPassword(info.GetUserPassword(), salt)
+		if enabled && info.GetOtpCode() == "" {
+			return &pb_aap.EditUserResponse{Status: pb_aap.EditUserResponse_TWO_FACTOR_REQUIRED}, nil
+		}
+		if enabled && !ok {
+			return &pb_aap.EditUserResponse{Status: pb_aap.EditUserResponse_WRONG_OTP_CODE}, nil
+		}
+
+		hashed_password, err := a.passwordHasher.Hash(info.GetUserPassword())
 		if err != nil {
 			return nil, err
 		}
-		user.Salt = salt
+		user.Salt = []byte{}
 		user.HashedPassword = hashed_password
 	}
 	if info.ProfilePicture != nil {
@@ -118,7 +237,53 @@ func (a *AuthenticateAndPostService) EditUser(ctx context.Context, info *pb_aap.
 	if info.CoverPicture != nil {
 		user.CoverPicture = info.GetCoverPicture()
 	}
-	a.db.Save(&user)
+	if info.Bio != nil {
+		user.Bio = info.GetBio()
+	}
+	if info.Location != nil {
+		user.Location = info.GetLocation()
+	}
+	if info.Website != nil {
+		user.Website = info.GetWebsite()
+	}
+	if info.Pronouns != nil {
+		user.Pronouns = info.GetPronouns()
+	}
+	if info.ProfileVisibility != nil {
+		setProfileVisibility(&user, info.GetProfileVisibility())
+	}
+	wasPrivate := user.IsPrivate
+	if info.IsPrivate != nil {
+		user.IsPrivate = info.GetIsPrivate()
+	}
+	if userNameChanged {
+		err := a.saveUserNameChange(&user, previous.UserName)
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			// Another user took the user name concurrently
+			return &pb_aap.EditUserResponse{Status: pb_aap.EditUserResponse_USERNAME_EXISTED}, nil
+		} else if err != nil {
+			return nil, err
+		}
+	} else {
+		a.db.Save(&user)
+	}
+	a.redisClient.Del(ctx, fmt.Sprintf("user:%d", user.ID))
+	a.updateUserSearchIndex(ctx, &previous, &user)
+
+	// Going public approves all pending follow requests
+	if wasPrivate && !user.IsPrivate {
+		var followersIds []int64
+		err := a.db.Model(&types.FollowRequest{}).Where("user_id = ?", user.ID).Pluck("follower_id", &followersIds).Error
+		if err != nil {
+			return nil, err
+		}
+		if len(followersIds) > 0 {
+			_, err = a.approveFollowRequests(int64(user.ID), followersIds)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 
 	return &pb_aap.EditUserResponse{
 		Status: pb_aap.EditUserResponse_OK,
@@ -126,41 +291,84 @@ func (a *AuthenticateAndPostService) EditUser(ctx context.Context, info *pb_aap.
 }
 
 func (a *AuthenticateAndPostService) GetUserDetailInfo(ctx context.Context, info *pb_aap.GetUserDetailInfoRequest) (*pb_aap.GetUserDetailInfoResponse, error) {
-	userKey := fmt.Sprintf("user:%d", info.GetUserId())
+	// Profile URLs refer to users by name
+	userId := info.GetUserId()
+	if userId == 0 && info.GetUserName() != "" {
+		exist, user := a.findUserByUserName(info.GetUserName())
+		if !exist {
+			exist, user = a.findUserByPreviousUserName(info.GetUserName())
+		}
+		if !exist {
+			return &pb_aap.GetUserDetailInfoResponse{Status: pb_aap.GetUserDetailInfoResponse_USER_NOT_FOUND}, nil
+		}
+		userId = int64(user.ID)
+	}
+
+	userKey := fmt.Sprintf("user:%d", userId)
 	cacheExist := (a.redisClient.Exists(context.Background(), userKey).Val() == 1)
 	if cacheExist {
 		userJson := a.redisClient.Get(context.Background(), userKey).Val()
 		var user types.User
 		err := json.Unmarshal([]byte(userJson), &user)
 		if err == nil {
+			userInfo := &pb_aap.UserDetailInfo{
+				UserId:            int64(user.ID),
+				UserName:          user.UserName,
+				FirstName:         user.FirstName,
+				LastName:          user.LastName,
+				DateOfBirth:       timestamppb.New(user.DateOfBirth.Time),
+				Email:             user.Email,
+				EmailVerified:     user.EmailVerifiedAt.Valid,
+				IsPrivate:         user.IsPrivate,
+				FollowersCount:    user.FollowersCount,
+				FollowingsCount:   user.FollowingsCount,
+				PostsCount:        user.PostsCount,
+				Bio:               user.Bio,
+				Location:          user.Location,
+				Website:           user.Website,
+				Pronouns:          user.Pronouns,
+				ProfileVisibility: newProfileVisibility(user),
+			}
+			err = a.filterProfileFields(userInfo, user, info.GetViewerId())
+			if err != nil {
+				return nil, err
+			}
 			return &pb_aap.GetUserDetailInfoResponse{
 				Status: pb_aap.GetUserDetailInfoResponse_OK,
-				User: &pb_aap.UserDetailInfo{
-					UserId:      int64(user.ID),
-					UserName:    user.UserName,
-					FirstName:   user.FirstName,
-					LastName:    user.LastName,
-					DateOfBirth: timestamppb.New(user.DateOfBirth.Time),
-					Email:       user.Email,
-				},
+				User:   userInfo,
 			}, nil
 		}
 	}
 
-	exist, user := a.findUserById(info.GetUserId())
+	exist, user := a.findUserById(userId)
 	if !exist {
 		return &pb_aap.GetUserDetailInfoResponse{Status: pb_aap.GetUserDetailInfoResponse_USER_NOT_FOUND}, nil
 	}
 
+	userInfo := &pb_aap.UserDetailInfo{
+		UserId:            int64(user.ID),
+		UserName:          user.UserName,
+		FirstName:         user.FirstName,
+		LastName:          user.LastName,
+		DateOfBirth:       timestamppb.New(user.DateOfBirth.Time),
+		Email:             user.Email,
+		EmailVerified:     user.EmailVerifiedAt.Valid,
+		IsPrivate:         user.IsPrivate,
+		FollowersCount:    user.FollowersCount,
+		FollowingsCount:   user.FollowingsCount,
+		PostsCount:        user.PostsCount,
+		Bio:               user.Bio,
+		Location:          user.Location,
+		Website:           user.Website,
+		Pronouns:          user.Pronouns,
+		ProfileVisibility: newProfileVisibility(user),
+	}
+	err := a.filterProfileFields(userInfo, user, info.GetViewerId())
+	if err != nil {
+		return nil, err
+	}
 	return &pb_aap.GetUserDetailInfoResponse{
 		Status: pb_aap.GetUserDetailInfoResponse_OK,
-		User: &pb_aap.UserDetailInfo{
-			UserId:      int64(user.ID),
-			UserName:    user.UserName,
-			FirstName:   user.FirstName,
-			LastName:    user.LastName,
-			DateOfBirth: timestamppb.New(user.DateOfBirth.Time),
-			Email:       user.Email,
-		},
+		User:   userInfo,
 	}, nil
 }
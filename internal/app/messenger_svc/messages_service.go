@@ -0,0 +1,280 @@
+package messenger_svc
+
+import (
+	"context"
+
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+	pb_msg "github.com/maxuanquang/social-network/pkg/types/proto/pb/messenger"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func (svc *MessengerService) SendMessage(ctx context.Context, info *pb_msg.SendMessageRequest) (*pb_msg.SendMessageResponse, error) {
+	svc.logger.Debug("start sending message")
+	defer svc.logger.Debug("end sending message")
+
+	if info.GetUserId() == info.GetRecipientId() {
+		return &pb_msg.SendMessageResponse{Status: pb_msg.SendMessageResponse_INVALID_RECIPIENT}, nil
+	}
+	exist, _ := svc.findUserById(info.GetUserId(), true)
+	if !exist {
+		return &pb_msg.SendMessageResponse{Status: pb_msg.SendMessageResponse_USER_NOT_FOUND}, nil
+	}
+	exist, _ = svc.findUserById(info.GetRecipientId(), false)
+	if !exist {
+		return &pb_msg.SendMessageResponse{Status: pb_msg.SendMessageResponse_RECIPIENT_NOT_FOUND}, nil
+	}
+	blocked, err := svc.isBlocked(info.GetUserId(), info.GetRecipientId())
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return &pb_msg.SendMessageResponse{Status: pb_msg.SendMessageResponse_BLOCKED}, nil
+	}
+
+	// The conversation of two users is created with their first message
+	firstUserId, secondUserId := info.GetUserId(), info.GetRecipientId()
+	if firstUserId > secondUserId {
+		firstUserId, secondUserId = secondUserId, firstUserId
+	}
+	var message types.Message
+	err = svc.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("insert ignore into conversation (first_user_id, second_user_id) values (?, ?)", firstUserId, secondUserId).Error
+		if err != nil {
+			return err
+		}
+		var conversation types.Conversation
+		err = tx.Where("first_user_id = ? AND second_user_id = ?", firstUserId, secondUserId).First(&conversation).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Exec("insert ignore into conversation_member (conversation_id, user_id) values (?, ?), (?, ?)",
+			conversation.ID, firstUserId, conversation.ID, secondUserId).Error
+		if err != nil {
+			return err
+		}
+
+		message = types.Message{
+			ConversationID: int64(conversation.ID),
+			SenderID:       info.GetUserId(),
+			ContentText:    info.GetContentText(),
+		}
+		err = tx.Create(&message).Error
+		if err != nil {
+			return err
+		}
+		// Concurrent messages can commit in any order, ids never go back
+		err = tx.Model(&conversation).UpdateColumn("last_message_id", gorm.Expr("greatest(last_message_id, ?)", message.ID)).Error
+		if err != nil {
+			return err
+		}
+		// Messages sent by the user are read by it
+		return tx.Model(&types.ConversationMember{}).Where("conversation_id = ? AND user_id = ?", conversation.ID, info.GetUserId()).
+			UpdateColumn("last_read_message_id", gorm.Expr("greatest(last_read_message_id, ?)", message.ID)).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_msg.SendMessageResponse{
+		Status:  pb_msg.SendMessageResponse_OK,
+		Message: newMessageInfo(message),
+	}, nil
+}
+
+func (svc *MessengerService) GetConversations(ctx context.Context, info *pb_msg.GetConversationsRequest) (*pb_msg.GetConversationsResponse, error) {
+	svc.logger.Debug("start getting conversations")
+	defer svc.logger.Debug("end getting conversations")
+
+	exist, _ := svc.findUserById(info.GetUserId(), true)
+	if !exist {
+		return &pb_msg.GetConversationsResponse{Status: pb_msg.GetConversationsResponse_USER_NOT_FOUND}, nil
+	}
+
+	// Conversations with the most recent messages first, deleted conversations are hidden until a new message
+	limit := pageLimit(info.GetLimit())
+	query := svc.db.Table("conversation").
+		Select("conversation.*, conversation_member.cleared_message_id, conversation_member.last_read_message_id").
+		Joins("join conversation_member on conversation_member.conversation_id = conversation.id and conversation_member.user_id = ?", info.GetUserId()).
+		Where("conversation.last_message_id > conversation_member.cleared_message_id")
+	if info.GetCursor() > 0 {
+		query = query.Where("conversation.last_message_id < ?", info.GetCursor())
+	}
+	var conversations []struct {
+		types.Conversation
+		ClearedMessageID  int64
+		LastReadMessageID int64
+	}
+	err := query.Order("conversation.last_message_id desc").Limit(limit).Scan(&conversations).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(conversations) == 0 {
+		return &pb_msg.GetConversationsResponse{Status: pb_msg.GetConversationsResponse_OK}, nil
+	}
+
+	var conversationsIds []int64
+	for _, conversation := range conversations {
+		conversationsIds = append(conversationsIds, int64(conversation.ID))
+	}
+	var unreadCounts []struct {
+		ConversationID int64
+		Count          int64
+	}
+	err = svc.db.Raw("select message.conversation_id, count(*) as count from message "+
+		"join conversation_member on conversation_member.conversation_id = message.conversation_id and conversation_member.user_id = ? "+
+		"where message.conversation_id in ? and message.sender_id != ? and message.deleted_at is null "+
+		"and message.id > greatest(conversation_member.last_read_message_id, conversation_member.cleared_message_id) "+
+		"group by message.conversation_id", info.GetUserId(), conversationsIds, info.GetUserId()).Scan(&unreadCounts).Error
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[int64]int64)
+	for _, unreadCount := range unreadCounts {
+		counts[unreadCount.ConversationID] = unreadCount.Count
+	}
+
+	// The last message may have been deleted by its sender, the last visible one of each conversation is shown
+	var lastMessages []types.Message
+	err = svc.db.Raw("select message.* from message join ("+
+		"select message.conversation_id, max(message.id) as id from message "+
+		"join conversation_member on conversation_member.conversation_id = message.conversation_id and conversation_member.user_id = ? "+
+		"where message.conversation_id in ? and message.deleted_at is null and message.id > conversation_member.cleared_message_id "+
+		"group by message.conversation_id) last_message on last_message.id = message.id", info.GetUserId(), conversationsIds).
+		Scan(&lastMessages).Error
+	if err != nil {
+		return nil, err
+	}
+	lastMessagesByConversation := make(map[int64]types.Message)
+	for _, lastMessage := range lastMessages {
+		lastMessagesByConversation[lastMessage.ConversationID] = lastMessage
+	}
+
+	var result []*pb_msg.ConversationInfo
+	for _, conversation := range conversations {
+		otherUserId := conversation.FirstUserID
+		if otherUserId == info.GetUserId() {
+			otherUserId = conversation.SecondUserID
+		}
+		conversationInfo := &pb_msg.ConversationInfo{
+			ConversationId: int64(conversation.ID),
+			OtherUserId:    otherUserId,
+			UnreadCount:    counts[int64(conversation.ID)],
+		}
+		if lastMessage, ok := lastMessagesByConversation[int64(conversation.ID)]; ok {
+			conversationInfo.LastMessage = newMessageInfo(lastMessage)
+		}
+		result = append(result, conversationInfo)
+	}
+
+	var nextCursor int64
+	if len(conversations) == limit {
+		nextCursor = conversations[len(conversations)-1].LastMessageID
+	}
+	return &pb_msg.GetConversationsResponse{
+		Status:        pb_msg.GetConversationsResponse_OK,
+		Conversations: result,
+		NextCursor:    nextCursor,
+	}, nil
+}
+
+func (svc *MessengerService) GetMessages(ctx context.Context, info *pb_msg.GetMessagesRequest) (*pb_msg.GetMessagesResponse, error) {
+	svc.logger.Debug("start getting messages")
+	defer svc.logger.Debug("end getting messages")
+
+	exist, member := svc.findConversationMember(info.GetUserId(), info.GetConversationId())
+	if !exist {
+		return &pb_msg.GetMessagesResponse{Status: pb_msg.GetMessagesResponse_CONVERSATION_NOT_FOUND}, nil
+	}
+
+	limit := pageLimit(info.GetLimit())
+	query := svc.db.Where("conversation_id = ? AND id > ?", info.GetConversationId(), member.ClearedMessageID)
+	if info.GetCursor() > 0 {
+		query = query.Where("id < ?", info.GetCursor())
+	}
+	var messages []types.Message
+	err := query.Order("id desc").Limit(limit).Find(&messages).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*pb_msg.MessageInfo
+	for _, message := range messages {
+		result = append(result, newMessageInfo(message))
+	}
+	var nextCursor int64
+	if len(messages) == limit {
+		nextCursor = int64(messages[len(messages)-1].ID)
+	}
+	return &pb_msg.GetMessagesResponse{
+		Status:     pb_msg.GetMessagesResponse_OK,
+		Messages:   result,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (svc *MessengerService) MarkConversationRead(ctx context.Context, info *pb_msg.MarkConversationReadRequest) (*pb_msg.MarkConversationReadResponse, error) {
+	svc.logger.Debug("start marking conversation read")
+	defer svc.logger.Debug("end marking conversation read")
+
+	exist, _ := svc.findConversationMember(info.GetUserId(), info.GetConversationId())
+	if !exist {
+		return &pb_msg.MarkConversationReadResponse{Status: pb_msg.MarkConversationReadResponse_CONVERSATION_NOT_FOUND}, nil
+	}
+
+	err := svc.db.Exec("update conversation_member join conversation on conversation.id = conversation_member.conversation_id "+
+		"set conversation_member.last_read_message_id = conversation.last_message_id "+
+		"where conversation_member.conversation_id = ? and conversation_member.user_id = ?", info.GetConversationId(), info.GetUserId()).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_msg.MarkConversationReadResponse{Status: pb_msg.MarkConversationReadResponse_OK}, nil
+}
+
+func (svc *MessengerService) DeleteMessage(ctx context.Context, info *pb_msg.DeleteMessageRequest) (*pb_msg.DeleteMessageResponse, error) {
+	svc.logger.Debug("start deleting message")
+	defer svc.logger.Debug("end deleting message")
+
+	// Messages are soft deleted for both users
+	result := svc.db.Where("id = ? AND sender_id = ?", info.GetMessageId(), info.GetUserId()).Delete(&types.Message{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return &pb_msg.DeleteMessageResponse{Status: pb_msg.DeleteMessageResponse_MESSAGE_NOT_FOUND}, nil
+	}
+
+	return &pb_msg.DeleteMessageResponse{Status: pb_msg.DeleteMessageResponse_OK}, nil
+}
+
+func (svc *MessengerService) DeleteConversation(ctx context.Context, info *pb_msg.DeleteConversationRequest) (*pb_msg.DeleteConversationResponse, error) {
+	svc.logger.Debug("start deleting conversation")
+	defer svc.logger.Debug("end deleting conversation")
+
+	exist, _ := svc.findConversationMember(info.GetUserId(), info.GetConversationId())
+	if !exist {
+		return &pb_msg.DeleteConversationResponse{Status: pb_msg.DeleteConversationResponse_CONVERSATION_NOT_FOUND}, nil
+	}
+
+	// The conversation is only deleted for the user, its messages are hidden from it and the other user keeps them
+	err := svc.db.Exec("update conversation_member join conversation on conversation.id = conversation_member.conversation_id "+
+		"set conversation_member.cleared_message_id = conversation.last_message_id, "+
+		"conversation_member.last_read_message_id = conversation.last_message_id "+
+		"where conversation_member.conversation_id = ? and conversation_member.user_id = ?", info.GetConversationId(), info.GetUserId()).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_msg.DeleteConversationResponse{Status: pb_msg.DeleteConversationResponse_OK}, nil
+}
+
+func newMessageInfo(message types.Message) *pb_msg.MessageInfo {
+	return &pb_msg.MessageInfo{
+		MessageId:      int64(message.ID),
+		ConversationId: message.ConversationID,
+		SenderId:       message.SenderID,
+		ContentText:    message.ContentText,
+		CreatedAt:      timestamppb.New(message.CreatedAt),
+	}
+}
@@ -0,0 +1,90 @@
+package messenger_svc
+
+import (
+	"errors"
+
+	"github.com/maxuanquang/social-network/configs"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+	"github.com/maxuanquang/social-network/internal/utils"
+	"go.uber.org/zap"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	pb_msg "github.com/maxuanquang/social-network/pkg/types/proto/pb/messenger"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+type MessengerService struct {
+	pb_msg.UnimplementedMessengerServer
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewMessengerService(cfg *configs.MessengerConfig) (*MessengerService, error) {
+	// Connect to database
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: cfg.MySQL.DSN}), &gorm.Config{TranslateError: true})
+	if err != nil {
+		return nil, err
+	}
+
+	// Establish logger
+	logger, err := utils.NewLogger(&cfg.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessengerService{
+		db:     db,
+		logger: logger,
+	}, nil
+}
+
+// findUserById checks if an user with provided userId exists in database, users scheduled for deletion
+// are only found when includeScheduled is set
+func (svc *MessengerService) findUserById(userId int64, includeScheduled bool) (exist bool, user types.User) {
+	query := svc.db
+	if !includeScheduled {
+		query = query.Where("deletion_scheduled_at is null")
+	}
+	result := query.First(&user, userId)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return false, types.User{}
+	}
+	return result.Error == nil, user
+}
+
+// findConversationMember checks if userId is a member of the conversation
+func (svc *MessengerService) findConversationMember(userId int64, conversationId int64) (exist bool, member types.ConversationMember) {
+	result := svc.db.Where("conversation_id = ? AND user_id = ?", conversationId, userId).First(&member)
+	if result.Error != nil {
+		return false, types.ConversationMember{}
+	}
+	return true, member
+}
+
+// isBlocked checks if one of the users blocked the other one
+func (svc *MessengerService) isBlocked(userId int64, otherUserId int64) (bool, error) {
+	var count int64
+	err := svc.db.Model(&types.Block{}).
+		Where("(user_id = ? AND blocked_user_id = ?) OR (user_id = ? AND blocked_user_id = ?)", userId, otherUserId, otherUserId, userId).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// pageLimit returns the number of items a paginated request can get
+func pageLimit(limit int32) int {
+	if limit <= 0 {
+		return defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		return maxPageLimit
+	}
+	return int(limit)
+}
@@ -19,6 +19,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// followersPageLimit is the page size used to read followers of post authors
+const followersPageLimit = 100
+
 type NewsfeedPublishingService struct {
 	pb_nfp.UnimplementedNewsfeedPublishingServer
 	kafkaWriter               *kafka.Writer
@@ -82,9 +85,10 @@ func NewNewsfeedPublishingService(cfg *configs.NewsfeedPublishingConfig) (*Newsf
 
 func (svc *NewsfeedPublishingService) PublishPost(ctx context.Context, info *pb_nfp.PublishPostRequest) (*pb_nfp.PublishPostResponse, error) {
 	value := map[string]int64{
-		"user_id":    info.GetUserId(),
-		"post_id":    info.GetPostId(),
-		"created_at": info.GetCreatedAt().GetSeconds(),
+		"user_id":          info.GetUserId(),
+		"post_id":          info.GetPostId(),
+		"created_at":       info.GetCreatedAt().GetSeconds(),
+		"audience_list_id": info.GetAudienceListId(),
 	}
 	jsonValue, _ := json.Marshal(value)
 	err := svc.kafkaWriter.WriteMessages(ctx, kafka.Message{
@@ -130,23 +134,59 @@ func (svc *NewsfeedPublishingService) processPost(value []byte) {
 	followersKey := fmt.Sprintf("followers:%d", message["user_id"])
 	exist := (svc.redisClient.Exists(context.Background(), followersKey).Val() == 1)
 	if !exist {
-		resp, err := svc.authenticateAndPostClient.GetUserFollower(
+		// Followers are paginated, all pages are read before caching
+		var followersIds []interface{}
+		var cursor int64
+		for {
+			resp, err := svc.authenticateAndPostClient.GetUserFollower(
+				context.Background(),
+				&pb_aap.GetUserFollowerRequest{
+					UserId: message["user_id"],
+					Cursor: cursor,
+					Limit:  followersPageLimit,
+				})
+			if err != nil {
+				panic(err)
+			}
+			for _, id := range resp.GetFollowersIds() {
+				followersIds = append(followersIds, id)
+			}
+			cursor = resp.GetNextCursor()
+			if cursor == 0 {
+				break
+			}
+		}
+
+		if len(followersIds) > 0 {
+			svc.redisClient.RPush(context.Background(), followersKey, followersIds...)
+			svc.redisClient.Expire(context.Background(), followersKey, 15*time.Minute)
+		}
+	}
+	followersIds := svc.redisClient.LRange(context.Background(), followersKey, 0, -1).Val()
+
+	// Posts shared with an audience list only reach the followers in the list
+	if message["audience_list_id"] != 0 {
+		resp, err := svc.authenticateAndPostClient.GetAudienceList(
 			context.Background(),
-			&pb_aap.GetUserFollowerRequest{
-				UserId: message["user_id"],
+			&pb_aap.GetAudienceListRequest{
+				UserId:         message["user_id"],
+				AudienceListId: message["audience_list_id"],
 			})
 		if err != nil {
 			panic(err)
 		}
-
-		var followersIds []interface{}
-		for _, id := range resp.GetFollowersIds() {
-			followersIds = append(followersIds, id)
+		members := map[string]bool{fmt.Sprint(message["user_id"]): true}
+		for _, id := range resp.GetMembersIds() {
+			members[fmt.Sprint(id)] = true
+		}
+		var audienceIds []string
+		for _, id := range followersIds {
+			if members[id] {
+				audienceIds = append(audienceIds, id)
+			}
 		}
-		svc.redisClient.RPush(context.Background(), followersKey, followersIds...)
-		svc.redisClient.Expire(context.Background(), followersKey, 15*time.Minute)
+		followersIds = audienceIds
 	}
-	followersIds := svc.redisClient.LRange(context.Background(), followersKey, 0, -1).Val()
 
 	// Add this post_id into followers' newsfeed
 	for _, id := range followersIds {
@@ -13,6 +13,8 @@ import (
 	"github.com/maxuanquang/social-network/configs"
 	"github.com/maxuanquang/social-network/internal/utils"
 	"go.uber.org/zap"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
 
 	// "github.com/maxuanquang/social-network/internal/pkg/types"
 	pb_nf "github.com/maxuanquang/social-network/pkg/types/proto/pb/newsfeed"
@@ -20,11 +22,18 @@ import (
 
 type NewsfeedService struct {
 	pb_nf.UnimplementedNewsfeedServer
+	db          *gorm.DB
 	redisClient *redis.Client
 	logger      *zap.Logger
 }
 
 func NewNewsfeedService(cfg *configs.NewsfeedConfig) (*NewsfeedService, error) {
+	// Connect to database
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: cfg.MySQL.DSN}), &gorm.Config{TranslateError: true})
+	if err != nil {
+		return nil, err
+	}
+
 	// Connect to redisClient
 	redisClient := redis.NewClient(&redis.Options{Addr: cfg.Redis.Addr, Password: cfg.Redis.Password})
 	if redisClient == nil {
@@ -38,6 +47,7 @@ func NewNewsfeedService(cfg *configs.NewsfeedConfig) (*NewsfeedService, error) {
 	}
 
 	return &NewsfeedService{
+		db:          db,
 		redisClient: redisClient,
 		logger:      logger,
 	}, nil
@@ -64,6 +74,10 @@ func (svc *NewsfeedService) GetNewsfeed(ctx context.Context, request *pb_nf.GetN
 		}
 		int64PostsIds = append(int64PostsIds, int64(intPostId))
 	}
+	int64PostsIds, err = svc.filterHiddenPosts(request.GetUserId(), int64PostsIds)
+	if err != nil {
+		return nil, err
+	}
 	if len(int64PostsIds) == 0 {
 		return &pb_nf.GetNewsfeedResponse{
 			Status: pb_nf.GetNewsfeedResponse_NEWSFEED_EMPTY,
@@ -74,3 +88,34 @@ func (svc *NewsfeedService) GetNewsfeed(ctx context.Context, request *pb_nf.GetN
 		PostsIds: int64PostsIds,
 	}, nil
 }
+
+// filterHiddenPosts removes posts of users muted by the user, and of users blocked by or blocking the user
+func (svc *NewsfeedService) filterHiddenPosts(userId int64, postsIds []int64) ([]int64, error) {
+	if len(postsIds) == 0 {
+		return postsIds, nil
+	}
+	var hiddenPostsIds []int64
+	err := svc.db.Raw(`select id from post where id in ? and user_id in (
+		select muted_user_id from mute where user_id = ?
+		union select blocked_user_id from block where user_id = ?
+		union select user_id from block where blocked_user_id = ?)`, postsIds, userId, userId, userId).
+		Scan(&hiddenPostsIds).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(hiddenPostsIds) == 0 {
+		return postsIds, nil
+	}
+
+	hidden := make(map[int64]bool)
+	for _, id := range hiddenPostsIds {
+		hidden[id] = true
+	}
+	var visiblePostsIds []int64
+	for _, id := range postsIds {
+		if !hidden[id] {
+			visiblePostsIds = append(visiblePostsIds, id)
+		}
+	}
+	return visiblePostsIds, nil
+}
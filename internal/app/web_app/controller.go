@@ -38,14 +38,17 @@ func NewWebController(cfg *configs.WebConfig) (*WebController, error) {
 
 	// Init router
 	router := gin.Default()
+	// Client IPs are used to lock out logins, so forwarded headers are only trusted from known proxies
+	err = router.SetTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
 	router.Use(cors.New(cors.Config{
 		AllowCredentials: true,
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"},
-		AllowHeaders:     []string{"Content-Type", "Cookie"},
-		AllowOriginFunc: func(origin string) bool {
-			return true
-		},
-		ExposeHeaders: []string{"Set-Cookie"},
+		AllowHeaders:     []string{"Content-Type", "Cookie", "Authorization"},
+		AllowOriginFunc:  webService.AllowedOrigin,
+		ExposeHeaders:    []string{"Set-Cookie"},
 	}))
 
 	// Serve frontend static files
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+)
+
+// DeleteUser schedules the deletion of user account
+//
+//	@Summary		delete account
+//	@Description	schedule the deletion of user account, it can be cancelled during the grace period after logging in again. Afterwards posts, comments, likes, follows, media and sessions of user are deleted. All sessions are logged out.
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		types.DeleteUserRequest	true	"Password and two-factor authentication code"
+//	@Success		200		{object}	types.AccountDeletionResponse
+//	@Failure		400		{object}	types.AccountDeletionResponse
+//	@Failure		401		{object}	types.AccountDeletionResponse
+//	@Failure		403		{object}	types.AccountDeletionResponse
+//	@Failure		409		{object}	types.AccountDeletionResponse
+//	@Failure		500		{object}	types.AccountDeletionResponse
+//	@Router			/users/delete [post]
+func (svc *WebService) DeleteUser(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.AccountDeletionResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.DeleteUserRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.AccountDeletionResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.AccountDeletionResponse{Message: err.Error()})
+		return
+	}
+
+	// Call DeleteUser service
+	resp, err := svc.authenticateAndPostClient.DeleteUser(ctx, &pb_aap.DeleteUserRequest{
+		UserId:       int64(userId),
+		UserPassword: jsonRequest.Password,
+		OtpCode:      jsonRequest.OTPCode,
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.AccountDeletionResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.DeleteUserResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.AccountDeletionResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteUserResponse_WRONG_PASSWORD {
+		ctx.IndentedJSON(http.StatusForbidden, types.AccountDeletionResponse{Message: "wrong password"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteUserResponse_TWO_FACTOR_REQUIRED {
+		ctx.IndentedJSON(http.StatusForbidden, types.AccountDeletionResponse{Message: "two-factor authentication code required"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteUserResponse_WRONG_OTP_CODE {
+		ctx.IndentedJSON(http.StatusForbidden, types.AccountDeletionResponse{Message: "wrong two-factor authentication code"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteUserResponse_ALREADY_SCHEDULED {
+		ctx.IndentedJSON(http.StatusConflict, types.AccountDeletionResponse{
+			Message:             "account deletion is already scheduled",
+			DeletionScheduledAt: formatTimestamp(resp.GetDeletionScheduledAt()),
+		})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteUserResponse_OK {
+		// The account can only be used again to cancel the deletion after logging in
+		svc.revokeUserSessions(int64(userId))
+		svc.clearSessionCookie(ctx)
+		ctx.IndentedJSON(http.StatusOK, types.AccountDeletionResponse{
+			Message:             "OK",
+			DeletionScheduledAt: formatTimestamp(resp.GetDeletionScheduledAt()),
+		})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.AccountDeletionResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// CancelUserDeletion cancels the scheduled deletion of user account
+//
+//	@Summary		cancel account deletion
+//	@Description	cancel the scheduled deletion of user account
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	types.MessageResponse
+//	@Failure		400	{object}	types.MessageResponse
+//	@Failure		401	{object}	types.MessageResponse
+//	@Failure		409	{object}	types.MessageResponse
+//	@Failure		500	{object}	types.MessageResponse
+//	@Router			/users/delete/cancel [post]
+func (svc *WebService) CancelUserDeletion(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call CancelUserDeletion service
+	resp, err := svc.authenticateAndPostClient.CancelUserDeletion(ctx, &pb_aap.CancelUserDeletionRequest{
+		UserId: int64(userId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.CancelUserDeletionResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CancelUserDeletionResponse_NOT_SCHEDULED {
+		ctx.IndentedJSON(http.StatusConflict, types.MessageResponse{Message: "account deletion is not scheduled"})
+		return
+	} else if resp.GetStatus() == pb_aap.CancelUserDeletionResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
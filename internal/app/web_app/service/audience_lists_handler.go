@@ -0,0 +1,359 @@
+package service
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+)
+
+// CreateAudienceList creates a named list of users
+//
+//	@Summary		create audience list
+//	@Description	create a named list of users, such as close friends. Posts shared with a list are only visible to its members.
+//	@Tags			audience lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		types.CreateAudienceListRequest	true	"Audience list parameters"
+//	@Success		200		{object}	types.AudienceListResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		409		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/audience_lists [post]
+func (svc *WebService) CreateAudienceList(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.CreateAudienceListRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.CreateAudienceList(ctx, &pb_aap.CreateAudienceListRequest{
+		UserId: int64(userId),
+		Name:   jsonRequest.Name,
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.CreateAudienceListResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateAudienceListResponse_ALREADY_EXISTS {
+		ctx.IndentedJSON(http.StatusConflict, types.MessageResponse{Message: "audience list already exists"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateAudienceListResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.AudienceListResponse{
+			AudienceListID: resp.GetAudienceListId(),
+			Name:           jsonRequest.Name,
+		})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetAudienceLists gets audience lists of the current user
+//
+//	@Summary		get audience lists
+//	@Description	get audience lists of the current user with their members count
+//	@Tags			audience lists
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	types.AudienceListsResponse
+//	@Failure		400	{object}	types.MessageResponse
+//	@Failure		401	{object}	types.MessageResponse
+//	@Failure		500	{object}	types.MessageResponse
+//	@Router			/audience_lists [get]
+func (svc *WebService) GetAudienceLists(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.GetAudienceLists(ctx, &pb_aap.GetAudienceListsRequest{
+		UserId: int64(userId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetAudienceListsResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetAudienceListsResponse_OK {
+		audienceLists := []types.AudienceListResponse{}
+		for _, audienceList := range resp.GetAudienceLists() {
+			audienceLists = append(audienceLists, newAudienceListResponse(audienceList, nil))
+		}
+		ctx.IndentedJSON(http.StatusOK, types.AudienceListsResponse{AudienceLists: audienceLists})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetAudienceList gets an audience list with its members
+//
+//	@Summary		get audience list
+//	@Description	get an audience list of the current user with its members
+//	@Tags			audience lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			list_id	path		int	true	"Audience list ID"
+//	@Success		200		{object}	types.AudienceListResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		404		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/audience_lists/{list_id} [get]
+func (svc *WebService) GetAudienceList(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	listId, err := strconv.Atoi(ctx.Param("list_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "audience list not found"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.GetAudienceList(ctx, &pb_aap.GetAudienceListRequest{
+		UserId:         int64(userId),
+		AudienceListId: int64(listId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetAudienceListResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetAudienceListResponse_LIST_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "audience list not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetAudienceListResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, newAudienceListResponse(resp.GetAudienceList(), resp.GetMembersIds()))
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// DeleteAudienceList deletes an audience list
+//
+//	@Summary		delete audience list
+//	@Description	delete an audience list of the current user, posts shared with it stay visible to their author only
+//	@Tags			audience lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			list_id	path		int	true	"Audience list ID"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		404		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/audience_lists/{list_id} [delete]
+func (svc *WebService) DeleteAudienceList(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	listId, err := strconv.Atoi(ctx.Param("list_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "audience list not found"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.DeleteAudienceList(ctx, &pb_aap.DeleteAudienceListRequest{
+		UserId:         int64(userId),
+		AudienceListId: int64(listId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.DeleteAudienceListResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteAudienceListResponse_LIST_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "audience list not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteAudienceListResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// AddAudienceListMember adds an user to an audience list
+//
+//	@Summary		add audience list member
+//	@Description	add an user to an audience list of the current user
+//	@Tags			audience lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			list_id	path		int	true	"Audience list ID"
+//	@Param			user_id	path		int	true	"User ID"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		404		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/audience_lists/{list_id}/members/{user_id} [post]
+func (svc *WebService) AddAudienceListMember(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	listId, err := strconv.Atoi(ctx.Param("list_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "audience list not found"})
+		return
+	}
+	memberId, err := strconv.Atoi(ctx.Param("user_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.AddAudienceListMember(ctx, &pb_aap.AddAudienceListMemberRequest{
+		UserId:         int64(userId),
+		AudienceListId: int64(listId),
+		MemberId:       int64(memberId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.AddAudienceListMemberResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.AddAudienceListMemberResponse_LIST_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "audience list not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.AddAudienceListMemberResponse_ALREADY_MEMBER {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "already a member"})
+		return
+	} else if resp.GetStatus() == pb_aap.AddAudienceListMemberResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// RemoveAudienceListMember removes an user from an audience list
+//
+//	@Summary		remove audience list member
+//	@Description	remove an user from an audience list of the current user
+//	@Tags			audience lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			list_id	path		int	true	"Audience list ID"
+//	@Param			user_id	path		int	true	"User ID"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		404		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/audience_lists/{list_id}/members/{user_id} [delete]
+func (svc *WebService) RemoveAudienceListMember(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	listId, err := strconv.Atoi(ctx.Param("list_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "audience list not found"})
+		return
+	}
+	memberId, err := strconv.Atoi(ctx.Param("user_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.RemoveAudienceListMember(ctx, &pb_aap.RemoveAudienceListMemberRequest{
+		UserId:         int64(userId),
+		AudienceListId: int64(listId),
+		MemberId:       int64(memberId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.RemoveAudienceListMemberResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RemoveAudienceListMemberResponse_LIST_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "audience list not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RemoveAudienceListMemberResponse_NOT_MEMBER {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "not a member"})
+		return
+	} else if resp.GetStatus() == pb_aap.RemoveAudienceListMemberResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+func newAudienceListResponse(audienceList *pb_aap.AudienceListInfo, membersIds []int64) types.AudienceListResponse {
+	return types.AudienceListResponse{
+		AudienceListID: audienceList.GetAudienceListId(),
+		Name:           audienceList.GetName(),
+		MembersCount:   audienceList.GetMembersCount(),
+		CreatedAt:      formatTimestamp(audienceList.GetCreatedAt()),
+		MembersIds:     membersIds,
+	}
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+)
+
+// BlockUser blocks an user
+//
+//	@Summary		block user
+//	@Description	block user, follows between both users are removed and they can not follow each other, comment on or like the posts of each other, or see the posts of each other
+//	@Tags			friends
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id	path		int	true	"User ID"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/blocks/{user_id} [post]
+func (svc *WebService) BlockUser(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate parameter
+	otherUserId, err := strconv.Atoi(ctx.Param("user_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	}
+
+	// Call BlockUser service
+	resp, err := svc.authenticateAndPostClient.BlockUser(ctx, &pb_aap.BlockUserRequest{
+		UserId:        int64(userId),
+		BlockedUserId: int64(otherUserId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.BlockUserResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.BlockUserResponse_ALREADY_BLOCKED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "already blocked"})
+		return
+	} else if resp.GetStatus() == pb_aap.BlockUserResponse_NOT_ALLOWED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "can not block yourself"})
+		return
+	} else if resp.GetStatus() == pb_aap.BlockUserResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// UnblockUser unblocks an user
+//
+//	@Summary		unblock user
+//	@Description	unblock user
+//	@Tags			friends
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id	path		int	true	"User ID"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/blocks/{user_id} [delete]
+func (svc *WebService) UnblockUser(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate parameter
+	otherUserId, err := strconv.Atoi(ctx.Param("user_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	}
+
+	// Call UnblockUser service
+	resp, err := svc.authenticateAndPostClient.UnblockUser(ctx, &pb_aap.UnblockUserRequest{
+		UserId:        int64(userId),
+		BlockedUserId: int64(otherUserId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.UnblockUserResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.UnblockUserResponse_NOT_BLOCKED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "not blocked"})
+		return
+	} else if resp.GetStatus() == pb_aap.UnblockUserResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// MuteUser mutes an user
+//
+//	@Summary		mute user
+//	@Description	mute user, posts of muted users are not shown in newsfeed
+//	@Tags			friends
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id	path		int	true	"User ID"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/mutes/{user_id} [post]
+func (svc *WebService) MuteUser(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate parameter
+	otherUserId, err := strconv.Atoi(ctx.Param("user_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	}
+
+	// Call MuteUser service
+	resp, err := svc.authenticateAndPostClient.MuteUser(ctx, &pb_aap.MuteUserRequest{
+		UserId:      int64(userId),
+		MutedUserId: int64(otherUserId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.MuteUserResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.MuteUserResponse_ALREADY_MUTED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "already muted"})
+		return
+	} else if resp.GetStatus() == pb_aap.MuteUserResponse_NOT_ALLOWED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "can not mute yourself"})
+		return
+	} else if resp.GetStatus() == pb_aap.MuteUserResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// UnmuteUser unmutes an user
+//
+//	@Summary		unmute user
+//	@Description	unmute user
+//	@Tags			friends
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id	path		int	true	"User ID"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/mutes/{user_id} [delete]
+func (svc *WebService) UnmuteUser(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate parameter
+	otherUserId, err := strconv.Atoi(ctx.Param("user_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	}
+
+	// Call UnmuteUser service
+	resp, err := svc.authenticateAndPostClient.UnmuteUser(ctx, &pb_aap.UnmuteUserRequest{
+		UserId:      int64(userId),
+		MutedUserId: int64(otherUserId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.UnmuteUserResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.UnmuteUserResponse_NOT_MUTED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "not muted"})
+		return
+	} else if resp.GetStatus() == pb_aap.UnmuteUserResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+)
+
+// SavePost saves a post to user's bookmarks
+//
+//	@Summary		save post
+//	@Description	save post to user's bookmarks
+//	@Tags			bookmarks
+//	@Accept			json
+//	@Produce		json
+//	@Param			post_id	path		int	true	"Post ID"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/bookmarks/{post_id} [post]
+func (svc *WebService) SavePost(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	postId, err := strconv.Atoi(ctx.Param("post_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "post not found"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.SavePost(ctx, &pb_aap.SavePostRequest{
+		UserId: int64(userId),
+		PostId: int64(postId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.SavePostResponse_POST_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "post not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.SavePostResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.SavePostResponse_ALREADY_SAVED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "already saved"})
+		return
+	} else if resp.GetStatus() == pb_aap.SavePostResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// UnsavePost removes a post from user's bookmarks
+//
+//	@Summary		unsave post
+//	@Description	remove post from user's bookmarks
+//	@Tags			bookmarks
+//	@Accept			json
+//	@Produce		json
+//	@Param			post_id	path		int	true	"Post ID"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/bookmarks/{post_id} [delete]
+func (svc *WebService) UnsavePost(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	postId, err := strconv.Atoi(ctx.Param("post_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "post not found"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.UnsavePost(ctx, &pb_aap.UnsavePostRequest{
+		UserId: int64(userId),
+		PostId: int64(postId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.UnsavePostResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.UnsavePostResponse_NOT_SAVED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "not saved"})
+		return
+	} else if resp.GetStatus() == pb_aap.UnsavePostResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// ListSavedPosts lists posts in user's bookmarks
+//
+//	@Summary		list saved posts
+//	@Description	list posts in user's bookmarks, newest first
+//	@Tags			bookmarks
+//	@Accept			json
+//	@Produce		json
+//	@Param			cursor	query		int	false	"Cursor returned by previous page"
+//	@Param			limit	query		int	false	"Page size"
+//	@Success		200		{object}	types.SavedPostsResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/bookmarks [get]
+func (svc *WebService) ListSavedPosts(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check query params
+	cursor, err := strconv.ParseInt(ctx.DefaultQuery("cursor", "0"), 10, 64)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid cursor"})
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid limit"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.ListSavedPosts(ctx, &pb_aap.ListSavedPostsRequest{
+		UserId: int64(userId),
+		Cursor: cursor,
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.ListSavedPostsResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.ListSavedPostsResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.SavedPostsResponse{
+			PostsIds:   resp.GetPostsIds(),
+			NextCursor: resp.GetNextCursor(),
+		})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+)
+
+// RequestDataExport requests an archive of user data
+//
+//	@Summary		request data export
+//	@Description	request a ZIP archive of user profile, posts with their media, comments, likes, followers and followings. The archive is built in background, its download link is returned by GET /users/export once ready. Requesting again replaces the previous archive.
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Success		202	{object}	types.DataExportResponse
+//	@Failure		400	{object}	types.DataExportResponse
+//	@Failure		401	{object}	types.DataExportResponse
+//	@Failure		409	{object}	types.DataExportResponse
+//	@Failure		500	{object}	types.DataExportResponse
+//	@Router			/users/export [post]
+func (svc *WebService) RequestDataExport(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.DataExportResponse{Message: err.Error()})
+		return
+	}
+
+	// Call RequestDataExport service
+	resp, err := svc.authenticateAndPostClient.RequestDataExport(ctx, &pb_aap.RequestDataExportRequest{
+		UserId: int64(userId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.DataExportResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.RequestDataExportResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.DataExportResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RequestDataExportResponse_ALREADY_PENDING {
+		ctx.IndentedJSON(http.StatusConflict, newDataExportResponse("data export is already in progress", resp.GetExport()))
+		return
+	} else if resp.GetStatus() == pb_aap.RequestDataExportResponse_OK {
+		ctx.IndentedJSON(http.StatusAccepted, newDataExportResponse("OK", resp.GetExport()))
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.DataExportResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetDataExport gets the latest data export of user
+//
+//	@Summary		get data export
+//	@Description	get the state of the latest data export of user, with a short-lived download link once the archive is ready
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	types.DataExportResponse
+//	@Failure		400	{object}	types.DataExportResponse
+//	@Failure		401	{object}	types.DataExportResponse
+//	@Failure		404	{object}	types.DataExportResponse
+//	@Failure		500	{object}	types.DataExportResponse
+//	@Router			/users/export [get]
+func (svc *WebService) GetDataExport(ctx *gin.Context) {
+	// Check authorization
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.DataExportResponse{Message: err.Error()})
+		return
+	}
+
+	// Call GetDataExport service
+	resp, err := svc.authenticateAndPostClient.GetDataExport(ctx, &pb_aap.GetDataExportRequest{
+		UserId: int64(userId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.DataExportResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetDataExportResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.DataExportResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetDataExportResponse_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusNotFound, types.DataExportResponse{Message: "no data export requested"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetDataExportResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, newDataExportResponse("OK", resp.GetExport()))
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.DataExportResponse{Message: "unknown error"})
+		return
+	}
+}
+
+func newDataExportResponse(message string, export *pb_aap.DataExportInfo) types.DataExportResponse {
+	state := "pending"
+	if export.GetState() == pb_aap.DataExportInfo_READY {
+		state = "ready"
+	} else if export.GetState() == pb_aap.DataExportInfo_FAILED {
+		state = "failed"
+	}
+	return types.DataExportResponse{
+		Message:              message,
+		State:                state,
+		CreatedAt:            formatTimestamp(export.GetCreatedAt()),
+		CompletedAt:          formatTimestamp(export.GetCompletedAt()),
+		DownloadURL:          export.GetDownloadUrl(),
+		DownloadURLExpiresAt: formatTimestamp(export.GetDownloadUrlExpiresAt()),
+		ExpiresAt:            formatTimestamp(export.GetExpiresAt()),
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/maxuanquang/social-network/internal/pkg/types"
@@ -17,6 +18,8 @@ import (
 //	@Accept			json
 //	@Produce		json
 //	@Param			user_id	path		int	true	"User ID"
+//	@Param			cursor	query		int	false	"Cursor returned by previous page"
+//	@Param			limit	query		int	false	"Page size"
 //	@Success		200		{object}	types.UserFollowerResponse
 //	@Failure		400		{object}	types.MessageResponse
 //	@Failure		500		{object}	types.MessageResponse
@@ -28,17 +31,33 @@ func (svc *WebService) GetUserFollower(ctx *gin.Context) {
 		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
 		return
 	}
+	cursor, err := strconv.ParseInt(ctx.DefaultQuery("cursor", "0"), 10, 64)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid cursor"})
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid limit"})
+		return
+	}
 
 	// Call GetUserFollower gprc service
 	resp, err := svc.authenticateAndPostClient.GetUserFollower(ctx, &pb_aap.GetUserFollowerRequest{
 		UserId: int64(userId),
+		Cursor: cursor,
+		Limit:  int32(limit),
 	})
 	if err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
 		return
 	}
 	if resp.GetStatus() == pb_aap.GetUserFollowerResponse_OK {
-		ctx.IndentedJSON(http.StatusOK, types.UserFollowerResponse{FollowersIds: resp.GetFollowersIds()})
+		ctx.IndentedJSON(http.StatusOK, types.UserFollowerResponse{
+			FollowersIds: resp.GetFollowersIds(),
+			NextCursor:   resp.GetNextCursor(),
+			TotalCount:   resp.GetTotalCount(),
+		})
 		return
 	} else if resp.GetStatus() == pb_aap.GetUserFollowerResponse_USER_NOT_FOUND {
 		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
@@ -57,6 +76,8 @@ func (svc *WebService) GetUserFollower(ctx *gin.Context) {
 //	@Accept			json
 //	@Produce		json
 //	@Param			user_id	path		int	true	"User ID"
+//	@Param			cursor	query		int	false	"Cursor returned by previous page"
+//	@Param			limit	query		int	false	"Page size"
 //	@Success		200		{object}	types.UserFollowingResponse
 //	@Failure		400		{object}	types.MessageResponse
 //	@Failure		500		{object}	types.MessageResponse
@@ -68,17 +89,33 @@ func (svc *WebService) GetUserFollowing(ctx *gin.Context) {
 		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
 		return
 	}
+	cursor, err := strconv.ParseInt(ctx.DefaultQuery("cursor", "0"), 10, 64)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid cursor"})
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid limit"})
+		return
+	}
 
 	// Call GetUserFollower gprc service
 	resp, err := svc.authenticateAndPostClient.GetUserFollowing(ctx, &pb_aap.GetUserFollowingRequest{
 		UserId: int64(userId),
+		Cursor: cursor,
+		Limit:  int32(limit),
 	})
 	if err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
 		return
 	}
 	if resp.GetStatus() == pb_aap.GetUserFollowingResponse_OK {
-		ctx.IndentedJSON(http.StatusOK, types.UserFollowingResponse{FollowingsIds: resp.GetFollowingsIds()})
+		ctx.IndentedJSON(http.StatusOK, types.UserFollowingResponse{
+			FollowingsIds: resp.GetFollowingsIds(),
+			NextCursor:    resp.GetNextCursor(),
+			TotalCount:    resp.GetTotalCount(),
+		})
 		return
 	} else if resp.GetStatus() == pb_aap.GetUserFollowingResponse_USER_NOT_FOUND {
 		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
@@ -92,13 +129,15 @@ func (svc *WebService) GetUserFollowing(ctx *gin.Context) {
 // FollowUser follows an user
 //
 //	@Summary		follow user
-//	@Description	follow user
+//	@Description	follow user, following a private account sends a follow request instead
 //	@Tags			friends
 //	@Accept			json
 //	@Produce		json
 //	@Param			user_id	path		int	true	"User ID"
 //	@Success		200		{object}	types.MessageResponse
+//	@Success		202		{object}	types.MessageResponse
 //	@Failure		400		{object}	types.MessageResponse
+//	@Failure		403		{object}	types.MessageResponse
 //	@Failure		500		{object}	types.MessageResponse
 //	@Router			/friends/{user_id} [post]
 func (svc *WebService) FollowUser(ctx *gin.Context) {
@@ -132,6 +171,15 @@ func (svc *WebService) FollowUser(ctx *gin.Context) {
 	} else if resp.GetStatus() == pb_aap.FollowUserResponse_ALREADY_FOLLOWED {
 		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "already followed"})
 		return
+	} else if resp.GetStatus() == pb_aap.FollowUserResponse_ALREADY_REQUESTED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "follow request already sent"})
+		return
+	} else if resp.GetStatus() == pb_aap.FollowUserResponse_NOT_ALLOWED {
+		ctx.IndentedJSON(http.StatusForbidden, types.MessageResponse{Message: "not allowed to follow this user"})
+		return
+	} else if resp.GetStatus() == pb_aap.FollowUserResponse_REQUESTED {
+		ctx.IndentedJSON(http.StatusAccepted, types.MessageResponse{Message: "follow request sent"})
+		return
 	} else if resp.GetStatus() == pb_aap.FollowUserResponse_OK {
 		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
 		return
@@ -144,7 +192,7 @@ func (svc *WebService) FollowUser(ctx *gin.Context) {
 // UnfollowUser unfollows an user
 //
 //	@Summary		unfollow user
-//	@Description	unfollow user
+//	@Description	unfollow user, or cancel the pending follow request to a private account
 //	@Tags			friends
 //	@Accept			json
 //	@Produce		json
@@ -196,13 +244,14 @@ func (svc *WebService) UnfollowUser(ctx *gin.Context) {
 // GetUserPosts get all posts an user
 //
 //	@Summary		get all posts of user
-//	@Description	get all posts user
+//	@Description	get all posts user, posts of private accounts are only visible to their approved followers
 //	@Tags			friends
 //	@Accept			json
 //	@Produce		json
 //	@Param			user_id	path		int	true	"User ID"
 //	@Success		200		{object}	types.UserPostsResponse
 //	@Failure		400		{object}	types.MessageResponse
+//	@Failure		403		{object}	types.MessageResponse
 //	@Failure		500		{object}	types.MessageResponse
 //	@Router			/friends/{user_id}/posts [get]
 func (svc *WebService) GetUserPosts(ctx *gin.Context) {
@@ -213,10 +262,17 @@ func (svc *WebService) GetUserPosts(ctx *gin.Context) {
 		return
 	}
 
+	// Posts of private accounts are only visible to logged in followers
+	_, viewerId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		viewerId = 0
+	}
+
 	// Call GetUserPost grpc service
 	resp, err := svc.authenticateAndPostClient.GetUserPosts(ctx,
 		&pb_aap.GetUserPostsRequest{
-			UserId: int64(userId),
+			UserId:   int64(userId),
+			ViewerId: int64(viewerId),
 		},
 	)
 	if err != nil {
@@ -225,8 +281,283 @@ func (svc *WebService) GetUserPosts(ctx *gin.Context) {
 	}
 	if resp.GetStatus() == pb_aap.GetUserPostsResponse_USER_NOT_FOUND {
 		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+	} else if resp.GetStatus() == pb_aap.GetUserPostsResponse_PRIVATE_ACCOUNT {
+		ctx.IndentedJSON(http.StatusForbidden, types.MessageResponse{Message: "account is private"})
+		return
 	} else if resp.GetStatus() == pb_aap.GetUserPostsResponse_OK {
-		ctx.IndentedJSON(http.StatusOK, types.UserPostsResponse{PostsIds: resp.GetPostsIds()})
+		ctx.IndentedJSON(http.StatusOK, types.UserPostsResponse{
+			PostsIds:       resp.GetPostsIds(),
+			PinnedPostsIds: resp.GetPinnedPostsIds(),
+		})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetFollowRequests gets pending follow requests of the current user
+//
+//	@Summary		get follow requests
+//	@Description	get IDs of users waiting for approval to follow the current user, oldest first
+//	@Tags			friends
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	types.FollowRequestsResponse
+//	@Failure		400	{object}	types.MessageResponse
+//	@Failure		401	{object}	types.MessageResponse
+//	@Failure		500	{object}	types.MessageResponse
+//	@Router			/friends/requests [get]
+func (svc *WebService) GetFollowRequests(ctx *gin.Context) {
+	// Check sessionId authentication
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: "session unauthorized"})
+		return
+	}
+
+	// Call GetFollowRequests grpc service
+	resp, err := svc.authenticateAndPostClient.GetFollowRequests(ctx, &pb_aap.GetFollowRequestsRequest{
+		UserId: int64(userId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetFollowRequestsResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetFollowRequestsResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.FollowRequestsResponse{FollowersIds: resp.GetFollowersIds()})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// ApproveFollowRequest approves a pending follow request
+//
+//	@Summary		approve follow request
+//	@Description	approve the follow request of an user, who becomes a follower of the current user
+//	@Tags			friends
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id	path		int	true	"User ID"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		404		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/friends/requests/{user_id} [post]
+func (svc *WebService) ApproveFollowRequest(ctx *gin.Context) {
+	// Check sessionId authentication
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: "session unauthorized"})
+		return
+	}
+
+	// Validate parameter
+	followerId, err := strconv.Atoi(ctx.Param("user_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	}
+
+	// Call ApproveFollowRequest grpc service
+	resp, err := svc.authenticateAndPostClient.ApproveFollowRequest(ctx, &pb_aap.ApproveFollowRequestRequest{
+		UserId:     int64(userId),
+		FollowerId: int64(followerId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.ApproveFollowRequestResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.ApproveFollowRequestResponse_REQUEST_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "follow request not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.ApproveFollowRequestResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// RejectFollowRequest rejects a pending follow request
+//
+//	@Summary		reject follow request
+//	@Description	reject the follow request of an user
+//	@Tags			friends
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_id	path		int	true	"User ID"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		404		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/friends/requests/{user_id} [delete]
+func (svc *WebService) RejectFollowRequest(ctx *gin.Context) {
+	// Check sessionId authentication
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: "session unauthorized"})
+		return
+	}
+
+	// Validate parameter
+	followerId, err := strconv.Atoi(ctx.Param("user_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	}
+
+	// Call RejectFollowRequest grpc service
+	resp, err := svc.authenticateAndPostClient.RejectFollowRequest(ctx, &pb_aap.RejectFollowRequestRequest{
+		UserId:     int64(userId),
+		FollowerId: int64(followerId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.RejectFollowRequestResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RejectFollowRequestResponse_REQUEST_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "follow request not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.RejectFollowRequestResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetRelationships gets relationships between the current user and other users
+//
+//	@Summary		get relationships
+//	@Description	for each user of ids, tell if the current user follows it, is followed by it, blocked it or sent it a pending follow request, and how many other users both of them follow. At most 100 ids are accepted.
+//	@Tags			friends
+//	@Accept			json
+//	@Produce		json
+//	@Param			ids	query		string	true	"Comma separated user IDs"
+//	@Success		200	{object}	types.RelationshipsResponse
+//	@Failure		400	{object}	types.MessageResponse
+//	@Failure		401	{object}	types.MessageResponse
+//	@Failure		500	{object}	types.MessageResponse
+//	@Router			/friends/relationships [get]
+func (svc *WebService) GetRelationships(ctx *gin.Context) {
+	// Check sessionId authentication
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: "session unauthorized"})
+		return
+	}
+
+	// Validate parameter
+	var targetsIds []int64
+	for _, stringId := range strings.Split(ctx.Query("ids"), ",") {
+		if stringId == "" {
+			continue
+		}
+		targetId, err := strconv.ParseInt(stringId, 10, 64)
+		if err != nil {
+			ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid ids"})
+			return
+		}
+		targetsIds = append(targetsIds, targetId)
+	}
+	if len(targetsIds) == 0 {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid ids"})
+		return
+	}
+
+	// Call GetRelationship grpc service
+	resp, err := svc.authenticateAndPostClient.GetRelationship(ctx, &pb_aap.GetRelationshipRequest{
+		UserId:     int64(userId),
+		TargetsIds: targetsIds,
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetRelationshipResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetRelationshipResponse_TOO_MANY_TARGETS {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "too many ids"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetRelationshipResponse_OK {
+		relationships := []types.Relationship{}
+		for _, relationship := range resp.GetRelationships() {
+			relationships = append(relationships, types.Relationship{
+				UserID:      relationship.GetTargetId(),
+				Following:   relationship.GetFollowing(),
+				FollowedBy:  relationship.GetFollowedBy(),
+				Blocked:     relationship.GetBlocked(),
+				Requested:   relationship.GetRequested(),
+				MutualCount: relationship.GetMutualCount(),
+			})
+		}
+		ctx.IndentedJSON(http.StatusOK, types.RelationshipsResponse{Relationships: relationships})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetFollowSuggestions gets users the current user may know
+//
+//	@Summary		get follow suggestions
+//	@Description	get users the current user may want to follow, ranked by followings in common, shared hashtags and popularity. Suggestions are refreshed periodically.
+//	@Tags			friends
+//	@Accept			json
+//	@Produce		json
+//	@Param			limit	query		int	false	"Number of suggestions"
+//	@Success		200		{object}	types.FollowSuggestionsResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/friends/suggestions [get]
+func (svc *WebService) GetFollowSuggestions(ctx *gin.Context) {
+	// Check sessionId authentication
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: "session unauthorized"})
+		return
+	}
+
+	// Check query params
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid limit"})
+		return
+	}
+
+	// Call GetFollowSuggestions grpc service
+	resp, err := svc.authenticateAndPostClient.GetFollowSuggestions(ctx, &pb_aap.GetFollowSuggestionsRequest{
+		UserId: int64(userId),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetFollowSuggestionsResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetFollowSuggestionsResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.FollowSuggestionsResponse{UsersIds: resp.GetUsersIds()})
 		return
 	} else {
 		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
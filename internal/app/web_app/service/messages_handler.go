@@ -0,0 +1,377 @@
+package service
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+
+	pb_msg "github.com/maxuanquang/social-network/pkg/types/proto/pb/messenger"
+)
+
+// SendMessage sends a direct message to an user
+//
+//	@Summary		send message
+//	@Description	send a direct message to an user, the conversation with the user is created by the first message. Users can not message users they blocked or who blocked them.
+//	@Tags			messages
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		types.SendMessageRequest	true	"Message parameters"
+//	@Success		200		{object}	types.DirectMessage
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		403		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/messages [post]
+func (svc *WebService) SendMessage(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.SendMessageRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.messengerClient.SendMessage(ctx, &pb_msg.SendMessageRequest{
+		UserId:      int64(userId),
+		RecipientId: jsonRequest.RecipientID,
+		ContentText: jsonRequest.ContentText,
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_msg.SendMessageResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_msg.SendMessageResponse_RECIPIENT_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "recipient not found"})
+		return
+	} else if resp.GetStatus() == pb_msg.SendMessageResponse_INVALID_RECIPIENT {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "can not message yourself"})
+		return
+	} else if resp.GetStatus() == pb_msg.SendMessageResponse_BLOCKED {
+		ctx.IndentedJSON(http.StatusForbidden, types.MessageResponse{Message: "user is blocked"})
+		return
+	} else if resp.GetStatus() == pb_msg.SendMessageResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, newDirectMessage(resp.GetMessage()))
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// DeleteMessage deletes a message sent by the current user
+//
+//	@Summary		delete message
+//	@Description	delete a message sent by the current user, it is removed for both users of the conversation
+//	@Tags			messages
+//	@Accept			json
+//	@Produce		json
+//	@Param			message_id	path		int	true	"Message ID"
+//	@Success		200			{object}	types.MessageResponse
+//	@Failure		401			{object}	types.MessageResponse
+//	@Failure		404			{object}	types.MessageResponse
+//	@Failure		500			{object}	types.MessageResponse
+//	@Router			/messages/{message_id} [delete]
+func (svc *WebService) DeleteMessage(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	messageId, err := strconv.Atoi(ctx.Param("message_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "message not found"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.messengerClient.DeleteMessage(ctx, &pb_msg.DeleteMessageRequest{
+		UserId:    int64(userId),
+		MessageId: int64(messageId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_msg.DeleteMessageResponse_MESSAGE_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "message not found"})
+		return
+	} else if resp.GetStatus() == pb_msg.DeleteMessageResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetConversations gets conversations of the current user
+//
+//	@Summary		get conversations
+//	@Description	get conversations of the current user with their last message and unread count, the most recent first
+//	@Tags			messages
+//	@Accept			json
+//	@Produce		json
+//	@Param			cursor	query		int	false	"next_cursor of the previous page"
+//	@Param			limit	query		int	false	"Page size"
+//	@Success		200		{object}	types.ConversationsResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/conversations [get]
+func (svc *WebService) GetConversations(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check query params
+	cursor, err := strconv.ParseInt(ctx.DefaultQuery("cursor", "0"), 10, 64)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid cursor"})
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid limit"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.messengerClient.GetConversations(ctx, &pb_msg.GetConversationsRequest{
+		UserId: int64(userId),
+		Cursor: cursor,
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_msg.GetConversationsResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_msg.GetConversationsResponse_OK {
+		conversations := []types.ConversationResponse{}
+		for _, conversation := range resp.GetConversations() {
+			conversationResponse := types.ConversationResponse{
+				ConversationID: conversation.GetConversationId(),
+				OtherUserID:    conversation.GetOtherUserId(),
+				UnreadCount:    conversation.GetUnreadCount(),
+			}
+			if conversation.GetLastMessage() != nil {
+				lastMessage := newDirectMessage(conversation.GetLastMessage())
+				conversationResponse.LastMessage = &lastMessage
+			}
+			conversations = append(conversations, conversationResponse)
+		}
+		ctx.IndentedJSON(http.StatusOK, types.ConversationsResponse{
+			Conversations: conversations,
+			NextCursor:    resp.GetNextCursor(),
+		})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetMessages gets messages of a conversation
+//
+//	@Summary		get messages
+//	@Description	get messages of a conversation of the current user, the newest first
+//	@Tags			messages
+//	@Accept			json
+//	@Produce		json
+//	@Param			conversation_id	path		int	true	"Conversation ID"
+//	@Param			cursor			query		int	false	"next_cursor of the previous page"
+//	@Param			limit			query		int	false	"Page size"
+//	@Success		200				{object}	types.DirectMessagesResponse
+//	@Failure		400				{object}	types.MessageResponse
+//	@Failure		401				{object}	types.MessageResponse
+//	@Failure		404				{object}	types.MessageResponse
+//	@Failure		500				{object}	types.MessageResponse
+//	@Router			/conversations/{conversation_id}/messages [get]
+func (svc *WebService) GetMessages(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL and query params
+	conversationId, err := strconv.Atoi(ctx.Param("conversation_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "conversation not found"})
+		return
+	}
+	cursor, err := strconv.ParseInt(ctx.DefaultQuery("cursor", "0"), 10, 64)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid cursor"})
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid limit"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.messengerClient.GetMessages(ctx, &pb_msg.GetMessagesRequest{
+		UserId:         int64(userId),
+		ConversationId: int64(conversationId),
+		Cursor:         cursor,
+		Limit:          int32(limit),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_msg.GetMessagesResponse_CONVERSATION_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "conversation not found"})
+		return
+	} else if resp.GetStatus() == pb_msg.GetMessagesResponse_OK {
+		messages := []types.DirectMessage{}
+		for _, message := range resp.GetMessages() {
+			messages = append(messages, newDirectMessage(message))
+		}
+		ctx.IndentedJSON(http.StatusOK, types.DirectMessagesResponse{
+			Messages:   messages,
+			NextCursor: resp.GetNextCursor(),
+		})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// MarkConversationRead marks all messages of a conversation as read
+//
+//	@Summary		mark conversation read
+//	@Description	mark all messages of a conversation of the current user as read
+//	@Tags			messages
+//	@Accept			json
+//	@Produce		json
+//	@Param			conversation_id	path		int	true	"Conversation ID"
+//	@Success		200				{object}	types.MessageResponse
+//	@Failure		401				{object}	types.MessageResponse
+//	@Failure		404				{object}	types.MessageResponse
+//	@Failure		500				{object}	types.MessageResponse
+//	@Router			/conversations/{conversation_id}/read [post]
+func (svc *WebService) MarkConversationRead(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	conversationId, err := strconv.Atoi(ctx.Param("conversation_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "conversation not found"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.messengerClient.MarkConversationRead(ctx, &pb_msg.MarkConversationReadRequest{
+		UserId:         int64(userId),
+		ConversationId: int64(conversationId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_msg.MarkConversationReadResponse_CONVERSATION_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "conversation not found"})
+		return
+	} else if resp.GetStatus() == pb_msg.MarkConversationReadResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// DeleteConversation deletes a conversation for the current user
+//
+//	@Summary		delete conversation
+//	@Description	delete a conversation for the current user only, it shows up again with the next message
+//	@Tags			messages
+//	@Accept			json
+//	@Produce		json
+//	@Param			conversation_id	path		int	true	"Conversation ID"
+//	@Success		200				{object}	types.MessageResponse
+//	@Failure		401				{object}	types.MessageResponse
+//	@Failure		404				{object}	types.MessageResponse
+//	@Failure		500				{object}	types.MessageResponse
+//	@Router			/conversations/{conversation_id} [delete]
+func (svc *WebService) DeleteConversation(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	conversationId, err := strconv.Atoi(ctx.Param("conversation_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "conversation not found"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.messengerClient.DeleteConversation(ctx, &pb_msg.DeleteConversationRequest{
+		UserId:         int64(userId),
+		ConversationId: int64(conversationId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_msg.DeleteConversationResponse_CONVERSATION_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusNotFound, types.MessageResponse{Message: "conversation not found"})
+		return
+	} else if resp.GetStatus() == pb_msg.DeleteConversationResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+func newDirectMessage(message *pb_msg.MessageInfo) types.DirectMessage {
+	return types.DirectMessage{
+		MessageID:      message.GetMessageId(),
+		ConversationID: message.GetConversationId(),
+		SenderID:       message.GetSenderId(),
+		ContentText:    message.GetContentText(),
+		CreatedAt:      formatTimestamp(message.GetCreatedAt()),
+	}
+}
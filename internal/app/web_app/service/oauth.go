@@ -0,0 +1,373 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/maxuanquang/social-network/internal/auth"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+)
+
+const defaultAuthorizationCodeLifetime = 10 * time.Minute
+
+var (
+	errOAuthClientNotFound      = errors.New("client not found")
+	errInvalidAuthorizationCode = errors.New("invalid authorization code")
+	errRedirectURINotRegistered = errors.New("redirect uri is not registered")
+	errInvalidConsentToken      = errors.New("invalid consent token")
+)
+
+// OAuth2 clients are stored in Redis:
+//
+//	oauth_client:<client_id>          hash of the client
+//	user_oauth_clients:<uid>          clients registered by an user
+//	oauth_code:<hash>                 hash of a pending authorization code, only its sha256 is stored
+//	oauth_consent:<hash>              hash of a consent token given to the consent page, only its sha256 is stored
+//
+// Public clients have no secret and can only use the authorization code grant, which always requires PKCE.
+
+// oauthClient is a third-party application registered by an user
+type oauthClient struct {
+	ID           string
+	Name         string
+	OwnerID      int64
+	SecretHash   string
+	RedirectURIs []string
+	Scopes       []string
+	CreatedAt    time.Time
+}
+
+func (c *oauthClient) confidential() bool {
+	return c.SecretHash != ""
+}
+
+// authorizationCode is what an authorization code grants, bound to the client, redirect URI and PKCE challenge
+type authorizationCode struct {
+	ClientID      string
+	UserID        int64
+	RedirectURI   string
+	Scopes        []string
+	CodeChallenge string
+}
+
+// createOAuthClient registers a client of an user, secret is empty for public clients
+func (svc *WebService) createOAuthClient(ownerId int64, name string, redirectURIs []string, scopes []string, confidential bool) (client *oauthClient, secret string, err error) {
+	client = &oauthClient{
+		ID:           uuid.New().String(),
+		Name:         name,
+		OwnerID:      ownerId,
+		RedirectURIs: redirectURIs,
+		Scopes:       scopes,
+		CreatedAt:    time.Now(),
+	}
+	if confidential {
+		secret, err = randomToken()
+		if err != nil {
+			return nil, "", err
+		}
+		client.SecretHash = hashToken(secret)
+	}
+
+	pipe := svc.redisClient.TxPipeline()
+	pipe.HSet(svc.redisClient.Context(), fmt.Sprintf("oauth_client:%s", client.ID),
+		"name", client.Name,
+		"owner_id", client.OwnerID,
+		"secret_hash", client.SecretHash,
+		"redirect_uris", strings.Join(client.RedirectURIs, " "),
+		"scope", auth.FormatScope(client.Scopes),
+		"created_at", client.CreatedAt.Unix(),
+	)
+	pipe.SAdd(svc.redisClient.Context(), fmt.Sprintf("user_oauth_clients:%d", ownerId), client.ID)
+	_, err = pipe.Exec(svc.redisClient.Context())
+	if err != nil {
+		return nil, "", err
+	}
+	return client, secret, nil
+}
+
+func (svc *WebService) getOAuthClient(clientId string) (*oauthClient, error) {
+	fields, err := svc.redisClient.HGetAll(svc.redisClient.Context(), fmt.Sprintf("oauth_client:%s", clientId)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, errOAuthClientNotFound
+	}
+	ownerId, _ := strconv.ParseInt(fields["owner_id"], 10, 64)
+	createdAt, _ := strconv.ParseInt(fields["created_at"], 10, 64)
+	scopes, err := auth.ParseScope(fields["scope"])
+	if err != nil {
+		return nil, err
+	}
+	return &oauthClient{
+		ID:           clientId,
+		Name:         fields["name"],
+		OwnerID:      ownerId,
+		SecretHash:   fields["secret_hash"],
+		RedirectURIs: strings.Fields(fields["redirect_uris"]),
+		Scopes:       scopes,
+		CreatedAt:    time.Unix(createdAt, 0),
+	}, nil
+}
+
+// listOAuthClients returns the clients registered by an user
+func (svc *WebService) listOAuthClients(ownerId int64) ([]*oauthClient, error) {
+	clientIds, err := svc.redisClient.SMembers(svc.redisClient.Context(), fmt.Sprintf("user_oauth_clients:%d", ownerId)).Result()
+	if err != nil {
+		return nil, err
+	}
+	var clients []*oauthClient
+	for _, clientId := range clientIds {
+		client, err := svc.getOAuthClient(clientId)
+		if err != nil {
+			continue
+		}
+		clients = append(clients, client)
+	}
+	return clients, nil
+}
+
+// deleteOAuthClient removes a client, its tokens are rejected from then on
+func (svc *WebService) deleteOAuthClient(client *oauthClient) {
+	svc.redisClient.Del(svc.redisClient.Context(), fmt.Sprintf("oauth_client:%s", client.ID))
+	svc.redisClient.SRem(svc.redisClient.Context(), fmt.Sprintf("user_oauth_clients:%d", client.OwnerID), client.ID)
+}
+
+// authenticateOAuthClient returns the client of a token request, authenticated with HTTP basic
+// or with client_id and client_secret form parameters. Public clients only send client_id.
+func (svc *WebService) authenticateOAuthClient(ctx *gin.Context) (*oauthClient, error) {
+	clientId, clientSecret, ok := ctx.Request.BasicAuth()
+	if ok {
+		clientId, _ = url.QueryUnescape(clientId)
+		clientSecret, _ = url.QueryUnescape(clientSecret)
+	} else {
+		clientId = ctx.PostForm("client_id")
+		clientSecret = ctx.PostForm("client_secret")
+	}
+	if clientId == "" {
+		return nil, errOAuthClientNotFound
+	}
+
+	client, err := svc.getOAuthClient(clientId)
+	if err != nil {
+		return nil, errOAuthClientNotFound
+	}
+	if client.confidential() {
+		if subtle.ConstantTimeCompare([]byte(hashToken(clientSecret)), []byte(client.SecretHash)) != 1 {
+			return nil, errOAuthClientNotFound
+		}
+	} else if clientSecret != "" {
+		return nil, errOAuthClientNotFound
+	}
+	return client, nil
+}
+
+// issueAuthorizationCode creates a single-use authorization code
+func (svc *WebService) issueAuthorizationCode(code *authorizationCode) (string, error) {
+	value, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+
+	codeKey := fmt.Sprintf("oauth_code:%s", hashToken(value))
+	pipe := svc.redisClient.TxPipeline()
+	pipe.HSet(svc.redisClient.Context(), codeKey,
+		"client_id", code.ClientID,
+		"user_id", code.UserID,
+		"redirect_uri", code.RedirectURI,
+		"scope", auth.FormatScope(code.Scopes),
+		"code_challenge", code.CodeChallenge,
+	)
+	pipe.Expire(svc.redisClient.Context(), codeKey, svc.authorizationCodeLifetime())
+	_, err = pipe.Exec(svc.redisClient.Context())
+	if err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// redeemAuthorizationCode consumes an authorization code of clientId and checks its redirect URI and PKCE verifier
+func (svc *WebService) redeemAuthorizationCode(value string, clientId string, redirectURI string, codeVerifier string) (*authorizationCode, error) {
+	codeKey := fmt.Sprintf("oauth_code:%s", hashToken(value))
+	fields, err := svc.redisClient.HGetAll(svc.redisClient.Context(), codeKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	// Codes are deleted whatever the outcome so that they can not be guessed or used twice
+	if len(fields) == 0 || svc.redisClient.Del(svc.redisClient.Context(), codeKey).Val() != 1 {
+		return nil, errInvalidAuthorizationCode
+	}
+	if fields["client_id"] != clientId || fields["redirect_uri"] != redirectURI {
+		return nil, errInvalidAuthorizationCode
+	}
+	if !verifyCodeChallenge(fields["code_challenge"], codeVerifier) {
+		return nil, errInvalidAuthorizationCode
+	}
+
+	userId, err := strconv.ParseInt(fields["user_id"], 10, 64)
+	if err != nil {
+		return nil, errInvalidAuthorizationCode
+	}
+	scopes, err := auth.ParseScope(fields["scope"])
+	if err != nil {
+		return nil, errInvalidAuthorizationCode
+	}
+	return &authorizationCode{
+		ClientID:      clientId,
+		UserID:        userId,
+		RedirectURI:   redirectURI,
+		Scopes:        scopes,
+		CodeChallenge: fields["code_challenge"],
+	}, nil
+}
+
+// issueConsentToken creates a single-use token which the consent page must send back to approve or deny
+// an authorization request. It is bound to the session and to the request so that a forged approval
+// made with the session cookie alone is rejected.
+func (svc *WebService) issueConsentToken(sessionId string, userId int64, code *authorizationCode) (string, error) {
+	value, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+
+	consentKey := fmt.Sprintf("oauth_consent:%s", hashToken(value))
+	pipe := svc.redisClient.TxPipeline()
+	pipe.HSet(svc.redisClient.Context(), consentKey,
+		"session_id", sessionId,
+		"user_id", userId,
+		"client_id", code.ClientID,
+		"redirect_uri", code.RedirectURI,
+		"scope", auth.FormatScope(code.Scopes),
+		"code_challenge", code.CodeChallenge,
+	)
+	pipe.Expire(svc.redisClient.Context(), consentKey, svc.authorizationCodeLifetime())
+	_, err = pipe.Exec(svc.redisClient.Context())
+	if err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// redeemConsentToken consumes a consent token and checks it was issued to the session for the same request
+func (svc *WebService) redeemConsentToken(value string, sessionId string, userId int64, code *authorizationCode) error {
+	if value == "" {
+		return errInvalidConsentToken
+	}
+	consentKey := fmt.Sprintf("oauth_consent:%s", hashToken(value))
+	fields, err := svc.redisClient.HGetAll(svc.redisClient.Context(), consentKey).Result()
+	if err != nil {
+		return err
+	}
+	if len(fields) == 0 || svc.redisClient.Del(svc.redisClient.Context(), consentKey).Val() != 1 {
+		return errInvalidConsentToken
+	}
+	if fields["session_id"] != sessionId || fields["user_id"] != strconv.FormatInt(userId, 10) ||
+		fields["client_id"] != code.ClientID || fields["redirect_uri"] != code.RedirectURI ||
+		fields["scope"] != auth.FormatScope(code.Scopes) || fields["code_challenge"] != code.CodeChallenge {
+		return errInvalidConsentToken
+	}
+	return nil
+}
+
+// AllowedOrigin checks if origin is one of the configured frontend origins
+func (svc *WebService) AllowedOrigin(origin string) bool {
+	for _, allowed := range svc.cfg.AllowedOrigins {
+		if origin == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckScopes rejects requests with a bearer token that lacks the scope of the route:
+// read for GET and HEAD requests, write for the others.
+// Requests authenticated by the session cookie have all scopes of their user.
+func (svc *WebService) CheckScopes() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		scope := auth.ScopeWrite
+		if ctx.Request.Method == http.MethodGet || ctx.Request.Method == http.MethodHead {
+			scope = auth.ScopeRead
+		}
+		svc.checkScope(ctx, scope)
+	}
+}
+
+// RequireScope rejects requests with a bearer token that lacks scope
+func (svc *WebService) RequireScope(scope string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		svc.checkScope(ctx, scope)
+	}
+}
+
+func (svc *WebService) checkScope(ctx *gin.Context, scope string) {
+	accessToken := auth.ExtractToken(ctx)
+	if accessToken == "" {
+		return
+	}
+	token, err := svc.parseAccessToken(accessToken)
+	if err != nil {
+		ctx.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if !auth.HasScope(token.Scopes, scope) {
+		ctx.Header("WWW-Authenticate", fmt.Sprintf(`Bearer error="insufficient_scope", scope="%s"`, scope))
+		ctx.AbortWithStatusJSON(http.StatusForbidden, types.MessageResponse{Message: fmt.Sprintf("token does not have %s scope", scope)})
+		return
+	}
+	// Tokens of deleted clients are rejected
+	if token.ClientID != "" {
+		_, err = svc.getOAuthClient(token.ClientID)
+		if err != nil {
+			ctx.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, types.MessageResponse{Message: "client not found"})
+			return
+		}
+	}
+}
+
+func (svc *WebService) authorizationCodeLifetime() time.Duration {
+	if svc.cfg.OAuth.AuthorizationCodeLifetime <= 0 {
+		return defaultAuthorizationCodeLifetime
+	}
+	return svc.cfg.OAuth.AuthorizationCodeLifetime
+}
+
+// validRedirectURI checks if uri is an absolute URI without fragment
+func validRedirectURI(uri string) bool {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+	return parsed.IsAbs() && parsed.Host != "" && parsed.Fragment == "" && !strings.ContainsAny(uri, " ")
+}
+
+// verifyCodeChallenge checks a PKCE S256 code verifier against its challenge
+func verifyCodeChallenge(codeChallenge string, codeVerifier string) bool {
+	if codeChallenge == "" || len(codeVerifier) < 43 || len(codeVerifier) > 128 {
+		return false
+	}
+	sum := sha256.Sum256([]byte(codeVerifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(codeChallenge)) == 1
+}
+
+func randomToken() (string, error) {
+	tokenBytes := make([]byte, 32)
+	_, err := rand.Read(tokenBytes)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(tokenBytes), nil
+}
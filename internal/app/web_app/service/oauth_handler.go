@@ -0,0 +1,513 @@
+package service
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxuanquang/social-network/internal/auth"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+)
+
+// CreateOAuthClient registers a third-party application of user
+//
+//	@Summary		register OAuth2 client
+//	@Description	register a third-party application, confidential clients get a secret which is only shown once
+//	@Tags			oauth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		types.CreateOAuthClientRequest	true	"Client parameters"
+//	@Success		200		{object}	types.OAuthClientResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/oauth/clients [post]
+func (svc *WebService) CreateOAuthClient(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.CreateOAuthClientRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	for _, redirectURI := range jsonRequest.RedirectURIs {
+		if !validRedirectURI(redirectURI) {
+			ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid redirect uri " + redirectURI})
+			return
+		}
+	}
+	scopes, err := auth.ParseScope(jsonRequest.Scope)
+	if err != nil || len(scopes) == 0 || !auth.ScopesAllowed(scopes, auth.ThirdPartyScopes) {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid scope"})
+		return
+	}
+
+	client, secret, err := svc.createOAuthClient(int64(userId), jsonRequest.Name, jsonRequest.RedirectURIs, scopes, jsonRequest.Confidential)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	resp := newOAuthClientResponse(client)
+	resp.ClientSecret = secret
+	ctx.IndentedJSON(http.StatusOK, resp)
+}
+
+// ListOAuthClients lists third-party applications of user
+//
+//	@Summary		list OAuth2 clients
+//	@Description	list third-party applications registered by user
+//	@Tags			oauth
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	types.OAuthClientsResponse
+//	@Failure		401	{object}	types.MessageResponse
+//	@Failure		500	{object}	types.MessageResponse
+//	@Router			/oauth/clients [get]
+func (svc *WebService) ListOAuthClients(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	clients, err := svc.listOAuthClients(int64(userId))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	resp := types.OAuthClientsResponse{Clients: []types.OAuthClientResponse{}}
+	for _, client := range clients {
+		resp.Clients = append(resp.Clients, newOAuthClientResponse(client))
+	}
+	ctx.IndentedJSON(http.StatusOK, resp)
+}
+
+// DeleteOAuthClient deletes a third-party application of user
+//
+//	@Summary		delete OAuth2 client
+//	@Description	delete a third-party application, its tokens stop working
+//	@Tags			oauth
+//	@Accept			json
+//	@Produce		json
+//	@Param			client_id	path		string	true	"Client ID"
+//	@Success		200			{object}	types.MessageResponse
+//	@Failure		400			{object}	types.MessageResponse
+//	@Failure		401			{object}	types.MessageResponse
+//	@Router			/oauth/clients/{client_id} [delete]
+func (svc *WebService) DeleteOAuthClient(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Only clients of the user can be deleted
+	client, err := svc.getOAuthClient(ctx.Param("client_id"))
+	if err != nil || client.OwnerID != int64(userId) {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "client not found"})
+		return
+	}
+
+	svc.deleteOAuthClient(client)
+	ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+}
+
+// GetOAuthAuthorization describes an authorization request so that user can approve it
+//
+//	@Summary		get authorization request
+//	@Description	validate an authorization code request and describe it for the consent page, which must send back the consent token
+//	@Tags			oauth
+//	@Accept			json
+//	@Produce		json
+//	@Param			response_type			query		string	true	"Must be code"
+//	@Param			client_id				query		string	true	"Client ID"
+//	@Param			redirect_uri			query		string	true	"Registered redirect URI"
+//	@Param			scope					query		string	false	"Space separated scopes, defaults to all scopes of client"
+//	@Param			state					query		string	false	"Opaque value returned to client"
+//	@Param			code_challenge			query		string	true	"PKCE code challenge"
+//	@Param			code_challenge_method	query		string	true	"Must be S256"
+//	@Success		200						{object}	types.OAuthAuthorizationInfoResponse
+//	@Failure		400						{object}	types.MessageResponse
+//	@Failure		401						{object}	types.MessageResponse
+//	@Failure		500						{object}	types.MessageResponse
+//	@Router			/oauth/authorize [get]
+func (svc *WebService) GetOAuthAuthorization(ctx *gin.Context) {
+	// Check session
+	sessionId, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate request
+	var request types.OAuthAuthorizeRequest
+	err = ctx.ShouldBindQuery(&request)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(request)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	client, scopes, oauthErr, err := svc.checkAuthorizeRequest(&request)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if oauthErr != "" {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: oauthErr})
+		return
+	}
+
+	consentToken, err := svc.issueConsentToken(sessionId, int64(userId), &authorizationCode{
+		ClientID:      client.ID,
+		RedirectURI:   request.RedirectURI,
+		Scopes:        scopes,
+		CodeChallenge: request.CodeChallenge,
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, types.OAuthAuthorizationInfoResponse{
+		ClientID:     client.ID,
+		ClientName:   client.Name,
+		RedirectURI:  request.RedirectURI,
+		Scope:        auth.FormatScope(scopes),
+		ConsentToken: consentToken,
+	})
+}
+
+// AuthorizeOAuthClient approves or denies an authorization request
+//
+//	@Summary		authorize client
+//	@Description	approve or deny an authorization code request with the consent token of the consent page, the client must be sent to the returned redirect uri
+//	@Tags			oauth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		types.OAuthAuthorizeRequest	true	"Authorization request"
+//	@Success		200		{object}	types.OAuthAuthorizeResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		403		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/oauth/authorize [post]
+func (svc *WebService) AuthorizeOAuthClient(ctx *gin.Context) {
+	// Check session
+	sessionId, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	// Browsers send the session cookie from any site, the approval must come from the frontend
+	if sessionId != "" && !svc.AllowedOrigin(ctx.GetHeader("Origin")) {
+		ctx.IndentedJSON(http.StatusForbidden, types.MessageResponse{Message: "origin not allowed"})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.OAuthAuthorizeRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	client, scopes, oauthErr, err := svc.checkAuthorizeRequest(&jsonRequest)
+	if err != nil {
+		// The redirect uri is not trusted, so the error is not sent to it
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if oauthErr != "" {
+		ctx.IndentedJSON(http.StatusOK, types.OAuthAuthorizeResponse{
+			RedirectURI: buildRedirectURI(jsonRequest.RedirectURI, jsonRequest.State, "error", oauthErr),
+		})
+		return
+	}
+	code := &authorizationCode{
+		ClientID:      client.ID,
+		UserID:        int64(userId),
+		RedirectURI:   jsonRequest.RedirectURI,
+		Scopes:        scopes,
+		CodeChallenge: jsonRequest.CodeChallenge,
+	}
+	err = svc.redeemConsentToken(jsonRequest.ConsentToken, sessionId, int64(userId), code)
+	if err == errInvalidConsentToken {
+		ctx.IndentedJSON(http.StatusForbidden, types.MessageResponse{Message: err.Error()})
+		return
+	} else if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if !jsonRequest.Approve {
+		ctx.IndentedJSON(http.StatusOK, types.OAuthAuthorizeResponse{
+			RedirectURI: buildRedirectURI(jsonRequest.RedirectURI, jsonRequest.State, "error", "access_denied"),
+		})
+		return
+	}
+
+	codeValue, err := svc.issueAuthorizationCode(code)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, types.OAuthAuthorizeResponse{
+		RedirectURI: buildRedirectURI(jsonRequest.RedirectURI, jsonRequest.State, "code", codeValue),
+	})
+}
+
+// IssueOAuthToken is the OAuth2 token endpoint
+//
+//	@Summary		OAuth2 token endpoint
+//	@Description	issue tokens with the authorization_code, password or refresh_token grant, clients authenticate with HTTP basic or client_id and client_secret
+//	@Tags			oauth
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Param			grant_type		formData	string	true	"authorization_code, password or refresh_token"
+//	@Param			client_id		formData	string	false	"Client ID"
+//	@Param			client_secret	formData	string	false	"Client secret"
+//	@Param			code			formData	string	false	"Authorization code"
+//	@Param			redirect_uri	formData	string	false	"Redirect URI of authorization request"
+//	@Param			code_verifier	formData	string	false	"PKCE code verifier"
+//	@Param			username		formData	string	false	"User name"
+//	@Param			password		formData	string	false	"Password"
+//	@Param			otp_code		formData	string	false	"Two-factor authentication code"
+//	@Param			refresh_token	formData	string	false	"Refresh token"
+//	@Param			scope			formData	string	false	"Space separated scopes"
+//	@Success		200				{object}	types.OAuthTokenResponse
+//	@Failure		400				{object}	types.OAuthErrorResponse
+//	@Failure		401				{object}	types.OAuthErrorResponse
+//	@Failure		429				{object}	types.OAuthErrorResponse
+//	@Failure		500				{object}	types.OAuthErrorResponse
+//	@Router			/oauth/token [post]
+func (svc *WebService) IssueOAuthToken(ctx *gin.Context) {
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Header("Pragma", "no-cache")
+
+	client, err := svc.authenticateOAuthClient(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.OAuthErrorResponse{Error: "invalid_client"})
+		return
+	}
+	requestedScopes, err := auth.ParseScope(ctx.PostForm("scope"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.OAuthErrorResponse{Error: "invalid_scope", ErrorDescription: err.Error()})
+		return
+	}
+
+	grantType := ctx.PostForm("grant_type")
+	if grantType == "authorization_code" {
+		code, err := svc.redeemAuthorizationCode(ctx.PostForm("code"), client.ID, ctx.PostForm("redirect_uri"), ctx.PostForm("code_verifier"))
+		if err != nil {
+			ctx.IndentedJSON(http.StatusBadRequest, types.OAuthErrorResponse{Error: "invalid_grant", ErrorDescription: err.Error()})
+			return
+		}
+		grant := &tokenGrant{UserID: code.UserID, ClientID: client.ID, Scopes: code.Scopes}
+		refreshToken, err := svc.issueRefreshToken(grant, "")
+		if err != nil {
+			ctx.IndentedJSON(http.StatusInternalServerError, types.OAuthErrorResponse{Error: "server_error"})
+			return
+		}
+		svc.respondWithOAuthTokens(ctx, grant, refreshToken)
+		return
+	} else if grantType == "password" {
+		// Users give their password to the client, so only clients able to keep a secret are trusted with it
+		if !client.confidential() {
+			ctx.IndentedJSON(http.StatusBadRequest, types.OAuthErrorResponse{Error: "unauthorized_client"})
+			return
+		}
+		if len(requestedScopes) == 0 {
+			requestedScopes = client.Scopes
+		}
+		if !auth.ScopesAllowed(requestedScopes, client.Scopes) || !auth.ScopesAllowed(requestedScopes, auth.ThirdPartyScopes) {
+			ctx.IndentedJSON(http.StatusBadRequest, types.OAuthErrorResponse{Error: "invalid_scope"})
+			return
+		}
+
+		resp, err := svc.authenticateAndPostClient.CheckUserAuthentication(ctx, &pb_aap.CheckUserAuthenticationRequest{
+			UserName:     ctx.PostForm("username"),
+			UserPassword: ctx.PostForm("password"),
+			ClientIp:     ctx.ClientIP(),
+			OtpCode:      ctx.PostForm("otp_code"),
+		})
+		if err != nil {
+			ctx.IndentedJSON(http.StatusInternalServerError, types.OAuthErrorResponse{Error: "server_error"})
+			return
+		}
+		if resp.GetStatus() == pb_aap.CheckUserAuthenticationResponse_ACCOUNT_LOCKED {
+			svc.countReporter.WithLabelValues("check_user_login", "blocked").Inc()
+			setRetryAfter(ctx, resp.GetLockedUntil().AsTime())
+			ctx.IndentedJSON(http.StatusTooManyRequests, types.OAuthErrorResponse{Error: "invalid_grant", ErrorDescription: "too many failed login attempts, try again later"})
+			return
+		} else if resp.GetStatus() == pb_aap.CheckUserAuthenticationResponse_TWO_FACTOR_REQUIRED {
+			ctx.IndentedJSON(http.StatusBadRequest, types.OAuthErrorResponse{Error: "invalid_grant", ErrorDescription: "two-factor authentication code required"})
+			return
+		} else if resp.GetStatus() != pb_aap.CheckUserAuthenticationResponse_OK {
+			ctx.IndentedJSON(http.StatusBadRequest, types.OAuthErrorResponse{Error: "invalid_grant", ErrorDescription: "wrong username or password"})
+			return
+		}
+
+		grant := &tokenGrant{UserID: resp.GetUser().GetUserId(), ClientID: client.ID, Scopes: requestedScopes}
+		refreshToken, err := svc.issueRefreshToken(grant, "")
+		if err != nil {
+			ctx.IndentedJSON(http.StatusInternalServerError, types.OAuthErrorResponse{Error: "server_error"})
+			return
+		}
+		svc.respondWithOAuthTokens(ctx, grant, refreshToken)
+		return
+	} else if grantType == "refresh_token" {
+		grant, refreshToken, err := svc.rotateRefreshToken(ctx.PostForm("refresh_token"), client.ID)
+		if err == errInvalidRefreshToken {
+			ctx.IndentedJSON(http.StatusBadRequest, types.OAuthErrorResponse{Error: "invalid_grant", ErrorDescription: err.Error()})
+			return
+		} else if err != nil {
+			ctx.IndentedJSON(http.StatusInternalServerError, types.OAuthErrorResponse{Error: "server_error"})
+			return
+		}
+
+		// The access token can be narrowed, the refresh token keeps the scopes originally granted
+		accessGrant := *grant
+		if len(requestedScopes) > 0 {
+			if !auth.ScopesAllowed(requestedScopes, grant.Scopes) {
+				ctx.IndentedJSON(http.StatusBadRequest, types.OAuthErrorResponse{Error: "invalid_scope"})
+				return
+			}
+			accessGrant.Scopes = requestedScopes
+		}
+		svc.respondWithOAuthTokens(ctx, &accessGrant, refreshToken)
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusBadRequest, types.OAuthErrorResponse{Error: "unsupported_grant_type"})
+		return
+	}
+}
+
+// RevokeOAuthToken revokes a refresh token of a client
+//
+//	@Summary		OAuth2 revocation endpoint
+//	@Description	revoke a refresh token of the client and all refresh tokens rotated from it
+//	@Tags			oauth
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Param			token			formData	string	true	"Refresh token"
+//	@Param			client_id		formData	string	false	"Client ID"
+//	@Param			client_secret	formData	string	false	"Client secret"
+//	@Success		200				{object}	types.MessageResponse
+//	@Failure		401				{object}	types.OAuthErrorResponse
+//	@Router			/oauth/revoke [post]
+func (svc *WebService) RevokeOAuthToken(ctx *gin.Context) {
+	client, err := svc.authenticateOAuthClient(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.OAuthErrorResponse{Error: "invalid_client"})
+		return
+	}
+
+	// Unknown tokens are not reported, as required by RFC 7009
+	_ = svc.revokeRefreshToken(ctx.PostForm("token"), client.ID)
+	ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+}
+
+// checkAuthorizeRequest validates an authorization request and returns its client and granted scopes.
+// Errors about the client or the redirect uri are returned as err since the user must not be sent to an
+// unverified uri, the others as an OAuth2 error code which can be sent to the redirect uri.
+func (svc *WebService) checkAuthorizeRequest(request *types.OAuthAuthorizeRequest) (client *oauthClient, scopes []string, oauthErr string, err error) {
+	client, err = svc.getOAuthClient(request.ClientID)
+	if err != nil {
+		return nil, nil, "", errOAuthClientNotFound
+	}
+	registered := false
+	for _, redirectURI := range client.RedirectURIs {
+		if redirectURI == request.RedirectURI {
+			registered = true
+			break
+		}
+	}
+	if !registered {
+		return nil, nil, "", errRedirectURINotRegistered
+	}
+
+	if request.ResponseType != "code" {
+		return client, nil, "unsupported_response_type", nil
+	}
+	// PKCE is required for every client
+	if request.CodeChallenge == "" || request.CodeChallengeMethod != "S256" {
+		return client, nil, "invalid_request", nil
+	}
+	scopes, err = auth.ParseScope(request.Scope)
+	if err != nil {
+		return client, nil, "invalid_scope", nil
+	}
+	if len(scopes) == 0 {
+		scopes = client.Scopes
+	}
+	// Clients registered with admin before it was reserved to first-party tokens can not get it either
+	if !auth.ScopesAllowed(scopes, client.Scopes) || !auth.ScopesAllowed(scopes, auth.ThirdPartyScopes) {
+		return client, nil, "invalid_scope", nil
+	}
+	return client, scopes, "", nil
+}
+
+func (svc *WebService) respondWithOAuthTokens(ctx *gin.Context, grant *tokenGrant, refreshToken string) {
+	accessToken, expiresAt, err := svc.issueAccessToken(grant)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.OAuthErrorResponse{Error: "server_error"})
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, types.OAuthTokenResponse{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(time.Until(expiresAt).Seconds()),
+		RefreshToken: refreshToken,
+		Scope:        auth.FormatScope(grant.Scopes),
+	})
+}
+
+func newOAuthClientResponse(client *oauthClient) types.OAuthClientResponse {
+	return types.OAuthClientResponse{
+		ClientID:     client.ID,
+		Name:         client.Name,
+		RedirectURIs: client.RedirectURIs,
+		Scope:        auth.FormatScope(client.Scopes),
+		Confidential: client.confidential(),
+		CreatedAt:    client.CreatedAt.In(time.Local).Format(time.DateTime),
+	}
+}
+
+// buildRedirectURI adds a parameter and state to the query of a redirect uri
+func buildRedirectURI(redirectURI string, state string, key string, value string) string {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		return redirectURI
+	}
+	query := u.Query()
+	query.Set(key, value)
+	if state != "" {
+		query.Set("state", state)
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/maxuanquang/social-network/internal/auth"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+)
+
+const (
+	testCodeVerifier  = "dBjftJeZ4CVP-mB92K1uhbRTpvyTclVmEX9l_i-R3ZT"
+	testCodeChallenge = "4L5TF6EvI1237k6Tq665LRlAyk48d4YLUaNHNp3zc1k"
+)
+
+func TestVerifyCodeChallenge(t *testing.T) {
+	tests := []struct {
+		name          string
+		codeChallenge string
+		codeVerifier  string
+		want          bool
+	}{
+		{"valid", testCodeChallenge, testCodeVerifier, true},
+		{"wrong verifier", testCodeChallenge, testCodeVerifier[:42] + "x", false},
+		{"plain method", testCodeVerifier, testCodeVerifier, false},
+		{"no challenge", "", testCodeVerifier, false},
+		{"no verifier", testCodeChallenge, "", false},
+		{"verifier too short", testCodeChallenge, testCodeVerifier[:42], false},
+		{"verifier too long", testCodeChallenge, strings.Repeat("a", 129), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verifyCodeChallenge(tt.codeChallenge, tt.codeVerifier); got != tt.want {
+				t.Errorf("verifyCodeChallenge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedeemAuthorizationCode(t *testing.T) {
+	svc := newTestWebService(t)
+	code := &authorizationCode{
+		ClientID:      "client",
+		UserID:        42,
+		RedirectURI:   "https://client.example.com/callback",
+		Scopes:        []string{auth.ScopeRead},
+		CodeChallenge: testCodeChallenge,
+	}
+
+	tests := []struct {
+		name         string
+		clientId     string
+		redirectURI  string
+		codeVerifier string
+	}{
+		{"other client", "other", code.RedirectURI, testCodeVerifier},
+		{"other redirect uri", code.ClientID, "https://client.example.com/other", testCodeVerifier},
+		{"wrong verifier", code.ClientID, code.RedirectURI, testCodeVerifier[:42] + "x"},
+		{"no verifier", code.ClientID, code.RedirectURI, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := svc.issueAuthorizationCode(code)
+			if err != nil {
+				t.Fatalf("issueAuthorizationCode() error = %v", err)
+			}
+			_, err = svc.redeemAuthorizationCode(value, tt.clientId, tt.redirectURI, tt.codeVerifier)
+			if !errors.Is(err, errInvalidAuthorizationCode) {
+				t.Fatalf("redeemAuthorizationCode() error = %v, want %v", err, errInvalidAuthorizationCode)
+			}
+			// Failed attempts consume the code
+			_, err = svc.redeemAuthorizationCode(value, code.ClientID, code.RedirectURI, testCodeVerifier)
+			if !errors.Is(err, errInvalidAuthorizationCode) {
+				t.Errorf("redeemAuthorizationCode(after failure) error = %v, want %v", err, errInvalidAuthorizationCode)
+			}
+		})
+	}
+
+	value, err := svc.issueAuthorizationCode(code)
+	if err != nil {
+		t.Fatalf("issueAuthorizationCode() error = %v", err)
+	}
+	got, err := svc.redeemAuthorizationCode(value, code.ClientID, code.RedirectURI, testCodeVerifier)
+	if err != nil {
+		t.Fatalf("redeemAuthorizationCode() error = %v", err)
+	}
+	if got.UserID != code.UserID || got.ClientID != code.ClientID || auth.FormatScope(got.Scopes) != auth.ScopeRead {
+		t.Errorf("redeemAuthorizationCode() = %+v", got)
+	}
+	_, err = svc.redeemAuthorizationCode(value, code.ClientID, code.RedirectURI, testCodeVerifier)
+	if !errors.Is(err, errInvalidAuthorizationCode) {
+		t.Errorf("redeemAuthorizationCode(reused) error = %v, want %v", err, errInvalidAuthorizationCode)
+	}
+}
+
+func TestRedeemConsentToken(t *testing.T) {
+	svc := newTestWebService(t)
+	code := &authorizationCode{
+		ClientID:      "client",
+		RedirectURI:   "https://client.example.com/callback",
+		Scopes:        []string{auth.ScopeRead},
+		CodeChallenge: testCodeChallenge,
+	}
+	withCode := func(change func(c *authorizationCode)) *authorizationCode {
+		c := *code
+		change(&c)
+		return &c
+	}
+
+	tests := []struct {
+		name      string
+		sessionId string
+		userId    int64
+		code      *authorizationCode
+	}{
+		{"other session", "other", 42, code},
+		{"bearer token", "", 42, code},
+		{"other user", "session", 7, code},
+		{"other client", "session", 42, withCode(func(c *authorizationCode) { c.ClientID = "other" })},
+		{"other redirect uri", "session", 42, withCode(func(c *authorizationCode) { c.RedirectURI = "https://evil.example.com" })},
+		{"more scopes", "session", 42, withCode(func(c *authorizationCode) { c.Scopes = auth.ThirdPartyScopes })},
+		{"other code challenge", "session", 42, withCode(func(c *authorizationCode) { c.CodeChallenge = "other" })},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := svc.issueConsentToken("session", 42, code)
+			if err != nil {
+				t.Fatalf("issueConsentToken() error = %v", err)
+			}
+			if err := svc.redeemConsentToken(value, tt.sessionId, tt.userId, tt.code); !errors.Is(err, errInvalidConsentToken) {
+				t.Errorf("redeemConsentToken() error = %v, want %v", err, errInvalidConsentToken)
+			}
+		})
+	}
+
+	if err := svc.redeemConsentToken("", "session", 42, code); !errors.Is(err, errInvalidConsentToken) {
+		t.Errorf("redeemConsentToken(empty) error = %v, want %v", err, errInvalidConsentToken)
+	}
+	value, err := svc.issueConsentToken("session", 42, code)
+	if err != nil {
+		t.Fatalf("issueConsentToken() error = %v", err)
+	}
+	if err := svc.redeemConsentToken(value, "session", 42, code); err != nil {
+		t.Fatalf("redeemConsentToken() error = %v", err)
+	}
+	if err := svc.redeemConsentToken(value, "session", 42, code); !errors.Is(err, errInvalidConsentToken) {
+		t.Errorf("redeemConsentToken(reused) error = %v, want %v", err, errInvalidConsentToken)
+	}
+}
+
+func TestCheckAuthorizeRequestScopes(t *testing.T) {
+	svc := newTestWebService(t)
+	redirectURI := "https://client.example.com/callback"
+	client, _, err := svc.createOAuthClient(42, "client", []string{redirectURI}, auth.FirstPartyScopes, false)
+	if err != nil {
+		t.Fatalf("createOAuthClient() error = %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		scope        string
+		wantOAuthErr string
+	}{
+		{"read", "read", ""},
+		{"read write", "read write", ""},
+		{"admin", "admin", "invalid_scope"},
+		{"default scopes include admin", "", "invalid_scope"},
+		{"unknown", "superuser", "invalid_scope"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, oauthErr, err := svc.checkAuthorizeRequest(&types.OAuthAuthorizeRequest{
+				ResponseType:        "code",
+				ClientID:            client.ID,
+				RedirectURI:         redirectURI,
+				Scope:               tt.scope,
+				CodeChallenge:       testCodeChallenge,
+				CodeChallengeMethod: "S256",
+			})
+			if err != nil {
+				t.Fatalf("checkAuthorizeRequest() error = %v", err)
+			}
+			if oauthErr != tt.wantOAuthErr {
+				t.Errorf("checkAuthorizeRequest() oauthErr = %q, want %q", oauthErr, tt.wantOAuthErr)
+			}
+		})
+	}
+}
+
+func TestAllowedOrigin(t *testing.T) {
+	svc := newTestWebService(t)
+	svc.cfg.AllowedOrigins = []string{"https://localhost"}
+
+	tests := []struct {
+		origin string
+		want   bool
+	}{
+		{"https://localhost", true},
+		{"http://localhost", false},
+		{"https://localhost.evil.example.com", false},
+		{"null", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.origin, func(t *testing.T) {
+			if got := svc.AllowedOrigin(tt.origin); got != tt.want {
+				t.Errorf("AllowedOrigin(%q) = %v, want %v", tt.origin, got, tt.want)
+			}
+		})
+	}
+}
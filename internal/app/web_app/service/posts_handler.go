@@ -8,6 +8,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/maxuanquang/social-network/internal/pkg/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
 )
@@ -22,6 +23,7 @@ import (
 //	@Param			request	body		types.CreatePostRequest	true	"Create post parameters"
 //	@Success		200		{object}	types.MessageResponse
 //	@Failure		400		{object}	types.MessageResponse
+//	@Failure		403		{object}	types.MessageResponse
 //	@Failure		500		{object}	types.MessageResponse
 //	@Router			/posts/ [post]
 func (svc *WebService) CreatePost(ctx *gin.Context) {
@@ -50,11 +52,23 @@ func (svc *WebService) CreatePost(ctx *gin.Context) {
 	if jsonRequest.Visible != nil && !*jsonRequest.Visible {
 		visible = false
 	}
+	var poll *pb_aap.NewPoll
+	if jsonRequest.Poll != nil {
+		poll = &pb_aap.NewPoll{
+			Options:        jsonRequest.Poll.Options,
+			MultipleChoice: jsonRequest.Poll.MultipleChoice,
+		}
+		if jsonRequest.Poll.ClosesAt != nil {
+			poll.ClosesAt = timestamppb.New(*jsonRequest.Poll.ClosesAt)
+		}
+	}
 	resp, err := svc.authenticateAndPostClient.CreatePost(ctx, &pb_aap.CreatePostRequest{
 		UserId:           int64(userId),
 		ContentText:      jsonRequest.ContentText,
 		ContentImagePath: jsonRequest.ContentImagePath,
 		Visible:          visible,
+		Poll:             poll,
+		AudienceListId:   jsonRequest.AudienceListID,
 	})
 	if err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
@@ -63,6 +77,15 @@ func (svc *WebService) CreatePost(ctx *gin.Context) {
 	if resp.GetStatus() == pb_aap.CreatePostResponse_USER_NOT_FOUND {
 		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
 		return
+	} else if resp.GetStatus() == pb_aap.CreatePostResponse_INVALID_POLL {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid poll"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreatePostResponse_EMAIL_NOT_VERIFIED {
+		ctx.IndentedJSON(http.StatusForbidden, types.MessageResponse{Message: "email not verified"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreatePostResponse_AUDIENCE_LIST_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "audience list not found"})
+		return
 	} else if resp.GetStatus() == pb_aap.CreatePostResponse_OK {
 		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
 		return
@@ -93,9 +116,16 @@ func (svc *WebService) GetPostDetailInfo(ctx *gin.Context) {
 		return
 	}
 
+	// Logged in viewers do not see posts of users they blocked or who blocked them
+	_, viewerId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		viewerId = 0
+	}
+
 	// Call gprc service
 	resp, err := svc.authenticateAndPostClient.GetPostDetailInfo(ctx, &pb_aap.GetPostDetailInfoRequest{
-		PostId: int64(postId),
+		PostId:   int64(postId),
+		ViewerId: int64(viewerId),
 	})
 	if err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
@@ -264,6 +294,7 @@ func (svc *WebService) DeletePost(ctx *gin.Context) {
 //	@Param			request	body		types.CreatePostCommentRequest	true	"Comment's content"
 //	@Success		200		{object}	types.PostDetailInfoResponse
 //	@Failure		400		{object}	types.MessageResponse
+//	@Failure		403		{object}	types.MessageResponse
 //	@Failure		500		{object}	types.MessageResponse
 //	@Router			/posts/{post_id} [post]
 func (svc *WebService) CommentPost(ctx *gin.Context) {
@@ -312,6 +343,9 @@ func (svc *WebService) CommentPost(ctx *gin.Context) {
 		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
 		return
 
+	} else if resp.GetStatus() == pb_aap.CommentPostResponse_NOT_ALLOWED {
+		ctx.IndentedJSON(http.StatusForbidden, types.MessageResponse{Message: "not allowed to comment on this post"})
+		return
 	} else if resp.GetStatus() == pb_aap.CommentPostResponse_OK {
 		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
 		return
@@ -331,6 +365,7 @@ func (svc *WebService) CommentPost(ctx *gin.Context) {
 //	@Param			post_id	path		int	true	"Post ID"
 //	@Success		200		{object}	types.MessageResponse
 //	@Failure		400		{object}	types.MessageResponse
+//	@Failure		403		{object}	types.MessageResponse
 //	@Failure		500		{object}	types.MessageResponse
 //	@Router			/posts/{post_id}/likes [post]
 func (svc *WebService) LikePost(ctx *gin.Context) {
@@ -368,6 +403,9 @@ func (svc *WebService) LikePost(ctx *gin.Context) {
 	} else if resp.GetStatus() == pb_aap.LikePostResponse_ALREADY_LIKED {
 		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "already liked"})
 		return
+	} else if resp.GetStatus() == pb_aap.LikePostResponse_NOT_ALLOWED {
+		ctx.IndentedJSON(http.StatusForbidden, types.MessageResponse{Message: "not allowed to like this post"})
+		return
 	} else if resp.GetStatus() == pb_aap.LikePostResponse_OK {
 		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
 		return
@@ -377,6 +415,239 @@ func (svc *WebService) LikePost(ctx *gin.Context) {
 	}
 }
 
+// PinPost pins a post to its owner's profile
+//
+//	@Summary		pin post
+//	@Description	pin post to user's profile
+//	@Tags			posts
+//	@Accept			json
+//	@Produce		json
+//	@Param			post_id	path		int	true	"Post ID"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/posts/{post_id}/pin [post]
+func (svc *WebService) PinPost(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	postId, err := strconv.Atoi(ctx.Param("post_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "post not found"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.PinPost(ctx, &pb_aap.PinPostRequest{
+		UserId: int64(userId),
+		PostId: int64(postId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.PinPostResponse_POST_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "post not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.PinPostResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.PinPostResponse_NOT_ALLOWED {
+		ctx.IndentedJSON(http.StatusForbidden, types.MessageResponse{Message: "not allowed"})
+		return
+	} else if resp.GetStatus() == pb_aap.PinPostResponse_ALREADY_PINNED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "already pinned"})
+		return
+	} else if resp.GetStatus() == pb_aap.PinPostResponse_PIN_LIMIT_REACHED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "pin limit reached"})
+		return
+	} else if resp.GetStatus() == pb_aap.PinPostResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// UnpinPost unpins a post from its owner's profile
+//
+//	@Summary		unpin post
+//	@Description	unpin post from user's profile
+//	@Tags			posts
+//	@Accept			json
+//	@Produce		json
+//	@Param			post_id	path		int	true	"Post ID"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/posts/{post_id}/pin [delete]
+func (svc *WebService) UnpinPost(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	postId, err := strconv.Atoi(ctx.Param("post_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "post not found"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.UnpinPost(ctx, &pb_aap.UnpinPostRequest{
+		UserId: int64(userId),
+		PostId: int64(postId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.UnpinPostResponse_POST_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "post not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.UnpinPostResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.UnpinPostResponse_NOT_PINNED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "not pinned"})
+		return
+	} else if resp.GetStatus() == pb_aap.UnpinPostResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// VotePoll votes in the poll of a post
+//
+//	@Summary		vote poll
+//	@Description	vote in the poll of a post
+//	@Tags			posts
+//	@Accept			json
+//	@Produce		json
+//	@Param			post_id	path		int						true	"Post ID"
+//	@Param			request	body		types.VotePollRequest	true	"Chosen options"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/posts/{post_id}/poll/votes [post]
+func (svc *WebService) VotePoll(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	postId, err := strconv.Atoi(ctx.Param("post_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "poll not found"})
+		return
+	}
+
+	// Check request
+	var jsonRequest types.VotePollRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.VotePoll(ctx, &pb_aap.VotePollRequest{
+		UserId:     int64(userId),
+		PostId:     int64(postId),
+		OptionsIds: jsonRequest.OptionsIds,
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.VotePollResponse_POLL_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "poll not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.VotePollResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.VotePollResponse_POLL_CLOSED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "poll closed"})
+		return
+	} else if resp.GetStatus() == pb_aap.VotePollResponse_INVALID_OPTIONS {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid options"})
+		return
+	} else if resp.GetStatus() == pb_aap.VotePollResponse_ALREADY_VOTED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "already voted"})
+		return
+	} else if resp.GetStatus() == pb_aap.VotePollResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetPollResults gets results of the poll of a post
+//
+//	@Summary		get poll results
+//	@Description	get results of the poll of a post
+//	@Tags			posts
+//	@Accept			json
+//	@Produce		json
+//	@Param			post_id	path		int	true	"Post ID"
+//	@Success		200		{object}	types.PollResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/posts/{post_id}/poll [get]
+func (svc *WebService) GetPollResults(ctx *gin.Context) {
+	// Check URL params
+	postId, err := strconv.Atoi(ctx.Param("post_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "poll not found"})
+		return
+	}
+
+	// Logged in users also see which options they voted for
+	_, userId, _ := svc.checkSessionAuthentication(ctx)
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.GetPollResults(ctx, &pb_aap.GetPollResultsRequest{
+		PostId: int64(postId),
+		UserId: int64(userId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetPollResultsResponse_POLL_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "poll not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetPollResultsResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, svc.newPollResponse(resp.GetPoll(), resp.GetVotedOptionsIds()))
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
 // GetS3PresignedUrl gets a presigned url for uploading pictures
 //
 //	@Summary		get presigned url
@@ -391,14 +662,14 @@ func (svc *WebService) LikePost(ctx *gin.Context) {
 //	@Router			/posts/url [get]
 func (svc *WebService) GetS3PresignedUrl(ctx *gin.Context) {
 	// Check session
-	_, _, err := svc.checkSessionAuthentication(ctx)
+	_, userId, err := svc.checkSessionAuthentication(ctx)
 	if err != nil {
 		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
 		return
 	}
 
 	// Call grpc service
-	resp, err := svc.authenticateAndPostClient.GetS3PresignedUrl(ctx, &pb_aap.GetS3PresignedUrlRequest{})
+	resp, err := svc.authenticateAndPostClient.GetS3PresignedUrl(ctx, &pb_aap.GetS3PresignedUrlRequest{UserId: int64(userId)})
 	if err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
 		return
@@ -406,6 +677,9 @@ func (svc *WebService) GetS3PresignedUrl(ctx *gin.Context) {
 	if resp.GetStatus() == pb_aap.GetS3PresignedUrlResponse_FAIL {
 		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "fail getting url"})
 		return
+	} else if resp.GetStatus() == pb_aap.GetS3PresignedUrlResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
 	} else if resp.GetStatus() == pb_aap.GetS3PresignedUrlResponse_OK {
 		ctx.IndentedJSON(http.StatusOK, types.GetS3PresignedUrlResponse{Url: resp.Url, ExpirationTime: resp.ExpirationTime.AsTime()})
 		return
@@ -439,5 +713,49 @@ func (svc *WebService) newPostDetailInfoResponse(post *pb_aap.PostDetailInfo) ty
 		CreatedAt:        post.GetCreatedAt().AsTime().In(time.Local).Format(time.DateTime),
 		Comments:         comments,
 		UsersLiked:       users_liked,
+		Poll:             svc.newPollResponse(post.GetPoll(), nil),
+		LinkPreview:      newLinkPreviewResponse(post.GetLinkPreview()),
+	}
+}
+
+func (svc *WebService) newPollResponse(poll *pb_aap.Poll, votedOptionsIds []int64) *types.PollResponse {
+	if poll == nil {
+		return nil
+	}
+
+	var options []types.PollOptionResponse
+	for _, option := range poll.GetOptions() {
+		options = append(options, types.PollOptionResponse{
+			OptionID:    option.GetOptionId(),
+			ContentText: option.GetContentText(),
+			Votes:       option.GetVotes(),
+		})
+	}
+
+	var closesAt string
+	if poll.GetClosesAt() != nil {
+		closesAt = poll.GetClosesAt().AsTime().In(time.Local).Format(time.DateTime)
+	}
+
+	return &types.PollResponse{
+		PostID:          poll.GetPostId(),
+		MultipleChoice:  poll.GetMultipleChoice(),
+		ClosesAt:        closesAt,
+		Closed:          poll.GetClosed(),
+		Options:         options,
+		TotalVoters:     poll.GetTotalVoters(),
+		VotedOptionsIds: votedOptionsIds,
+	}
+}
+
+func newLinkPreviewResponse(preview *pb_aap.LinkPreview) *types.LinkPreviewResponse {
+	if preview == nil {
+		return nil
+	}
+	return &types.LinkPreviewResponse{
+		URL:         preview.GetUrl(),
+		Title:       preview.GetTitle(),
+		Description: preview.GetDescription(),
+		ImageURL:    preview.GetImageUrl(),
 	}
 }
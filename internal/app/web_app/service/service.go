@@ -2,18 +2,23 @@ package service
 
 import (
 	"errors"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/maxuanquang/social-network/configs"
+	"github.com/maxuanquang/social-network/internal/auth"
 	"github.com/maxuanquang/social-network/internal/pkg/types"
 	"github.com/maxuanquang/social-network/internal/utils"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	client_aap "github.com/maxuanquang/social-network/pkg/client/authen_and_post"
+	client_msg "github.com/maxuanquang/social-network/pkg/client/messenger"
 	client_nf "github.com/maxuanquang/social-network/pkg/client/newsfeed"
 	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+	pb_msg "github.com/maxuanquang/social-network/pkg/types/proto/pb/messenger"
 	pb_nf "github.com/maxuanquang/social-network/pkg/types/proto/pb/newsfeed"
 )
 
@@ -22,7 +27,10 @@ var validate = types.NewValidator()
 type WebService struct {
 	authenticateAndPostClient pb_aap.AuthenticateAndPostClient
 	newsfeedClient            pb_nf.NewsfeedClient
+	messengerClient           pb_msg.MessengerClient
 	redisClient               *redis.Client
+	cfg                       *configs.WebConfig
+	tokenManager              *auth.TokenManager
 
 	logger          *zap.Logger
 	latencyReporter *prometheus.SummaryVec
@@ -40,11 +48,21 @@ func NewWebService(cfg *configs.WebConfig) (*WebService, error) {
 		return nil, err
 	}
 
+	msgClient, err := client_msg.NewClient(cfg.Messenger.Hosts)
+	if err != nil {
+		return nil, err
+	}
+
 	redisClient := redis.NewClient(&redis.Options{Addr: cfg.Redis.Addr, Password: cfg.Redis.Password})
 	if redisClient == nil {
 		return nil, errors.New("redis connection failed")
 	}
 
+	tokenManager, err := auth.NewTokenManager(&cfg.Token)
+	if err != nil {
+		return nil, err
+	}
+
 	logger, err := utils.NewLogger(&cfg.Logger)
 	if err != nil {
 		return nil, err
@@ -74,9 +92,28 @@ func NewWebService(cfg *configs.WebConfig) (*WebService, error) {
 	return &WebService{
 		authenticateAndPostClient: aapClient,
 		newsfeedClient:            nfClient,
+		messengerClient:           msgClient,
 		redisClient:               redisClient,
+		cfg:                       cfg,
+		tokenManager:              tokenManager,
 		logger:                    logger,
 		latencyReporter:           latencyExporter,
 		countReporter:             countExporter,
 	}, nil
 }
+
+// formatTimestamp formats an optional timestamp of a response, it is empty when the timestamp is not set
+func formatTimestamp(timestamp *timestamppb.Timestamp) string {
+	if timestamp == nil {
+		return ""
+	}
+	return timestamp.AsTime().In(time.Local).Format(time.DateTime)
+}
+
+// formatDate formats an optional date of a response, it is empty when the date is not set
+func formatDate(timestamp *timestamppb.Timestamp) string {
+	if timestamp == nil {
+		return ""
+	}
+	return timestamp.AsTime().Format(time.DateOnly)
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/maxuanquang/social-network/internal/auth"
+)
+
+const (
+	sessionCookieName         = "session_id"
+	defaultSessionIdleTimeout = 15 * time.Minute
+	defaultSessionMaxLifetime = 7 * 24 * time.Hour
+)
+
+var errSessionNotFound = errors.New("session not found")
+
+// session is the metadata of a logged in session, stored in Redis hash "session:<id>".
+// The id is the sha256 of the cookie value, so the cookie can not be recovered from Redis or from listed sessions.
+type session struct {
+	ID        string
+	UserID    int64
+	CreatedAt time.Time
+	LastSeen  time.Time
+	IP        string
+	UserAgent string
+}
+
+// createSession starts a new session for the user and sets the session cookie
+func (svc *WebService) createSession(ctx *gin.Context, userId int64) error {
+	cookieValue := uuid.New().String()
+	sessionId := hashSessionCookie(cookieValue)
+	now := time.Now()
+
+	sessionKey := fmt.Sprintf("session:%s", sessionId)
+	userSessionsKey := fmt.Sprintf("user_sessions:%d", userId)
+	pipe := svc.redisClient.TxPipeline()
+	pipe.HSet(svc.redisClient.Context(), sessionKey,
+		"user_id", userId,
+		"created_at", now.Unix(),
+		"last_seen", now.Unix(),
+		"ip", ctx.ClientIP(),
+		"user_agent", ctx.Request.UserAgent(),
+	)
+	pipe.Expire(svc.redisClient.Context(), sessionKey, svc.sessionIdleTimeout())
+	pipe.SAdd(svc.redisClient.Context(), userSessionsKey, sessionId)
+	pipe.Expire(svc.redisClient.Context(), userSessionsKey, svc.sessionMaxLifetime())
+	_, err := pipe.Exec(svc.redisClient.Context())
+	if err != nil {
+		return err
+	}
+
+	http.SetCookie(ctx.Writer, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    cookieValue,
+		MaxAge:   int(svc.sessionMaxLifetime().Seconds()),
+		Path:     "/",
+		Domain:   "",
+		SameSite: http.SameSiteNoneMode,
+		Secure:   true,
+		HttpOnly: true,
+	})
+	return nil
+}
+
+// checkSessionAuthentication returns the user of the request, authenticated by a bearer access token
+// or by the session cookie. sessionId is empty for bearer tokens.
+// Session expiration slides on every request but never goes past the absolute max lifetime.
+func (svc *WebService) checkSessionAuthentication(ctx *gin.Context) (sessionId string, userId int, err error) {
+	if accessToken := auth.ExtractToken(ctx); accessToken != "" {
+		token, err := svc.parseAccessToken(accessToken)
+		if err != nil {
+			return "", 0, err
+		}
+		return "", int(token.UserID), nil
+	}
+
+	cookieValue, err := ctx.Cookie(sessionCookieName)
+	if err != nil {
+		return "", 0, err
+	}
+	sessionId = hashSessionCookie(cookieValue)
+
+	s, err := svc.getSession(sessionId)
+	if err != nil {
+		return "", 0, err
+	}
+	remaining := time.Until(s.CreatedAt.Add(svc.sessionMaxLifetime()))
+	if remaining <= 0 {
+		svc.revokeSession(s.UserID, sessionId)
+		return "", 0, errSessionNotFound
+	}
+
+	ttl := svc.sessionIdleTimeout()
+	if remaining < ttl {
+		ttl = remaining
+	}
+	sessionKey := fmt.Sprintf("session:%s", sessionId)
+	svc.redisClient.HSet(svc.redisClient.Context(), sessionKey, "last_seen", time.Now().Unix())
+	svc.redisClient.Expire(svc.redisClient.Context(), sessionKey, ttl)
+
+	return sessionId, int(s.UserID), nil
+}
+
+// getSession reads a session from Redis
+func (svc *WebService) getSession(sessionId string) (*session, error) {
+	fields, err := svc.redisClient.HGetAll(svc.redisClient.Context(), fmt.Sprintf("session:%s", sessionId)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, errSessionNotFound
+	}
+
+	userId, err := strconv.ParseInt(fields["user_id"], 10, 64)
+	if err != nil {
+		return nil, errSessionNotFound
+	}
+	createdAt, _ := strconv.ParseInt(fields["created_at"], 10, 64)
+	lastSeen, _ := strconv.ParseInt(fields["last_seen"], 10, 64)
+	return &session{
+		ID:        sessionId,
+		UserID:    userId,
+		CreatedAt: time.Unix(createdAt, 0),
+		LastSeen:  time.Unix(lastSeen, 0),
+		IP:        fields["ip"],
+		UserAgent: fields["user_agent"],
+	}, nil
+}
+
+// listUserSessions returns active sessions of an user, most recently used first.
+// Ids of expired sessions are removed from the index on the way.
+func (svc *WebService) listUserSessions(userId int64) ([]*session, error) {
+	userSessionsKey := fmt.Sprintf("user_sessions:%d", userId)
+	sessionIds, err := svc.redisClient.SMembers(svc.redisClient.Context(), userSessionsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var sessions []*session
+	for _, sessionId := range sessionIds {
+		s, err := svc.getSession(sessionId)
+		if errors.Is(err, errSessionNotFound) {
+			svc.redisClient.SRem(svc.redisClient.Context(), userSessionsKey, sessionId)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeen.After(sessions[j].LastSeen)
+	})
+	return sessions, nil
+}
+
+// revokeSession logs out one session of an user
+func (svc *WebService) revokeSession(userId int64, sessionId string) {
+	svc.redisClient.Del(svc.redisClient.Context(), fmt.Sprintf("session:%s", sessionId))
+	svc.redisClient.SRem(svc.redisClient.Context(), fmt.Sprintf("user_sessions:%d", userId), sessionId)
+}
+
+// revokeUserSessions logs out all sessions of an user except the ones in keepSessionsIds,
+// access and refresh tokens of the user are revoked as well
+func (svc *WebService) revokeUserSessions(userId int64, keepSessionsIds ...string) {
+	svc.revokeUserRefreshTokens(userId)
+	svc.revokeUserAccessTokens(userId)
+
+	keep := make(map[string]bool)
+	for _, sessionId := range keepSessionsIds {
+		keep[sessionId] = true
+	}
+
+	userSessionsKey := fmt.Sprintf("user_sessions:%d", userId)
+	sessionIds := svc.redisClient.SMembers(svc.redisClient.Context(), userSessionsKey).Val()
+	for _, sessionId := range sessionIds {
+		if !keep[sessionId] {
+			svc.revokeSession(userId, sessionId)
+		}
+	}
+}
+
+// clearSessionCookie removes the session cookie from the browser
+func (svc *WebService) clearSessionCookie(ctx *gin.Context) {
+	http.SetCookie(ctx.Writer, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    "",
+		MaxAge:   -1,
+		Path:     "/",
+		Domain:   "",
+		SameSite: http.SameSiteNoneMode,
+		Secure:   true,
+		HttpOnly: true,
+	})
+}
+
+func (svc *WebService) sessionIdleTimeout() time.Duration {
+	if svc.cfg.Session.IdleTimeout <= 0 {
+		return defaultSessionIdleTimeout
+	}
+	return svc.cfg.Session.IdleTimeout
+}
+
+func (svc *WebService) sessionMaxLifetime() time.Duration {
+	if svc.cfg.Session.MaxLifetime <= 0 {
+		return defaultSessionMaxLifetime
+	}
+	return svc.cfg.Session.MaxLifetime
+}
+
+func hashSessionCookie(cookieValue string) string {
+	hash := sha256.Sum256([]byte(cookieValue))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,292 @@
+package service
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+)
+
+// CreateStory creates new story
+//
+//	@Summary		create new story
+//	@Description	create new story, it expires 24 hours after creation
+//	@Tags			stories
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		types.CreateStoryRequest	true	"Create story parameters"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/stories [post]
+func (svc *WebService) CreateStory(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.CreateStoryRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.CreateStory(ctx, &pb_aap.CreateStoryRequest{
+		UserId:           int64(userId),
+		ContentText:      jsonRequest.ContentText,
+		ContentImagePath: jsonRequest.ContentImagePath,
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.CreateStoryResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateStoryResponse_INVALID_STORY {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid story"})
+		return
+	} else if resp.GetStatus() == pb_aap.CreateStoryResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetStory gets a story and marks it as viewed
+//
+//	@Summary		get story
+//	@Description	get an active story, the user is added to its viewers
+//	@Tags			stories
+//	@Accept			json
+//	@Produce		json
+//	@Param			story_id	path		int	true	"Story ID"
+//	@Success		200			{object}	types.StoryResponse
+//	@Failure		400			{object}	types.MessageResponse
+//	@Failure		500			{object}	types.MessageResponse
+//	@Router			/stories/{story_id} [get]
+func (svc *WebService) GetStory(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	storyId, err := strconv.Atoi(ctx.Param("story_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "story not found"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.GetStory(ctx, &pb_aap.GetStoryRequest{
+		UserId:  int64(userId),
+		StoryId: int64(storyId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetStoryResponse_STORY_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "story not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetStoryResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetStoryResponse_OK {
+		story := resp.GetStory()
+		ctx.IndentedJSON(http.StatusOK, types.StoryResponse{
+			StoryID:          story.GetStoryId(),
+			UserID:           story.GetUserId(),
+			ContentText:      story.GetContentText(),
+			ContentImagePath: story.GetContentImagePath(),
+			CreatedAt:        story.GetCreatedAt().AsTime().In(time.Local).Format(time.DateTime),
+			ExpiresAt:        story.GetExpiresAt().AsTime().In(time.Local).Format(time.DateTime),
+		})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// DeleteStory deletes a story before it expires
+//
+//	@Summary		delete story
+//	@Description	delete a story before it expires
+//	@Tags			stories
+//	@Accept			json
+//	@Produce		json
+//	@Param			story_id	path		int	true	"Story ID"
+//	@Success		200			{object}	types.MessageResponse
+//	@Failure		400			{object}	types.MessageResponse
+//	@Failure		500			{object}	types.MessageResponse
+//	@Router			/stories/{story_id} [delete]
+func (svc *WebService) DeleteStory(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	storyId, err := strconv.Atoi(ctx.Param("story_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "story not found"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.DeleteStory(ctx, &pb_aap.DeleteStoryRequest{
+		UserId:  int64(userId),
+		StoryId: int64(storyId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.DeleteStoryResponse_STORY_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "story not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteStoryResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteStoryResponse_NOT_ALLOWED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "not allowed"})
+		return
+	} else if resp.GetStatus() == pb_aap.DeleteStoryResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetStoriesTray lists followings with active stories
+//
+//	@Summary		get stories tray
+//	@Description	list followings with active stories, authors with unseen stories come first
+//	@Tags			stories
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	types.StoriesTrayResponse
+//	@Failure		400	{object}	types.MessageResponse
+//	@Failure		500	{object}	types.MessageResponse
+//	@Router			/stories [get]
+func (svc *WebService) GetStoriesTray(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.GetStoriesTray(ctx, &pb_aap.GetStoriesTrayRequest{
+		UserId: int64(userId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetStoriesTrayResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetStoriesTrayResponse_OK {
+		items := []types.StoriesTrayItemResponse{}
+		for _, item := range resp.GetItems() {
+			items = append(items, types.StoriesTrayItemResponse{
+				UserID:          item.GetUserId(),
+				StoriesIds:      item.GetStoriesIds(),
+				LatestCreatedAt: item.GetLatestCreatedAt().AsTime().In(time.Local).Format(time.DateTime),
+				Seen:            item.GetSeen(),
+			})
+		}
+		ctx.IndentedJSON(http.StatusOK, types.StoriesTrayResponse{Items: items})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// GetStoryViewers lists viewers of a story
+//
+//	@Summary		get story viewers
+//	@Description	list viewers of user's own story, latest viewers first
+//	@Tags			stories
+//	@Accept			json
+//	@Produce		json
+//	@Param			story_id	path		int	true	"Story ID"
+//	@Success		200			{object}	types.StoryViewersResponse
+//	@Failure		400			{object}	types.MessageResponse
+//	@Failure		500			{object}	types.MessageResponse
+//	@Router			/stories/{story_id}/viewers [get]
+func (svc *WebService) GetStoryViewers(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Check URL params
+	storyId, err := strconv.Atoi(ctx.Param("story_id"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "story not found"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.GetStoryViewers(ctx, &pb_aap.GetStoryViewersRequest{
+		UserId:  int64(userId),
+		StoryId: int64(storyId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.GetStoryViewersResponse_STORY_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "story not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetStoryViewersResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetStoryViewersResponse_NOT_ALLOWED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "not allowed"})
+		return
+	} else if resp.GetStatus() == pb_aap.GetStoryViewersResponse_OK {
+		viewers := []types.StoryViewerResponse{}
+		for _, viewer := range resp.GetViewers() {
+			viewers = append(viewers, types.StoryViewerResponse{
+				UserID:   viewer.GetUserId(),
+				ViewedAt: viewer.GetViewedAt().AsTime().In(time.Local).Format(time.DateTime),
+			})
+		}
+		ctx.IndentedJSON(http.StatusOK, types.StoryViewersResponse{Viewers: viewers})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
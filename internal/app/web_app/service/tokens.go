@@ -0,0 +1,203 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/maxuanquang/social-network/internal/auth"
+)
+
+const defaultRefreshTokenLifetime = 30 * 24 * time.Hour
+
+var (
+	errInvalidRefreshToken = errors.New("invalid refresh token")
+	errRevokedAccessToken  = errors.New("access token was revoked")
+)
+
+// Refresh tokens are opaque random strings, only their sha256 is stored in Redis:
+//
+//	refresh_token:<hash>          hash of user_id, client_id, scope and family of a valid token
+//	refresh_token_used:<hash>     family of a token that was already exchanged
+//	refresh_family:<family>       hash of the valid token of a family
+//	user_refresh_families:<uid>   families of an user
+//
+// Every refresh exchanges the token for a new one of the same family. Presenting an exchanged
+// token again means it was stolen, so the whole family is revoked.
+//
+// Access tokens can not be deleted, they carry the token generation of their user instead:
+//
+//	token_generation:<uid>        current generation, increased to revoke every access token issued before
+
+// tokenGrant is what a refresh token grants: an user, the OAuth2 client it was issued to
+// (empty for first-party tokens) and the scopes of its access tokens
+type tokenGrant struct {
+	UserID   int64
+	ClientID string
+	Scopes   []string
+}
+
+// issueRefreshToken creates a refresh token, a new family is started when family is empty
+func (svc *WebService) issueRefreshToken(grant *tokenGrant, family string) (string, error) {
+	token, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	tokenHash := hashToken(token)
+	if family == "" {
+		family = uuid.New().String()
+	}
+
+	lifetime := svc.refreshTokenLifetime()
+	userFamiliesKey := fmt.Sprintf("user_refresh_families:%d", grant.UserID)
+	pipe := svc.redisClient.TxPipeline()
+	pipe.HSet(svc.redisClient.Context(), fmt.Sprintf("refresh_token:%s", tokenHash),
+		"user_id", grant.UserID,
+		"client_id", grant.ClientID,
+		"scope", auth.FormatScope(grant.Scopes),
+		"family", family,
+	)
+	pipe.Expire(svc.redisClient.Context(), fmt.Sprintf("refresh_token:%s", tokenHash), lifetime)
+	pipe.Set(svc.redisClient.Context(), fmt.Sprintf("refresh_family:%s", family), tokenHash, lifetime)
+	pipe.SAdd(svc.redisClient.Context(), userFamiliesKey, family)
+	pipe.Expire(svc.redisClient.Context(), userFamiliesKey, lifetime)
+	_, err = pipe.Exec(svc.redisClient.Context())
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// rotateRefreshToken exchanges a refresh token of clientId for a new one, it returns the grant of the token
+func (svc *WebService) rotateRefreshToken(token string, clientId string) (grant *tokenGrant, newToken string, err error) {
+	tokenHash := hashToken(token)
+	tokenKey := fmt.Sprintf("refresh_token:%s", tokenHash)
+	usedKey := fmt.Sprintf("refresh_token_used:%s", tokenHash)
+
+	fields, err := svc.redisClient.HGetAll(svc.redisClient.Context(), tokenKey).Result()
+	if err != nil {
+		return nil, "", err
+	}
+	if len(fields) == 0 {
+		// Reuse of an exchanged token revokes its family
+		family, err := svc.redisClient.Get(svc.redisClient.Context(), usedKey).Result()
+		if err == nil {
+			svc.revokeRefreshFamily(family)
+		}
+		return nil, "", errInvalidRefreshToken
+	}
+	if fields["client_id"] != clientId {
+		return nil, "", errInvalidRefreshToken
+	}
+
+	// Only one of concurrent refreshes with the same token can succeed
+	if svc.redisClient.Del(svc.redisClient.Context(), tokenKey).Val() != 1 {
+		return nil, "", errInvalidRefreshToken
+	}
+	userId, err := strconv.ParseInt(fields["user_id"], 10, 64)
+	if err != nil {
+		return nil, "", errInvalidRefreshToken
+	}
+	scopes, err := auth.ParseScope(fields["scope"])
+	if err != nil {
+		return nil, "", errInvalidRefreshToken
+	}
+	grant = &tokenGrant{UserID: userId, ClientID: clientId, Scopes: scopes}
+	family := fields["family"]
+	svc.redisClient.Set(svc.redisClient.Context(), usedKey, family, svc.refreshTokenLifetime())
+
+	newToken, err = svc.issueRefreshToken(grant, family)
+	if err != nil {
+		return nil, "", err
+	}
+	return grant, newToken, nil
+}
+
+// revokeRefreshToken revokes the family of a refresh token of clientId
+func (svc *WebService) revokeRefreshToken(token string, clientId string) error {
+	fields, err := svc.redisClient.HGetAll(svc.redisClient.Context(), fmt.Sprintf("refresh_token:%s", hashToken(token))).Result()
+	if err != nil || len(fields) == 0 || fields["client_id"] != clientId {
+		return errInvalidRefreshToken
+	}
+	svc.revokeRefreshFamily(fields["family"])
+	return nil
+}
+
+func (svc *WebService) revokeRefreshFamily(family string) {
+	familyKey := fmt.Sprintf("refresh_family:%s", family)
+	tokenHash, err := svc.redisClient.Get(svc.redisClient.Context(), familyKey).Result()
+	if err == nil {
+		svc.redisClient.Del(svc.redisClient.Context(), fmt.Sprintf("refresh_token:%s", tokenHash))
+	}
+	svc.redisClient.Del(svc.redisClient.Context(), familyKey)
+}
+
+// revokeUserRefreshTokens revokes all refresh tokens of an user
+func (svc *WebService) revokeUserRefreshTokens(userId int64) {
+	userFamiliesKey := fmt.Sprintf("user_refresh_families:%d", userId)
+	for _, family := range svc.redisClient.SMembers(svc.redisClient.Context(), userFamiliesKey).Val() {
+		svc.revokeRefreshFamily(family)
+	}
+	svc.redisClient.Del(svc.redisClient.Context(), userFamiliesKey)
+}
+
+// issueAccessToken returns a signed access token of the current token generation of an user
+func (svc *WebService) issueAccessToken(grant *tokenGrant) (string, time.Time, error) {
+	generation, err := svc.tokenGeneration(grant.UserID)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return svc.tokenManager.GenerateAccessToken(grant.UserID, grant.ClientID, grant.Scopes, generation)
+}
+
+// parseAccessToken validates an access token and checks that it was not revoked
+func (svc *WebService) parseAccessToken(accessToken string) (*auth.AccessToken, error) {
+	token, err := svc.tokenManager.ParseAccessToken(accessToken)
+	if err != nil {
+		return nil, err
+	}
+	generation, err := svc.tokenGeneration(token.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if token.Generation < generation {
+		return nil, errRevokedAccessToken
+	}
+	return token, nil
+}
+
+func (svc *WebService) tokenGeneration(userId int64) (int64, error) {
+	generation, err := svc.redisClient.Get(svc.redisClient.Context(), fmt.Sprintf("token_generation:%d", userId)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return generation, err
+}
+
+// revokeUserAccessTokens revokes all access tokens of an user. The generation never expires, a
+// generation going back to 0 would make the revoked tokens valid again.
+func (svc *WebService) revokeUserAccessTokens(userId int64) {
+	generationKey := fmt.Sprintf("token_generation:%d", userId)
+	err := svc.redisClient.Incr(svc.redisClient.Context(), generationKey).Err()
+	if err != nil {
+		svc.logger.Error(err.Error())
+	}
+}
+
+func (svc *WebService) refreshTokenLifetime() time.Duration {
+	if svc.cfg.Token.RefreshTokenLifetime <= 0 {
+		return defaultRefreshTokenLifetime
+	}
+	return svc.cfg.Token.RefreshTokenLifetime
+}
+
+// hashToken hashes high-entropy tokens and secrets before they are stored
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxuanquang/social-network/internal/auth"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+)
+
+// IssueToken issues access and refresh tokens for API clients
+//
+//	@Summary		issue token
+//	@Description	check user's username, password and two-factor authentication code if enabled, and issue a short-lived access token and a refresh token
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		types.LoginRequest	true	"Login parameters"
+//	@Success		200		{object}	types.TokenResponse
+//	@Failure		400		{object}	types.TokenResponse
+//	@Failure		401		{object}	types.TokenResponse
+//	@Failure		429		{object}	types.TokenResponse
+//	@Failure		500		{object}	types.TokenResponse
+//	@Router			/users/token [post]
+func (svc *WebService) IssueToken(ctx *gin.Context) {
+	// Validate request
+	var jsonRequest types.LoginRequest
+	err := ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.TokenResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.TokenResponse{Message: err.Error()})
+		return
+	}
+
+	// Call CheckUserAuthentication service
+	resp, err := svc.authenticateAndPostClient.CheckUserAuthentication(ctx, &pb_aap.CheckUserAuthenticationRequest{
+		UserName:     jsonRequest.UserName,
+		UserPassword: jsonRequest.Password,
+		ClientIp:     ctx.ClientIP(),
+		OtpCode:      jsonRequest.OTPCode,
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.TokenResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.CheckUserAuthenticationResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.TokenResponse{Message: "wrong username or password"})
+		return
+	} else if resp.GetStatus() == pb_aap.CheckUserAuthenticationResponse_WRONG_PASSWORD {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.TokenResponse{Message: "wrong username or password"})
+		return
+	} else if resp.GetStatus() == pb_aap.CheckUserAuthenticationResponse_TWO_FACTOR_REQUIRED {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.TokenResponse{Message: "two-factor authentication code required"})
+		return
+	} else if resp.GetStatus() == pb_aap.CheckUserAuthenticationResponse_WRONG_OTP_CODE {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.TokenResponse{Message: "wrong two-factor authentication code"})
+		return
+	} else if resp.GetStatus() == pb_aap.CheckUserAuthenticationResponse_ACCOUNT_LOCKED {
+		svc.countReporter.WithLabelValues("check_user_login", "blocked").Inc()
+		setRetryAfter(ctx, resp.GetLockedUntil().AsTime())
+		ctx.IndentedJSON(http.StatusTooManyRequests, types.TokenResponse{Message: "too many failed login attempts, try again later"})
+		return
+	} else if resp.GetStatus() == pb_aap.CheckUserAuthenticationResponse_OK {
+		grant := &tokenGrant{UserID: resp.GetUser().GetUserId(), Scopes: auth.FirstPartyScopes}
+		refreshToken, err := svc.issueRefreshToken(grant, "")
+		if err != nil {
+			ctx.IndentedJSON(http.StatusInternalServerError, types.TokenResponse{Message: err.Error()})
+			return
+		}
+		svc.respondWithTokens(ctx, grant, refreshToken)
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.TokenResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// RefreshToken exchanges a refresh token for new access and refresh tokens
+//
+//	@Summary		refresh token
+//	@Description	exchange a refresh token for a new access token and a new refresh token, the old refresh token can not be used again
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		types.RefreshTokenRequest	true	"Refresh token"
+//	@Success		200		{object}	types.TokenResponse
+//	@Failure		400		{object}	types.TokenResponse
+//	@Failure		401		{object}	types.TokenResponse
+//	@Failure		500		{object}	types.TokenResponse
+//	@Router			/users/token/refresh [post]
+func (svc *WebService) RefreshToken(ctx *gin.Context) {
+	// Validate request
+	var jsonRequest types.RefreshTokenRequest
+	err := ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.TokenResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.TokenResponse{Message: err.Error()})
+		return
+	}
+
+	// Tokens of OAuth2 clients are refreshed through the OAuth2 token endpoint
+	grant, refreshToken, err := svc.rotateRefreshToken(jsonRequest.RefreshToken, "")
+	if err == errInvalidRefreshToken {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.TokenResponse{Message: err.Error()})
+		return
+	} else if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.TokenResponse{Message: err.Error()})
+		return
+	}
+	svc.respondWithTokens(ctx, grant, refreshToken)
+}
+
+// RevokeToken revokes a refresh token
+//
+//	@Summary		revoke token
+//	@Description	revoke a refresh token and all refresh tokens rotated from it
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		types.RefreshTokenRequest	true	"Refresh token"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Router			/users/token/revoke [post]
+func (svc *WebService) RevokeToken(ctx *gin.Context) {
+	// Validate request
+	var jsonRequest types.RefreshTokenRequest
+	err := ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Unknown tokens are not reported so that valid tokens can not be probed
+	_ = svc.revokeRefreshToken(jsonRequest.RefreshToken, "")
+	ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+}
+
+func (svc *WebService) respondWithTokens(ctx *gin.Context, grant *tokenGrant, refreshToken string) {
+	accessToken, expiresAt, err := svc.issueAccessToken(grant)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.TokenResponse{Message: err.Error()})
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, types.TokenResponse{
+		Message:      "OK",
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(time.Until(expiresAt).Seconds()),
+		RefreshToken: refreshToken,
+	})
+}
+
+// setRetryAfter tells the client how long to wait before retrying a login
+func setRetryAfter(ctx *gin.Context, lockedUntil time.Time) {
+	retryAfter := int(math.Ceil(time.Until(lockedUntil).Seconds()))
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+	ctx.Header("Retry-After", strconv.Itoa(retryAfter))
+}
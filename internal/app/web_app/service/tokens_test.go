@@ -0,0 +1,177 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/maxuanquang/social-network/configs"
+	"github.com/maxuanquang/social-network/internal/auth"
+	"go.uber.org/zap"
+)
+
+func newTestWebService(t *testing.T) *WebService {
+	t.Helper()
+	server := miniredis.RunT(t)
+	cfg := &configs.WebConfig{
+		Token: configs.TokenConfig{
+			CurrentKid:  "test",
+			SigningKeys: []configs.SigningKeyConfig{{Kid: "test", Secret: "0123456789abcdef0123456789abcdef"}},
+		},
+	}
+	tokenManager, err := auth.NewTokenManager(&cfg.Token)
+	if err != nil {
+		t.Fatalf("NewTokenManager() error = %v", err)
+	}
+	return &WebService{
+		redisClient:  redis.NewClient(&redis.Options{Addr: server.Addr()}),
+		cfg:          cfg,
+		tokenManager: tokenManager,
+		logger:       zap.NewNop(),
+	}
+}
+
+func TestRotateRefreshToken(t *testing.T) {
+	svc := newTestWebService(t)
+	grant := &tokenGrant{UserID: 42, ClientID: "client", Scopes: []string{auth.ScopeRead}}
+	first, err := svc.issueRefreshToken(grant, "")
+	if err != nil {
+		t.Fatalf("issueRefreshToken() error = %v", err)
+	}
+
+	gotGrant, second, err := svc.rotateRefreshToken(first, "client")
+	if err != nil {
+		t.Fatalf("rotateRefreshToken() error = %v", err)
+	}
+	if gotGrant.UserID != 42 || gotGrant.ClientID != "client" || auth.FormatScope(gotGrant.Scopes) != auth.ScopeRead {
+		t.Errorf("rotateRefreshToken() grant = %+v", gotGrant)
+	}
+	if second == first {
+		t.Fatal("rotateRefreshToken() returned the same token")
+	}
+
+	_, third, err := svc.rotateRefreshToken(second, "client")
+	if err != nil {
+		t.Fatalf("rotateRefreshToken(second) error = %v", err)
+	}
+
+	// Reusing an exchanged token revokes the whole family, including its latest token
+	_, _, err = svc.rotateRefreshToken(first, "client")
+	if !errors.Is(err, errInvalidRefreshToken) {
+		t.Fatalf("rotateRefreshToken(reused) error = %v, want %v", err, errInvalidRefreshToken)
+	}
+	_, _, err = svc.rotateRefreshToken(third, "client")
+	if !errors.Is(err, errInvalidRefreshToken) {
+		t.Errorf("rotateRefreshToken(after reuse) error = %v, want %v", err, errInvalidRefreshToken)
+	}
+}
+
+func TestRotateRefreshTokenRejects(t *testing.T) {
+	svc := newTestWebService(t)
+	grant := &tokenGrant{UserID: 42, ClientID: "client", Scopes: []string{auth.ScopeRead}}
+	token, err := svc.issueRefreshToken(grant, "")
+	if err != nil {
+		t.Fatalf("issueRefreshToken() error = %v", err)
+	}
+	other, err := svc.issueRefreshToken(&tokenGrant{UserID: 42, Scopes: auth.FirstPartyScopes}, "")
+	if err != nil {
+		t.Fatalf("issueRefreshToken() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		token    string
+		clientId string
+	}{
+		{"unknown token", "unknown", "client"},
+		{"other client", token, "other"},
+		{"first-party token used by client", other, "client"},
+		{"client token used by first party", token, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := svc.rotateRefreshToken(tt.token, tt.clientId)
+			if !errors.Is(err, errInvalidRefreshToken) {
+				t.Errorf("rotateRefreshToken() error = %v, want %v", err, errInvalidRefreshToken)
+			}
+		})
+	}
+
+	// Rejected attempts do not consume the token
+	_, _, err = svc.rotateRefreshToken(token, "client")
+	if err != nil {
+		t.Errorf("rotateRefreshToken() error = %v", err)
+	}
+}
+
+func TestRevokeRefreshTokens(t *testing.T) {
+	svc := newTestWebService(t)
+	grant := &tokenGrant{UserID: 42, Scopes: auth.FirstPartyScopes}
+	first, err := svc.issueRefreshToken(grant, "")
+	if err != nil {
+		t.Fatalf("issueRefreshToken() error = %v", err)
+	}
+	second, err := svc.issueRefreshToken(grant, "")
+	if err != nil {
+		t.Fatalf("issueRefreshToken() error = %v", err)
+	}
+	otherUser, err := svc.issueRefreshToken(&tokenGrant{UserID: 7, Scopes: auth.FirstPartyScopes}, "")
+	if err != nil {
+		t.Fatalf("issueRefreshToken() error = %v", err)
+	}
+
+	if err := svc.revokeRefreshToken(first, "other"); !errors.Is(err, errInvalidRefreshToken) {
+		t.Errorf("revokeRefreshToken(other client) error = %v, want %v", err, errInvalidRefreshToken)
+	}
+	if err := svc.revokeRefreshToken(first, ""); err != nil {
+		t.Fatalf("revokeRefreshToken() error = %v", err)
+	}
+	if _, _, err := svc.rotateRefreshToken(first, ""); !errors.Is(err, errInvalidRefreshToken) {
+		t.Errorf("rotateRefreshToken(revoked) error = %v, want %v", err, errInvalidRefreshToken)
+	}
+
+	svc.revokeUserRefreshTokens(42)
+	if _, _, err := svc.rotateRefreshToken(second, ""); !errors.Is(err, errInvalidRefreshToken) {
+		t.Errorf("rotateRefreshToken(revoked user) error = %v, want %v", err, errInvalidRefreshToken)
+	}
+	if _, _, err := svc.rotateRefreshToken(otherUser, ""); err != nil {
+		t.Errorf("rotateRefreshToken(other user) error = %v", err)
+	}
+}
+
+func TestRevokeUserAccessTokens(t *testing.T) {
+	svc := newTestWebService(t)
+	grant := &tokenGrant{UserID: 42, Scopes: auth.FirstPartyScopes}
+	before, _, err := svc.issueAccessToken(grant)
+	if err != nil {
+		t.Fatalf("issueAccessToken() error = %v", err)
+	}
+	otherUser, _, err := svc.issueAccessToken(&tokenGrant{UserID: 7, Scopes: auth.FirstPartyScopes})
+	if err != nil {
+		t.Fatalf("issueAccessToken() error = %v", err)
+	}
+	if _, err := svc.parseAccessToken(before); err != nil {
+		t.Fatalf("parseAccessToken() error = %v", err)
+	}
+
+	svc.revokeUserAccessTokens(42)
+	after, _, err := svc.issueAccessToken(grant)
+	if err != nil {
+		t.Fatalf("issueAccessToken() error = %v", err)
+	}
+
+	if _, err := svc.parseAccessToken(before); !errors.Is(err, errRevokedAccessToken) {
+		t.Errorf("parseAccessToken(before) error = %v, want %v", err, errRevokedAccessToken)
+	}
+	if _, err := svc.parseAccessToken(after); err != nil {
+		t.Errorf("parseAccessToken(after) error = %v", err)
+	}
+	if _, err := svc.parseAccessToken(otherUser); err != nil {
+		t.Errorf("parseAccessToken(other user) error = %v", err)
+	}
+	// The generation going back to 0 would make the revoked tokens valid again
+	if ttl := svc.redisClient.TTL(svc.redisClient.Context(), "token_generation:42").Val(); ttl != -1 {
+		t.Errorf("token generation TTL = %v, want none", ttl)
+	}
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	loginChallengeLifetime    = 5 * time.Minute
+	maxLoginChallengeAttempts = 5
+)
+
+var errInvalidLoginChallenge = errors.New("invalid or expired two-factor challenge")
+
+// Users with two-factor authentication log in in two steps. The password step returns a challenge,
+// which is exchanged with a code for a session. Only the sha256 of challenges is stored, in Redis hash
+// "login_challenge:<hash>" with the user id and the number of attempts.
+
+// createLoginChallenge creates a challenge of an user whose password was checked
+func (svc *WebService) createLoginChallenge(userId int64) (string, error) {
+	challenge, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+
+	challengeKey := fmt.Sprintf("login_challenge:%s", hashToken(challenge))
+	pipe := svc.redisClient.TxPipeline()
+	pipe.HSet(svc.redisClient.Context(), challengeKey, "user_id", userId, "attempts", 0)
+	pipe.Expire(svc.redisClient.Context(), challengeKey, loginChallengeLifetime)
+	_, err = pipe.Exec(svc.redisClient.Context())
+	if err != nil {
+		return "", err
+	}
+	return challenge, nil
+}
+
+// getLoginChallenge returns the user of a challenge and counts an attempt to complete it,
+// the challenge is removed once it has been tried maxLoginChallengeAttempts times
+func (svc *WebService) getLoginChallenge(challenge string) (int64, error) {
+	challengeKey := fmt.Sprintf("login_challenge:%s", hashToken(challenge))
+	userIdString, err := svc.redisClient.HGet(svc.redisClient.Context(), challengeKey, "user_id").Result()
+	if err != nil {
+		return 0, errInvalidLoginChallenge
+	}
+	userId, err := strconv.ParseInt(userIdString, 10, 64)
+	if err != nil {
+		return 0, errInvalidLoginChallenge
+	}
+
+	attempts := svc.redisClient.HIncrBy(svc.redisClient.Context(), challengeKey, "attempts", 1).Val()
+	if attempts > maxLoginChallengeAttempts {
+		svc.deleteLoginChallenge(challenge)
+		return 0, errInvalidLoginChallenge
+	}
+	return userId, nil
+}
+
+func (svc *WebService) deleteLoginChallenge(challenge string) {
+	svc.redisClient.Del(svc.redisClient.Context(), fmt.Sprintf("login_challenge:%s", hashToken(challenge)))
+}
@@ -0,0 +1,332 @@
+package service
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maxuanquang/social-network/internal/pkg/types"
+
+	pb_aap "github.com/maxuanquang/social-network/pkg/types/proto/pb/authen_and_post"
+)
+
+// CompleteTwoFactorLogin completes the login of an user with two-factor authentication
+//
+//	@Summary		complete two-factor login
+//	@Description	exchange the challenge returned by login and a TOTP or recovery code for a session
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		types.TwoFactorLoginRequest	true	"Challenge and code"
+//	@Success		200		{object}	types.LoginResponse
+//	@Failure		400		{object}	types.LoginResponse
+//	@Failure		401		{object}	types.LoginResponse
+//	@Failure		429		{object}	types.LoginResponse
+//	@Failure		500		{object}	types.LoginResponse
+//	@Router			/users/login/two_factor [post]
+func (svc *WebService) CompleteTwoFactorLogin(ctx *gin.Context) {
+	// Validate request
+	var jsonRequest types.TwoFactorLoginRequest
+	err := ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.LoginResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.LoginResponse{Message: err.Error()})
+		return
+	}
+
+	userId, err := svc.getLoginChallenge(jsonRequest.Challenge)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.LoginResponse{Message: err.Error()})
+		return
+	}
+
+	// Call VerifyTwoFactor service
+	resp, err := svc.authenticateAndPostClient.VerifyTwoFactor(ctx, &pb_aap.VerifyTwoFactorRequest{
+		UserId:   userId,
+		OtpCode:  jsonRequest.OTPCode,
+		ClientIp: ctx.ClientIP(),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.LoginResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.VerifyTwoFactorResponse_WRONG_OTP_CODE {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.LoginResponse{Message: "wrong two-factor authentication code"})
+		return
+	} else if resp.GetStatus() == pb_aap.VerifyTwoFactorResponse_ACCOUNT_LOCKED {
+		svc.countReporter.WithLabelValues("check_user_login", "blocked").Inc()
+		setRetryAfter(ctx, resp.GetLockedUntil().AsTime())
+		ctx.IndentedJSON(http.StatusTooManyRequests, types.LoginResponse{Message: "too many failed login attempts, try again later"})
+		return
+	} else if resp.GetStatus() == pb_aap.VerifyTwoFactorResponse_USER_NOT_FOUND || resp.GetStatus() == pb_aap.VerifyTwoFactorResponse_NOT_ENABLED {
+		svc.deleteLoginChallenge(jsonRequest.Challenge)
+		ctx.IndentedJSON(http.StatusUnauthorized, types.LoginResponse{Message: errInvalidLoginChallenge.Error()})
+		return
+	} else if resp.GetStatus() == pb_aap.VerifyTwoFactorResponse_OK {
+		svc.deleteLoginChallenge(jsonRequest.Challenge)
+
+		// Start a new session
+		err = svc.createSession(ctx, resp.GetUser().GetUserId())
+		if err != nil {
+			ctx.IndentedJSON(http.StatusInternalServerError, types.LoginResponse{Message: err.Error()})
+			return
+		}
+
+		ctx.IndentedJSON(http.StatusOK, types.LoginResponse{
+			Message:             "OK",
+			DeletionScheduledAt: formatTimestamp(resp.GetDeletionScheduledAt()),
+			User: types.UserDetailInfo{
+				UserID:            resp.GetUser().GetUserId(),
+				UserName:          resp.GetUser().GetUserName(),
+				FirstName:         resp.GetUser().GetFirstName(),
+				LastName:          resp.GetUser().GetLastName(),
+				DateOfBirth:       resp.GetUser().GetDateOfBirth().AsTime().Format(time.DateOnly),
+				Email:             resp.GetUser().GetEmail(),
+				EmailVerified:     resp.GetUser().GetEmailVerified(),
+				ProfilePicture:    resp.GetUser().GetProfilePicture(),
+				CoverPicture:      resp.GetUser().GetCoverPicture(),
+				IsPrivate:         resp.GetUser().GetIsPrivate(),
+				FollowersCount:    resp.GetUser().GetFollowersCount(),
+				FollowingsCount:   resp.GetUser().GetFollowingsCount(),
+				PostsCount:        resp.GetUser().GetPostsCount(),
+				Bio:               resp.GetUser().GetBio(),
+				Location:          resp.GetUser().GetLocation(),
+				Website:           resp.GetUser().GetWebsite(),
+				Pronouns:          resp.GetUser().GetPronouns(),
+				ProfileVisibility: newProfileVisibilityResponse(resp.GetUser().GetProfileVisibility()),
+			}})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.LoginResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// EnrollTwoFactor starts enrolling two-factor authentication
+//
+//	@Summary		enroll two-factor authentication
+//	@Description	create a TOTP secret, its provisioning uri is shown as a QR code to authenticator apps. It is enabled once a code is confirmed
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	types.TwoFactorEnrollmentResponse
+//	@Failure		400	{object}	types.MessageResponse
+//	@Failure		401	{object}	types.MessageResponse
+//	@Failure		500	{object}	types.MessageResponse
+//	@Router			/users/two_factor/enroll [post]
+func (svc *WebService) EnrollTwoFactor(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call EnrollTwoFactor service
+	resp, err := svc.authenticateAndPostClient.EnrollTwoFactor(ctx, &pb_aap.EnrollTwoFactorRequest{
+		UserId: int64(userId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.EnrollTwoFactorResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.EnrollTwoFactorResponse_ALREADY_ENABLED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "two-factor authentication already enabled"})
+		return
+	} else if resp.GetStatus() == pb_aap.EnrollTwoFactorResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.TwoFactorEnrollmentResponse{
+			Secret:          resp.GetSecret(),
+			ProvisioningURI: resp.GetProvisioningUri(),
+		})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// EnableTwoFactor enables two-factor authentication
+//
+//	@Summary		enable two-factor authentication
+//	@Description	confirm the enrollment with a TOTP code, recovery codes are returned only once
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		types.TwoFactorCodeRequest	true	"TOTP code"
+//	@Success		200		{object}	types.RecoveryCodesResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/users/two_factor/enable [post]
+func (svc *WebService) EnableTwoFactor(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.TwoFactorCodeRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call EnableTwoFactor service
+	resp, err := svc.authenticateAndPostClient.EnableTwoFactor(ctx, &pb_aap.EnableTwoFactorRequest{
+		UserId:  int64(userId),
+		OtpCode: jsonRequest.OTPCode,
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.EnableTwoFactorResponse_NOT_ENROLLED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "two-factor authentication not enrolled"})
+		return
+	} else if resp.GetStatus() == pb_aap.EnableTwoFactorResponse_ALREADY_ENABLED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "two-factor authentication already enabled"})
+		return
+	} else if resp.GetStatus() == pb_aap.EnableTwoFactorResponse_WRONG_OTP_CODE {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "wrong two-factor authentication code"})
+		return
+	} else if resp.GetStatus() == pb_aap.EnableTwoFactorResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.RecoveryCodesResponse{RecoveryCodes: resp.GetRecoveryCodes()})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// DisableTwoFactor disables two-factor authentication
+//
+//	@Summary		disable two-factor authentication
+//	@Description	disable two-factor authentication with a TOTP or recovery code
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		types.TwoFactorCodeRequest	true	"TOTP or recovery code"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		403		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/users/two_factor/disable [post]
+func (svc *WebService) DisableTwoFactor(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.TwoFactorCodeRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call DisableTwoFactor service
+	resp, err := svc.authenticateAndPostClient.DisableTwoFactor(ctx, &pb_aap.DisableTwoFactorRequest{
+		UserId:  int64(userId),
+		OtpCode: jsonRequest.OTPCode,
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.DisableTwoFactorResponse_NOT_ENABLED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "two-factor authentication not enabled"})
+		return
+	} else if resp.GetStatus() == pb_aap.DisableTwoFactorResponse_WRONG_OTP_CODE {
+		ctx.IndentedJSON(http.StatusForbidden, types.MessageResponse{Message: "wrong two-factor authentication code"})
+		return
+	} else if resp.GetStatus() == pb_aap.DisableTwoFactorResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// RegenerateRecoveryCodes replaces recovery codes of user
+//
+//	@Summary		regenerate recovery codes
+//	@Description	replace recovery codes with a TOTP or recovery code, the old ones stop working
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		types.TwoFactorCodeRequest	true	"TOTP or recovery code"
+//	@Success		200		{object}	types.RecoveryCodesResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		401		{object}	types.MessageResponse
+//	@Failure		403		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/users/two_factor/recovery_codes [post]
+func (svc *WebService) RegenerateRecoveryCodes(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Validate request
+	var jsonRequest types.TwoFactorCodeRequest
+	err = ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call RegenerateRecoveryCodes service
+	resp, err := svc.authenticateAndPostClient.RegenerateRecoveryCodes(ctx, &pb_aap.RegenerateRecoveryCodesRequest{
+		UserId:  int64(userId),
+		OtpCode: jsonRequest.OTPCode,
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.RegenerateRecoveryCodesResponse_NOT_ENABLED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "two-factor authentication not enabled"})
+		return
+	} else if resp.GetStatus() == pb_aap.RegenerateRecoveryCodesResponse_WRONG_OTP_CODE {
+		ctx.IndentedJSON(http.StatusForbidden, types.MessageResponse{Message: "wrong two-factor authentication code"})
+		return
+	} else if resp.GetStatus() == pb_aap.RegenerateRecoveryCodesResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.RecoveryCodesResponse{RecoveryCodes: resp.GetRecoveryCodes()})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
@@ -2,11 +2,12 @@ package service
 
 import (
 	"net/http"
+	"net/url"
+	"path"
 	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 	"github.com/maxuanquang/social-network/internal/pkg/types"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -16,13 +17,14 @@ import (
 // CheckUserNamePassword checks user's authentication
 //
 //	@Summary		Check user's username and password
-//	@Description	check user's username and password
+//	@Description	check user's username and password, users with two-factor authentication get a challenge to complete at /users/login/two_factor
 //	@Tags			users
 //	@Accept			json
 //	@Produce		json
 //	@Param			request	body		types.LoginRequest	true	"Login parameters"
 //	@Success		200		{object}	types.LoginResponse
 //	@Failure		400		{object}	types.LoginResponse
+//	@Failure		429		{object}	types.LoginResponse
 //	@Failure		500		{object}	types.LoginResponse
 //	@Router			/users/login [post]
 func (svc *WebService) CheckUserAuthentication(ctx *gin.Context) {
@@ -57,6 +59,8 @@ func (svc *WebService) CheckUserAuthentication(ctx *gin.Context) {
 	resp, err := svc.authenticateAndPostClient.CheckUserAuthentication(ctx, &pb_aap.CheckUserAuthenticationRequest{
 		UserName:     jsonRequest.UserName,
 		UserPassword: jsonRequest.Password,
+		ClientIp:     ctx.ClientIP(),
+		OtpCode:      jsonRequest.OTPCode,
 	})
 	if err != nil {
 		httpStatus = http.StatusInternalServerError
@@ -74,38 +78,69 @@ func (svc *WebService) CheckUserAuthentication(ctx *gin.Context) {
 		end = time.Now()
 		ctx.IndentedJSON(http.StatusOK, types.LoginResponse{Message: "wrong username or password"})
 		return
-	} else if resp.GetStatus() == pb_aap.CheckUserAuthenticationResponse_OK {
-		// Set a sessionId for this session
-		sessionId := uuid.New().String()
-
-		// Save current sessionID and expiration time in Redis
-		svc.redisClient.Set(svc.redisClient.Context(), sessionId, resp.GetUser().GetUserId(), time.Minute*15)
-
-		// Set sessionID cookie
-		http.SetCookie(ctx.Writer, &http.Cookie{
-			Name:     "session_id",
-			Value:    sessionId,
-			MaxAge:   900,
-			Path:     "/",
-			Domain:   "",
-			SameSite: http.SameSiteNoneMode,
-			Secure:   true,
-			HttpOnly: true,
+	} else if resp.GetStatus() == pb_aap.CheckUserAuthenticationResponse_WRONG_OTP_CODE {
+		httpStatus = http.StatusOK
+		end = time.Now()
+		ctx.IndentedJSON(http.StatusOK, types.LoginResponse{Message: "wrong two-factor authentication code"})
+		return
+	} else if resp.GetStatus() == pb_aap.CheckUserAuthenticationResponse_TWO_FACTOR_REQUIRED {
+		// The session is started by CompleteTwoFactorLogin once the code is checked
+		challenge, err := svc.createLoginChallenge(resp.GetUserId())
+		if err != nil {
+			httpStatus = http.StatusInternalServerError
+			end = time.Now()
+			ctx.IndentedJSON(http.StatusInternalServerError, types.LoginResponse{Message: err.Error()})
+			return
+		}
+
+		httpStatus = http.StatusOK
+		end = time.Now()
+		ctx.IndentedJSON(http.StatusOK, types.LoginResponse{
+			Message:            "two-factor authentication required",
+			TwoFactorChallenge: challenge,
 		})
+		return
+	} else if resp.GetStatus() == pb_aap.CheckUserAuthenticationResponse_ACCOUNT_LOCKED {
+		svc.countReporter.WithLabelValues("check_user_login", "blocked").Inc()
+		setRetryAfter(ctx, resp.GetLockedUntil().AsTime())
+		httpStatus = http.StatusTooManyRequests
+		end = time.Now()
+		ctx.IndentedJSON(http.StatusTooManyRequests, types.LoginResponse{Message: "too many failed login attempts, try again later"})
+		return
+	} else if resp.GetStatus() == pb_aap.CheckUserAuthenticationResponse_OK {
+		// Start a new session
+		err = svc.createSession(ctx, resp.GetUser().GetUserId())
+		if err != nil {
+			httpStatus = http.StatusInternalServerError
+			end = time.Now()
+			ctx.IndentedJSON(http.StatusInternalServerError, types.LoginResponse{Message: err.Error()})
+			return
+		}
 
 		httpStatus = http.StatusOK
 		end = time.Now()
 		ctx.IndentedJSON(http.StatusOK, types.LoginResponse{
-			Message: "OK",
+			Message:             "OK",
+			DeletionScheduledAt: formatTimestamp(resp.GetDeletionScheduledAt()),
 			User: types.UserDetailInfo{
-				UserID:         resp.GetUser().GetUserId(),
-				UserName:       resp.GetUser().GetUserName(),
-				FirstName:      resp.GetUser().GetFirstName(),
-				LastName:       resp.GetUser().GetLastName(),
-				DateOfBirth:    resp.GetUser().GetDateOfBirth().AsTime().Format(time.DateOnly),
-				Email:          resp.GetUser().GetEmail(),
-				ProfilePicture: resp.GetUser().GetProfilePicture(),
-				CoverPicture:   resp.GetUser().GetCoverPicture(),
+				UserID:            resp.GetUser().GetUserId(),
+				UserName:          resp.GetUser().GetUserName(),
+				FirstName:         resp.GetUser().GetFirstName(),
+				LastName:          resp.GetUser().GetLastName(),
+				DateOfBirth:       resp.GetUser().GetDateOfBirth().AsTime().Format(time.DateOnly),
+				Email:             resp.GetUser().GetEmail(),
+				EmailVerified:     resp.GetUser().GetEmailVerified(),
+				ProfilePicture:    resp.GetUser().GetProfilePicture(),
+				CoverPicture:      resp.GetUser().GetCoverPicture(),
+				IsPrivate:         resp.GetUser().GetIsPrivate(),
+				FollowersCount:    resp.GetUser().GetFollowersCount(),
+				FollowingsCount:   resp.GetUser().GetFollowingsCount(),
+				PostsCount:        resp.GetUser().GetPostsCount(),
+				Bio:               resp.GetUser().GetBio(),
+				Location:          resp.GetUser().GetLocation(),
+				Website:           resp.GetUser().GetWebsite(),
+				Pronouns:          resp.GetUser().GetPronouns(),
+				ProfileVisibility: newProfileVisibilityResponse(resp.GetUser().GetProfileVisibility()),
 			}})
 		return
 	} else {
@@ -170,18 +205,20 @@ func (svc *WebService) CreateUser(ctx *gin.Context) {
 // EditUser edits user information
 //
 //	@Summary		edit user information
-//	@Description	edit user information
+//	@Description	edit user information, the user name can be changed once per cooldown and the previous one redirects to the user for a grace period
 //	@Tags			users
 //	@Accept			json
 //	@Produce		json
 //	@Param			request	body		types.EditUserRequest	true	"Edit user information parameters"
 //	@Success		200		{object}	types.MessageResponse
 //	@Failure		400		{object}	types.MessageResponse
+//	@Failure		403		{object}	types.MessageResponse
+//	@Failure		429		{object}	types.MessageResponse
 //	@Failure		500		{object}	types.MessageResponse
 //	@Router			/users/edit [put]
 func (svc *WebService) EditUser(ctx *gin.Context) {
 	// Check authorization
-	_, userId, err := svc.checkSessionAuthentication(ctx)
+	sessionId, userId, err := svc.checkSessionAuthentication(ctx)
 	if err != nil {
 		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
 		return
@@ -231,13 +268,21 @@ func (svc *WebService) EditUser(ctx *gin.Context) {
 
 	// Call EditUser service
 	resp, err := svc.authenticateAndPostClient.EditUser(ctx, &pb_aap.EditUserRequest{
-		UserId:         int64(userId),
-		UserPassword:   password,
-		FirstName:      firstName,
-		LastName:       lastName,
-		DateOfBirth:    dateOfBirth,
-		ProfilePicture: profilePicture,
-		CoverPicture:   coverPicture,
+		UserId:            int64(userId),
+		UserPassword:      password,
+		FirstName:         firstName,
+		LastName:          lastName,
+		DateOfBirth:       dateOfBirth,
+		ProfilePicture:    profilePicture,
+		CoverPicture:      coverPicture,
+		IsPrivate:         jsonRequest.IsPrivate,
+		OtpCode:           jsonRequest.OTPCode,
+		UserName:          jsonRequest.UserName,
+		Bio:               jsonRequest.Bio,
+		Location:          jsonRequest.Location,
+		Website:           jsonRequest.Website,
+		Pronouns:          jsonRequest.Pronouns,
+		ProfileVisibility: newProfileVisibilityRequest(jsonRequest.ProfileVisibility),
 	})
 	if err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
@@ -246,7 +291,25 @@ func (svc *WebService) EditUser(ctx *gin.Context) {
 	if resp.GetStatus() == pb_aap.EditUserResponse_USER_NOT_FOUND {
 		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
 		return
+	} else if resp.GetStatus() == pb_aap.EditUserResponse_TWO_FACTOR_REQUIRED {
+		ctx.IndentedJSON(http.StatusForbidden, types.MessageResponse{Message: "two-factor authentication code required"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditUserResponse_WRONG_OTP_CODE {
+		ctx.IndentedJSON(http.StatusForbidden, types.MessageResponse{Message: "wrong two-factor authentication code"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditUserResponse_USERNAME_EXISTED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "username existed"})
+		return
+	} else if resp.GetStatus() == pb_aap.EditUserResponse_USERNAME_CHANGE_TOO_SOON {
+		ctx.IndentedJSON(http.StatusTooManyRequests, types.MessageResponse{
+			Message: "username can be changed again at " + formatTimestamp(resp.GetNextUserNameChangeAt()),
+		})
+		return
 	} else if resp.GetStatus() == pb_aap.EditUserResponse_OK {
+		// Changing password logs out every other session
+		if password != nil {
+			svc.revokeUserSessions(int64(userId), sessionId)
+		}
 		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
 		return
 	} else {
@@ -258,7 +321,7 @@ func (svc *WebService) EditUser(ctx *gin.Context) {
 // GetUserDetailInfo gets user information
 //
 //	@Summary		get user information
-//	@Description	get user information
+//	@Description	get user information, profile fields such as email and date of birth are only returned to viewers allowed by their visibility
 //	@Tags			users
 //	@Accept			json
 //	@Produce		json
@@ -275,10 +338,101 @@ func (svc *WebService) GetUserDetailInfo(ctx *gin.Context) {
 		return
 	}
 
-	// Call gprc service
-	resp, err := svc.authenticateAndPostClient.GetUserDetailInfo(ctx, &pb_aap.GetUserDetailInfoRequest{
+	svc.getUserDetailInfo(ctx, &pb_aap.GetUserDetailInfoRequest{
 		UserId: int64(userId),
 	})
+}
+
+// GetUserDetailInfoByUserName gets user information by user name
+//
+//	@Summary		get user information by user name
+//	@Description	get user information by user name, used by profile URLs. Profile fields are filtered by their visibility like in /users/{user_id}. Previous user names redirect to the current one for a grace period.
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			user_name	path		string	true	"User name"
+//	@Success		200			{object}	types.UserDetailInfo
+//	@Success		302			{object}	types.MessageResponse
+//	@Failure		400			{object}	types.MessageResponse
+//	@Failure		500			{object}	types.MessageResponse
+//	@Router			/users/by-name/{user_name} [get]
+func (svc *WebService) GetUserDetailInfoByUserName(ctx *gin.Context) {
+	svc.getUserDetailInfo(ctx, &pb_aap.GetUserDetailInfoRequest{
+		UserName: ctx.Param("user_name"),
+	})
+}
+
+// SearchUsers autocompletes user names and display names
+//
+//	@Summary		search users
+//	@Description	search users whose user name, first name, last name or full name starts with the query, case insensitively
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			q		query		string	true	"Name prefix"
+//	@Param			limit	query		int		false	"Number of users"
+//	@Success		200		{object}	types.SearchUsersResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/users/search [get]
+func (svc *WebService) SearchUsers(ctx *gin.Context) {
+	// Check query params
+	query := ctx.Query("q")
+	if query == "" {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "missing query"})
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid limit"})
+		return
+	}
+
+	// Logged in viewers do not see users they blocked or who blocked them
+	_, viewerId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		viewerId = 0
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.SearchUsers(ctx, &pb_aap.SearchUsersRequest{
+		Query:    query,
+		Limit:    int32(limit),
+		ViewerId: int64(viewerId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.SearchUsersResponse_OK {
+		users := []types.UserSearchResult{}
+		for _, user := range resp.GetUsers() {
+			users = append(users, types.UserSearchResult{
+				UserID:         user.GetUserId(),
+				UserName:       user.GetUserName(),
+				FirstName:      user.GetFirstName(),
+				LastName:       user.GetLastName(),
+				ProfilePicture: user.GetProfilePicture(),
+			})
+		}
+		ctx.IndentedJSON(http.StatusOK, types.SearchUsersResponse{Users: users})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// getUserDetailInfo calls GetUserDetailInfo grpc service and writes the user information
+func (svc *WebService) getUserDetailInfo(ctx *gin.Context, request *pb_aap.GetUserDetailInfoRequest) {
+	// Profile fields are filtered by their visibility for the viewer
+	_, viewerId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		viewerId = 0
+	}
+	request.ViewerId = int64(viewerId)
+
+	resp, err := svc.authenticateAndPostClient.GetUserDetailInfo(ctx, request)
 	if err != nil {
 		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
 		return
@@ -287,15 +441,30 @@ func (svc *WebService) GetUserDetailInfo(ctx *gin.Context) {
 		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
 		return
 	} else if resp.GetStatus() == pb_aap.GetUserDetailInfoResponse_OK {
+		// Previous user names redirect to the current one during their grace period
+		if request.GetUserName() != "" && request.GetUserName() != resp.GetUser().GetUserName() {
+			ctx.Redirect(http.StatusFound, path.Join(path.Dir(ctx.Request.URL.Path), url.PathEscape(resp.GetUser().GetUserName())))
+			return
+		}
 		ctx.IndentedJSON(http.StatusAccepted, types.UserDetailInfo{
-			UserID:         resp.GetUser().GetUserId(),
-			UserName:       resp.GetUser().GetUserName(),
-			FirstName:      resp.GetUser().GetFirstName(),
-			LastName:       resp.GetUser().GetLastName(),
-			DateOfBirth:    resp.GetUser().GetDateOfBirth().AsTime().Format(time.DateOnly),
-			Email:          resp.GetUser().GetEmail(),
-			ProfilePicture: resp.GetUser().GetProfilePicture(),
-			CoverPicture:   resp.GetUser().GetCoverPicture(),
+			UserID:            resp.GetUser().GetUserId(),
+			UserName:          resp.GetUser().GetUserName(),
+			FirstName:         resp.GetUser().GetFirstName(),
+			LastName:          resp.GetUser().GetLastName(),
+			DateOfBirth:       formatDate(resp.GetUser().GetDateOfBirth()),
+			Email:             resp.GetUser().GetEmail(),
+			EmailVerified:     resp.GetUser().GetEmailVerified(),
+			ProfilePicture:    resp.GetUser().GetProfilePicture(),
+			CoverPicture:      resp.GetUser().GetCoverPicture(),
+			IsPrivate:         resp.GetUser().GetIsPrivate(),
+			FollowersCount:    resp.GetUser().GetFollowersCount(),
+			FollowingsCount:   resp.GetUser().GetFollowingsCount(),
+			PostsCount:        resp.GetUser().GetPostsCount(),
+			Bio:               resp.GetUser().GetBio(),
+			Location:          resp.GetUser().GetLocation(),
+			Website:           resp.GetUser().GetWebsite(),
+			Pronouns:          resp.GetUser().GetPronouns(),
+			ProfileVisibility: newProfileVisibilityResponse(resp.GetUser().GetProfileVisibility()),
 		})
 		return
 	} else {
@@ -304,16 +473,326 @@ func (svc *WebService) GetUserDetailInfo(ctx *gin.Context) {
 	}
 }
 
-func (svc *WebService) checkSessionAuthentication(ctx *gin.Context) (sessionId string, userId int, err error) {
-	sessionId, err = ctx.Cookie("session_id")
+// SendVerificationEmail sends another verification email
+//
+//	@Summary		send verification email
+//	@Description	send a new email verification link to user's email
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	types.MessageResponse
+//	@Failure		400	{object}	types.MessageResponse
+//	@Failure		500	{object}	types.MessageResponse
+//	@Router			/users/verify_email [post]
+func (svc *WebService) SendVerificationEmail(ctx *gin.Context) {
+	// Check session
+	_, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.SendVerificationEmail(ctx, &pb_aap.SendVerificationEmailRequest{
+		UserId: int64(userId),
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.SendVerificationEmailResponse_USER_NOT_FOUND {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "user not found"})
+		return
+	} else if resp.GetStatus() == pb_aap.SendVerificationEmailResponse_ALREADY_VERIFIED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "email already verified"})
+		return
+	} else if resp.GetStatus() == pb_aap.SendVerificationEmailResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// VerifyEmail verifies user's email with the token sent by email
+//
+//	@Summary		verify email
+//	@Description	verify user's email with the token from verification link
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			token	query		string	true	"Verification token"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/users/verify_email [get]
+func (svc *WebService) VerifyEmail(ctx *gin.Context) {
+	// Check query params
+	token := ctx.Query("token")
+	if token == "" {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid token"})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.VerifyEmail(ctx, &pb_aap.VerifyEmailRequest{
+		Token: token,
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.VerifyEmailResponse_INVALID_TOKEN {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid token"})
+		return
+	} else if resp.GetStatus() == pb_aap.VerifyEmailResponse_ALREADY_VERIFIED {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "email already verified"})
+		return
+	} else if resp.GetStatus() == pb_aap.VerifyEmailResponse_OK {
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// RequestPasswordReset sends a password reset token to user's email
+//
+//	@Summary		request password reset
+//	@Description	send a password reset token to the email, the response does not tell if the email exists
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		types.RequestPasswordResetRequest	true	"Request password reset parameters"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/users/password_reset [post]
+func (svc *WebService) RequestPasswordReset(ctx *gin.Context) {
+	// Validate request
+	var jsonRequest types.RequestPasswordResetRequest
+	err := ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.RequestPasswordReset(ctx, &pb_aap.RequestPasswordResetRequest{
+		Email: jsonRequest.Email,
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.RequestPasswordResetResponse_USER_NOT_FOUND || resp.GetStatus() == pb_aap.RequestPasswordResetResponse_OK {
+		// Unknown emails get the same response to avoid disclosing registered emails
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// ConfirmPasswordReset sets a new password with a password reset token
+//
+//	@Summary		confirm password reset
+//	@Description	set a new password with the token sent by email, all sessions of the user are logged out
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		types.ConfirmPasswordResetRequest	true	"Confirm password reset parameters"
+//	@Success		200		{object}	types.MessageResponse
+//	@Failure		400		{object}	types.MessageResponse
+//	@Failure		500		{object}	types.MessageResponse
+//	@Router			/users/password_reset/confirm [post]
+func (svc *WebService) ConfirmPasswordReset(ctx *gin.Context) {
+	// Validate request
+	var jsonRequest types.ConfirmPasswordResetRequest
+	err := ctx.ShouldBindJSON(&jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	err = validate.Struct(jsonRequest)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Call grpc service
+	resp, err := svc.authenticateAndPostClient.ConfirmPasswordReset(ctx, &pb_aap.ConfirmPasswordResetRequest{
+		Token:       jsonRequest.Token,
+		NewPassword: jsonRequest.Password,
+	})
+	if err != nil {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
+	}
+	if resp.GetStatus() == pb_aap.ConfirmPasswordResetResponse_INVALID_TOKEN {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "invalid token"})
+		return
+	} else if resp.GetStatus() == pb_aap.ConfirmPasswordResetResponse_OK {
+		svc.revokeUserSessions(resp.GetUserId())
+		ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+		return
+	} else {
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: "unknown error"})
+		return
+	}
+}
+
+// Logout logs out current session
+//
+//	@Summary		logout
+//	@Description	log out current session
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	types.MessageResponse
+//	@Failure		401	{object}	types.MessageResponse
+//	@Router			/users/logout [post]
+func (svc *WebService) Logout(ctx *gin.Context) {
+	// Check session
+	sessionId, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Bearer tokens have no session, they are revoked through their refresh token
+	if sessionId != "" {
+		svc.revokeSession(int64(userId), sessionId)
+	}
+	svc.clearSessionCookie(ctx)
+	ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+}
+
+// ListSessions lists active sessions of user
+//
+//	@Summary		list sessions
+//	@Description	list active sessions of user, most recently used first
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	types.SessionsResponse
+//	@Failure		401	{object}	types.MessageResponse
+//	@Failure		500	{object}	types.MessageResponse
+//	@Router			/users/sessions [get]
+func (svc *WebService) ListSessions(ctx *gin.Context) {
+	// Check session
+	sessionId, userId, err := svc.checkSessionAuthentication(ctx)
 	if err != nil {
-		return "", 0, err
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
 	}
 
-	userId, err = svc.redisClient.Get(svc.redisClient.Context(), sessionId).Int()
+	sessions, err := svc.listUserSessions(int64(userId))
 	if err != nil {
-		return "", 0, err
+		ctx.IndentedJSON(http.StatusInternalServerError, types.MessageResponse{Message: err.Error()})
+		return
 	}
 
-	return sessionId, userId, nil
+	resp := types.SessionsResponse{Sessions: []types.SessionResponse{}}
+	for _, s := range sessions {
+		resp.Sessions = append(resp.Sessions, types.SessionResponse{
+			SessionID: s.ID,
+			CreatedAt: s.CreatedAt.In(time.Local).Format(time.DateTime),
+			LastSeen:  s.LastSeen.In(time.Local).Format(time.DateTime),
+			IP:        s.IP,
+			UserAgent: s.UserAgent,
+			Current:   s.ID == sessionId,
+		})
+	}
+	ctx.IndentedJSON(http.StatusOK, resp)
+}
+
+// RevokeSession logs out a session of user
+//
+//	@Summary		revoke session
+//	@Description	log out a session of user
+//	@Tags			users
+//	@Accept			json
+//	@Produce		json
+//	@Param			session_id	path		string	true	"Session ID"
+//	@Success		200			{object}	types.MessageResponse
+//	@Failure		400			{object}	types.MessageResponse
+//	@Failure		401			{object}	types.MessageResponse
+//	@Router			/users/sessions/{session_id} [delete]
+func (svc *WebService) RevokeSession(ctx *gin.Context) {
+	// Check session
+	currentSessionId, userId, err := svc.checkSessionAuthentication(ctx)
+	if err != nil {
+		ctx.IndentedJSON(http.StatusUnauthorized, types.MessageResponse{Message: err.Error()})
+		return
+	}
+
+	// Only sessions of the user can be revoked
+	sessionId := ctx.Param("session_id")
+	s, err := svc.getSession(sessionId)
+	if err != nil || s.UserID != int64(userId) {
+		ctx.IndentedJSON(http.StatusBadRequest, types.MessageResponse{Message: "session not found"})
+		return
+	}
+
+	svc.revokeSession(int64(userId), sessionId)
+	if sessionId == currentSessionId {
+		svc.clearSessionCookie(ctx)
+	}
+	ctx.IndentedJSON(http.StatusOK, types.MessageResponse{Message: "OK"})
+}
+
+var visibilitiesToProto = map[string]pb_aap.ProfileVisibility_Visibility{
+	"public":    pb_aap.ProfileVisibility_PUBLIC,
+	"followers": pb_aap.ProfileVisibility_FOLLOWERS,
+	"only_me":   pb_aap.ProfileVisibility_ONLY_ME,
+}
+
+var visibilitiesFromProto = map[pb_aap.ProfileVisibility_Visibility]string{
+	pb_aap.ProfileVisibility_PUBLIC:    "public",
+	pb_aap.ProfileVisibility_FOLLOWERS: "followers",
+	pb_aap.ProfileVisibility_ONLY_ME:   "only_me",
+}
+
+func newProfileVisibilityRequest(request *types.ProfileVisibilityRequest) *pb_aap.ProfileVisibility {
+	if request == nil {
+		return nil
+	}
+	toProto := func(visibility *string) *pb_aap.ProfileVisibility_Visibility {
+		if visibility == nil {
+			return nil
+		}
+		protoVisibility := visibilitiesToProto[*visibility]
+		return &protoVisibility
+	}
+	return &pb_aap.ProfileVisibility{
+		Email:       toProto(request.Email),
+		DateOfBirth: toProto(request.DateOfBirth),
+		Bio:         toProto(request.Bio),
+		Location:    toProto(request.Location),
+		Website:     toProto(request.Website),
+		Pronouns:    toProto(request.Pronouns),
+	}
+}
+
+func newProfileVisibilityResponse(visibility *pb_aap.ProfileVisibility) *types.ProfileVisibility {
+	if visibility == nil {
+		return nil
+	}
+	return &types.ProfileVisibility{
+		Email:       visibilitiesFromProto[visibility.GetEmail()],
+		DateOfBirth: visibilitiesFromProto[visibility.GetDateOfBirth()],
+		Bio:         visibilitiesFromProto[visibility.GetBio()],
+		Location:    visibilitiesFromProto[visibility.GetLocation()],
+		Website:     visibilitiesFromProto[visibility.GetWebsite()],
+		Pronouns:    visibilitiesFromProto[visibility.GetPronouns()],
+	}
 }
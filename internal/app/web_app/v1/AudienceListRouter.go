@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/maxuanquang/social-network/internal/app/web_app/service"
+)
+
+// AddAudienceListRouter adds audience list-related routes to input router
+func AddAudienceListRouter(r *gin.RouterGroup, svc *service.WebService) {
+	audienceListRouter := r.Group("audience_lists")
+	audienceListRouter.GET("", svc.GetAudienceLists)
+	audienceListRouter.POST("", svc.CreateAudienceList)
+	audienceListRouter.GET(":list_id", svc.GetAudienceList)
+	audienceListRouter.DELETE(":list_id", svc.DeleteAudienceList)
+	audienceListRouter.POST(":list_id/members/:user_id", svc.AddAudienceListMember)
+	audienceListRouter.DELETE(":list_id/members/:user_id", svc.RemoveAudienceListMember)
+}
@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/maxuanquang/social-network/internal/app/web_app/service"
+)
+
+// AddBlockRouter adds block and mute routes to input router
+func AddBlockRouter(r *gin.RouterGroup, svc *service.WebService) {
+	blockRouter := r.Group("blocks")
+	blockRouter.POST(":user_id", svc.BlockUser)
+	blockRouter.DELETE(":user_id", svc.UnblockUser)
+
+	muteRouter := r.Group("mutes")
+	muteRouter.POST(":user_id", svc.MuteUser)
+	muteRouter.DELETE(":user_id", svc.UnmuteUser)
+}
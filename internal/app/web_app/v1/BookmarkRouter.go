@@ -0,0 +1,15 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/maxuanquang/social-network/internal/app/web_app/service"
+)
+
+// AddBookmarkRouter adds bookmark-related routes to input router
+func AddBookmarkRouter(r *gin.RouterGroup, svc *service.WebService) {
+	bookmarkRouter := r.Group("bookmarks")
+
+	bookmarkRouter.GET("", svc.ListSavedPosts)
+	bookmarkRouter.POST(":post_id", svc.SavePost)
+	bookmarkRouter.DELETE(":post_id", svc.UnsavePost)
+}
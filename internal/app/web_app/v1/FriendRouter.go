@@ -13,4 +13,9 @@ func AddFriendRouter(r *gin.RouterGroup, svc *service.WebService) {
 	friendRouter.POST(":following_id", svc.FollowUser)
 	friendRouter.DELETE(":following_id", svc.UnfollowUser)
 	friendRouter.GET(":user_id/posts", svc.GetUserPosts)
+	friendRouter.GET("requests", svc.GetFollowRequests)
+	friendRouter.POST("requests/:user_id", svc.ApproveFollowRequest)
+	friendRouter.DELETE("requests/:user_id", svc.RejectFollowRequest)
+	friendRouter.GET("relationships", svc.GetRelationships)
+	friendRouter.GET("suggestions", svc.GetFollowSuggestions)
 }
@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/maxuanquang/social-network/internal/app/web_app/service"
+)
+
+// AddMessageRouter adds direct message routes to input router
+func AddMessageRouter(r *gin.RouterGroup, svc *service.WebService) {
+	messageRouter := r.Group("messages")
+	messageRouter.POST("", svc.SendMessage)
+	messageRouter.DELETE(":message_id", svc.DeleteMessage)
+
+	conversationRouter := r.Group("conversations")
+	conversationRouter.GET("", svc.GetConversations)
+	conversationRouter.GET(":conversation_id/messages", svc.GetMessages)
+	conversationRouter.POST(":conversation_id/read", svc.MarkConversationRead)
+	conversationRouter.DELETE(":conversation_id", svc.DeleteConversation)
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/maxuanquang/social-network/internal/app/web_app/service"
+	"github.com/maxuanquang/social-network/internal/auth"
+)
+
+// AddOAuthRouter adds OAuth2 authorization server routes to input router
+func AddOAuthRouter(r *gin.RouterGroup, svc *service.WebService) {
+	oauthRouter := r.Group("oauth")
+
+	// Third-party apps must not manage clients or approve other clients
+	oauthRouter.GET("clients", svc.RequireScope(auth.ScopeAdmin), svc.ListOAuthClients)
+	oauthRouter.POST("clients", svc.RequireScope(auth.ScopeAdmin), svc.CreateOAuthClient)
+	oauthRouter.DELETE("clients/:client_id", svc.RequireScope(auth.ScopeAdmin), svc.DeleteOAuthClient)
+	oauthRouter.GET("authorize", svc.RequireScope(auth.ScopeAdmin), svc.GetOAuthAuthorization)
+	oauthRouter.POST("authorize", svc.RequireScope(auth.ScopeAdmin), svc.AuthorizeOAuthClient)
+
+	oauthRouter.POST("token", svc.IssueOAuthToken)
+	oauthRouter.POST("revoke", svc.RevokeOAuthToken)
+}
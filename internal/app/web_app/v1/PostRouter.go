@@ -17,4 +17,9 @@ func AddPostRouter(r *gin.RouterGroup, svc *service.WebService) {
 
 	postRouter.POST(":post_id/comments", svc.CommentPost)
 	postRouter.POST(":post_id/likes", svc.LikePost)
+	postRouter.POST(":post_id/pin", svc.PinPost)
+	postRouter.DELETE(":post_id/pin", svc.UnpinPost)
+
+	postRouter.GET(":post_id/poll", svc.GetPollResults)
+	postRouter.POST(":post_id/poll/votes", svc.VotePoll)
 }
@@ -6,8 +6,16 @@ import (
 )
 
 func AddAllRouter(r *gin.RouterGroup, svc *service.WebService) {
+	r.Use(svc.CheckScopes())
+
 	AddUserRouter(r, svc)
 	AddFriendRouter(r, svc)
+	AddBlockRouter(r, svc)
+	AddAudienceListRouter(r, svc)
 	AddPostRouter(r, svc)
 	AddNewsfeedRouter(r, svc)
+	AddBookmarkRouter(r, svc)
+	AddStoryRouter(r, svc)
+	AddMessageRouter(r, svc)
+	AddOAuthRouter(r, svc)
 }
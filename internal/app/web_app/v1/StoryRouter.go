@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/maxuanquang/social-network/internal/app/web_app/service"
+)
+
+// AddStoryRouter adds story-related routes to input router
+func AddStoryRouter(r *gin.RouterGroup, svc *service.WebService) {
+	storyRouter := r.Group("stories")
+
+	storyRouter.GET("", svc.GetStoriesTray)
+	storyRouter.POST("", svc.CreateStory)
+	storyRouter.GET(":story_id", svc.GetStory)
+	storyRouter.DELETE(":story_id", svc.DeleteStory)
+	storyRouter.GET(":story_id/viewers", svc.GetStoryViewers)
+}
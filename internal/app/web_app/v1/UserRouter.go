@@ -3,6 +3,7 @@ package v1
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/maxuanquang/social-network/internal/app/web_app/service"
+	"github.com/maxuanquang/social-network/internal/auth"
 )
 
 // AddUserRouter adds user-related routes to input router
@@ -10,6 +11,27 @@ func AddUserRouter(r *gin.RouterGroup, svc *service.WebService) {
 	userRouter := r.Group("users")
 	userRouter.POST("signup", svc.CreateUser)
 	userRouter.POST("login", svc.CheckUserAuthentication)
-	userRouter.POST("edit", svc.EditUser)
+	userRouter.POST("login/two_factor", svc.CompleteTwoFactorLogin)
+	userRouter.POST("token", svc.IssueToken)
+	userRouter.POST("token/refresh", svc.RefreshToken)
+	userRouter.POST("token/revoke", svc.RevokeToken)
+	userRouter.POST("logout", svc.Logout)
+	userRouter.GET("sessions", svc.RequireScope(auth.ScopeAdmin), svc.ListSessions)
+	userRouter.DELETE("sessions/:session_id", svc.RequireScope(auth.ScopeAdmin), svc.RevokeSession)
+	userRouter.POST("edit", svc.RequireScope(auth.ScopeAdmin), svc.EditUser)
+	userRouter.POST("delete", svc.RequireScope(auth.ScopeAdmin), svc.DeleteUser)
+	userRouter.POST("delete/cancel", svc.RequireScope(auth.ScopeAdmin), svc.CancelUserDeletion)
+	userRouter.POST("export", svc.RequireScope(auth.ScopeAdmin), svc.RequestDataExport)
+	userRouter.GET("export", svc.RequireScope(auth.ScopeAdmin), svc.GetDataExport)
+	userRouter.GET("verify_email", svc.VerifyEmail)
+	userRouter.POST("verify_email", svc.SendVerificationEmail)
+	userRouter.POST("password_reset", svc.RequestPasswordReset)
+	userRouter.POST("password_reset/confirm", svc.ConfirmPasswordReset)
+	userRouter.POST("two_factor/enroll", svc.RequireScope(auth.ScopeAdmin), svc.EnrollTwoFactor)
+	userRouter.POST("two_factor/enable", svc.RequireScope(auth.ScopeAdmin), svc.EnableTwoFactor)
+	userRouter.POST("two_factor/disable", svc.RequireScope(auth.ScopeAdmin), svc.DisableTwoFactor)
+	userRouter.POST("two_factor/recovery_codes", svc.RequireScope(auth.ScopeAdmin), svc.RegenerateRecoveryCodes)
+	userRouter.GET("search", svc.SearchUsers)
+	userRouter.GET("by-name/:user_name", svc.GetUserDetailInfoByUserName)
 	userRouter.GET(":user_id", svc.GetUserDetailInfo)
 }
@@ -2,38 +2,175 @@ package auth
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
 	"html"
 	"strings"
 
+	"github.com/maxuanquang/social-network/configs"
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
 )
 
-// HashPassword hashes the input password
-func HashPassword(password string, salt []byte) (string, error) {
-	// Convert password string to byte slice
-	var passwordBytes = []byte(password)
+const (
+	AlgorithmArgon2id     = "argon2id"
+	AlgorithmBcryptSHA256 = "bcrypt-sha256"
 
-	// Append salt to password
-	passwordBytes = append(passwordBytes, salt...)
+	defaultArgon2Memory      = 64 * 1024
+	defaultArgon2Iterations  = 3
+	defaultArgon2Parallelism = 2
+	defaultBcryptCost        = 12
 
-	// Get the bcrypt hashed password
-	hashedPasswordBytes, err := bcrypt.GenerateFromPassword(passwordBytes, 4)
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+)
+
+var ErrUnknownHashFormat = errors.New("unknown password hash format")
+
+// PasswordHasher hashes passwords with the configured algorithm.
+// Hashes are encoded with their algorithm and parameters, so hashes made with other
+// parameters can still be verified and are reported as needing a rehash.
+//
+// Supported formats:
+//
+//	$argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<key>
+//	$bcrypt-sha256$<bcrypt hash of base64 encoded sha256 of password>
+//	<bcrypt hash of password with salt appended>, the legacy format verified with the user's salt
+type PasswordHasher struct {
+	algorithm         string
+	argon2Memory      uint32
+	argon2Iterations  uint32
+	argon2Parallelism uint8
+	bcryptCost        int
+}
+
+func NewPasswordHasher(cfg *configs.PasswordHashingConfig) (*PasswordHasher, error) {
+	h := &PasswordHasher{
+		algorithm:         cfg.Algorithm,
+		argon2Memory:      cfg.Argon2.Memory,
+		argon2Iterations:  cfg.Argon2.Iterations,
+		argon2Parallelism: cfg.Argon2.Parallelism,
+		bcryptCost:        cfg.BcryptCost,
+	}
+	if h.algorithm == "" {
+		h.algorithm = AlgorithmArgon2id
+	}
+	if h.algorithm != AlgorithmArgon2id && h.algorithm != AlgorithmBcryptSHA256 {
+		return nil, fmt.Errorf("unknown password hashing algorithm %q", h.algorithm)
+	}
+	if h.argon2Memory == 0 {
+		h.argon2Memory = defaultArgon2Memory
+	}
+	if h.argon2Iterations == 0 {
+		h.argon2Iterations = defaultArgon2Iterations
+	}
+	if h.argon2Parallelism == 0 {
+		h.argon2Parallelism = defaultArgon2Parallelism
+	}
+	if h.bcryptCost == 0 {
+		h.bcryptCost = defaultBcryptCost
+	}
+	if h.bcryptCost < bcrypt.MinCost || h.bcryptCost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("invalid bcrypt cost %d", h.bcryptCost)
+	}
+	return h, nil
+}
+
+// Hash hashes the input password with the configured algorithm
+func (h *PasswordHasher) Hash(password string) (string, error) {
+	if h.algorithm == AlgorithmBcryptSHA256 {
+		hashed, err := bcrypt.GenerateFromPassword(prehash(password), h.bcryptCost)
+		if err != nil {
+			return "", err
+		}
+		return "$" + AlgorithmBcryptSHA256 + string(hashed), nil
+	}
+
+	salt := make([]byte, argon2SaltLength)
+	_, err := rand.Read(salt)
 	if err != nil {
 		return "", err
 	}
-
-	return string(hashedPasswordBytes), err
+	key := argon2.IDKey([]byte(password), salt, h.argon2Iterations, h.argon2Memory, h.argon2Parallelism, argon2KeyLength)
+	return fmt.Sprintf("$%s$v=%d$m=%d,t=%d,p=%d$%s$%s", AlgorithmArgon2id, argon2.Version,
+		h.argon2Memory, h.argon2Iterations, h.argon2Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
 }
 
-// CheckPasswordHash checks if hashed password matches raw password
-func CheckPasswordHash(hashedPassword, password string, salt []byte) error {
-	// Convert password string to byte slice
-	var passwordBytes = []byte(password)
+// Verify checks if hashed password matches raw password. legacySalt is only used by legacy hashes.
+// needsRehash is true when the password matches but the hash was not made with the current configuration.
+func (h *PasswordHasher) Verify(hashedPassword, password string, legacySalt []byte) (match bool, needsRehash bool, err error) {
+	switch {
+	case strings.HasPrefix(hashedPassword, "$"+AlgorithmArgon2id+"$"):
+		var version int
+		var memory, iterations uint32
+		var parallelism uint8
+		parts := strings.Split(hashedPassword, "$")
+		if len(parts) != 6 {
+			return false, false, ErrUnknownHashFormat
+		}
+		_, err = fmt.Sscanf(parts[2], "v=%d", &version)
+		if err != nil || version != argon2.Version {
+			return false, false, ErrUnknownHashFormat
+		}
+		_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &iterations, &parallelism)
+		if err != nil {
+			return false, false, ErrUnknownHashFormat
+		}
+		salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+		if err != nil {
+			return false, false, ErrUnknownHashFormat
+		}
+		key, err := base64.RawStdEncoding.DecodeString(parts[5])
+		if err != nil {
+			return false, false, ErrUnknownHashFormat
+		}
+
+		otherKey := argon2.IDKey([]byte(password), salt, iterations, memory, parallelism, uint32(len(key)))
+		if subtle.ConstantTimeCompare(key, otherKey) != 1 {
+			return false, false, nil
+		}
+		needsRehash = h.algorithm != AlgorithmArgon2id || memory != h.argon2Memory ||
+			iterations != h.argon2Iterations || parallelism != h.argon2Parallelism
+		return true, needsRehash, nil
 
-	// Append salt to password
-	passwordBytes = append(passwordBytes, salt...)
+	case strings.HasPrefix(hashedPassword, "$"+AlgorithmBcryptSHA256+"$"):
+		hashed := []byte(strings.TrimPrefix(hashedPassword, "$"+AlgorithmBcryptSHA256))
+		err = bcrypt.CompareHashAndPassword(hashed, prehash(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, false, nil
+		} else if err != nil {
+			return false, false, err
+		}
+		cost, err := bcrypt.Cost(hashed)
+		if err != nil {
+			return false, false, err
+		}
+		return true, h.algorithm != AlgorithmBcryptSHA256 || cost != h.bcryptCost, nil
+
+	case strings.HasPrefix(hashedPassword, "$2"):
+		// Legacy hash: bcrypt of password with salt appended
+		passwordBytes := append([]byte(password), legacySalt...)
+		err = bcrypt.CompareHashAndPassword([]byte(hashedPassword), passwordBytes)
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, false, nil
+		} else if err != nil {
+			return false, false, err
+		}
+		return true, true, nil
+
+	default:
+		return false, false, ErrUnknownHashFormat
+	}
+}
 
-	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), passwordBytes)
+// prehash makes passwords longer than 72 bytes usable with bcrypt, which ignores the following bytes
+func prehash(password string) []byte {
+	sum := sha256.Sum256([]byte(password))
+	return []byte(base64.StdEncoding.EncodeToString(sum[:]))
 }
 
 // Santinize removes redundant spaces and encodes some special characters which helps to avoid SQL injection
@@ -41,17 +178,3 @@ func Santinize(data string) string {
 	data = html.EscapeString(strings.TrimSpace(data))
 	return data
 }
-
-// Generate 4 bytes randomly and securely using the
-// Cryptographically secure pseudorandom number generator (CSPRNG)
-// in the crypto.rand package
-func GenerateRandomSalt() ([]byte, error) {
-	salt := make([]byte, 4)
-
-	_, err := rand.Read(salt[:])
-	if err != nil {
-		return []byte("error"), err
-	}
-
-	return salt, nil
-}
@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/maxuanquang/social-network/configs"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Small parameters keep the tests fast
+var (
+	testArgon2Config = configs.PasswordHashingConfig{
+		Algorithm:  AlgorithmArgon2id,
+		Argon2:     configs.Argon2Config{Memory: 1024, Iterations: 1, Parallelism: 1},
+		BcryptCost: bcrypt.MinCost,
+	}
+	testBcryptConfig = configs.PasswordHashingConfig{
+		Algorithm:  AlgorithmBcryptSHA256,
+		Argon2:     configs.Argon2Config{Memory: 1024, Iterations: 1, Parallelism: 1},
+		BcryptCost: bcrypt.MinCost,
+	}
+)
+
+func newTestHasher(t *testing.T, cfg configs.PasswordHashingConfig) *PasswordHasher {
+	t.Helper()
+	h, err := NewPasswordHasher(&cfg)
+	if err != nil {
+		t.Fatalf("NewPasswordHasher() error = %v", err)
+	}
+	return h
+}
+
+func TestNewPasswordHasher(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     configs.PasswordHashingConfig
+		wantErr bool
+	}{
+		{"defaults", configs.PasswordHashingConfig{}, false},
+		{"argon2id", testArgon2Config, false},
+		{"bcrypt-sha256", testBcryptConfig, false},
+		{"unknown algorithm", configs.PasswordHashingConfig{Algorithm: "md5"}, true},
+		{"bcrypt cost too low", configs.PasswordHashingConfig{BcryptCost: 1}, true},
+		{"bcrypt cost too high", configs.PasswordHashingConfig{BcryptCost: bcrypt.MaxCost + 1}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewPasswordHasher(&tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewPasswordHasher() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPasswordHasherHashAndVerify(t *testing.T) {
+	longPassword := strings.Repeat("a", 100)
+	tests := []struct {
+		name     string
+		cfg      configs.PasswordHashingConfig
+		prefix   string
+		password string
+	}{
+		{"argon2id", testArgon2Config, "$argon2id$v=19$m=1024,t=1,p=1$", "correct horse battery staple"},
+		{"bcrypt-sha256", testBcryptConfig, "$bcrypt-sha256$2a$04$", "correct horse battery staple"},
+		{"bcrypt-sha256 long password", testBcryptConfig, "$bcrypt-sha256$", longPassword},
+		{"argon2id empty password", testArgon2Config, "$argon2id$", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHasher(t, tt.cfg)
+			hashed, err := h.Hash(tt.password)
+			if err != nil {
+				t.Fatalf("Hash() error = %v", err)
+			}
+			if !strings.HasPrefix(hashed, tt.prefix) {
+				t.Errorf("Hash() = %q, want prefix %q", hashed, tt.prefix)
+			}
+
+			match, needsRehash, err := h.Verify(hashed, tt.password, nil)
+			if err != nil || !match || needsRehash {
+				t.Errorf("Verify(correct) = %v, %v, %v, want true, false, nil", match, needsRehash, err)
+			}
+			match, _, err = h.Verify(hashed, tt.password+"x", nil)
+			if err != nil || match {
+				t.Errorf("Verify(wrong) = %v, %v, want false, nil", match, err)
+			}
+
+			// Salts are random
+			other, err := h.Hash(tt.password)
+			if err != nil {
+				t.Fatalf("Hash() error = %v", err)
+			}
+			if other == hashed {
+				t.Error("Hash() returned the same hash twice")
+			}
+		})
+	}
+}
+
+func TestPasswordHasherBcryptSHA256UsesWholePassword(t *testing.T) {
+	// Plain bcrypt ignores bytes after the 72nd
+	h := newTestHasher(t, testBcryptConfig)
+	prefix := strings.Repeat("a", 72)
+	hashed, err := h.Hash(prefix + "1")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+	match, _, err := h.Verify(hashed, prefix+"2", nil)
+	if err != nil || match {
+		t.Errorf("Verify() = %v, %v, want false, nil", match, err)
+	}
+}
+
+func TestPasswordHasherNeedsRehash(t *testing.T) {
+	argon2Hasher := newTestHasher(t, testArgon2Config)
+	bcryptHasher := newTestHasher(t, testBcryptConfig)
+	strongerArgon2Config := testArgon2Config
+	strongerArgon2Config.Argon2.Iterations = 2
+	strongerArgon2Hasher := newTestHasher(t, strongerArgon2Config)
+	strongerBcryptConfig := testBcryptConfig
+	strongerBcryptConfig.BcryptCost = bcrypt.MinCost + 1
+	strongerBcryptHasher := newTestHasher(t, strongerBcryptConfig)
+
+	argon2Hash, err := argon2Hasher.Hash("password")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+	bcryptHash, err := bcryptHasher.Hash("password")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+	salt := []byte("salt")
+	legacy, err := bcrypt.GenerateFromPassword(append([]byte("password"), salt...), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("GenerateFromPassword() error = %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		hasher          *PasswordHasher
+		hashed          string
+		salt            []byte
+		wantNeedsRehash bool
+	}{
+		{"same argon2 parameters", argon2Hasher, argon2Hash, nil, false},
+		{"other argon2 parameters", strongerArgon2Hasher, argon2Hash, nil, true},
+		{"argon2 hash with bcrypt configured", bcryptHasher, argon2Hash, nil, true},
+		{"same bcrypt cost", bcryptHasher, bcryptHash, nil, false},
+		{"other bcrypt cost", strongerBcryptHasher, bcryptHash, nil, true},
+		{"bcrypt hash with argon2 configured", argon2Hasher, bcryptHash, nil, true},
+		{"legacy hash", argon2Hasher, string(legacy), salt, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, needsRehash, err := tt.hasher.Verify(tt.hashed, "password", tt.salt)
+			if err != nil || !match {
+				t.Fatalf("Verify() = %v, %v, want true, nil", match, err)
+			}
+			if needsRehash != tt.wantNeedsRehash {
+				t.Errorf("Verify() needsRehash = %v, want %v", needsRehash, tt.wantNeedsRehash)
+			}
+		})
+	}
+}
+
+func TestPasswordHasherVerifyLegacyWrongSalt(t *testing.T) {
+	h := newTestHasher(t, testArgon2Config)
+	legacy, err := bcrypt.GenerateFromPassword([]byte("passwordsalt"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("GenerateFromPassword() error = %v", err)
+	}
+	match, _, err := h.Verify(string(legacy), "password", []byte("other"))
+	if err != nil || match {
+		t.Errorf("Verify() = %v, %v, want false, nil", match, err)
+	}
+}
+
+func TestPasswordHasherVerifyMalformed(t *testing.T) {
+	h := newTestHasher(t, testArgon2Config)
+	tests := []struct {
+		name   string
+		hashed string
+	}{
+		{"empty", ""},
+		{"plain text", "password"},
+		{"unknown algorithm", "$scrypt$abc"},
+		{"argon2 missing parts", "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA"},
+		{"argon2 other version", "$argon2id$v=16$m=1024,t=1,p=1$c2FsdA$a2V5"},
+		{"argon2 bad parameters", "$argon2id$v=19$memory$c2FsdA$a2V5"},
+		{"argon2 bad salt", "$argon2id$v=19$m=1024,t=1,p=1$!!!$a2V5"},
+		{"argon2 bad key", "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$!!!"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, _, err := h.Verify(tt.hashed, "password", nil)
+			if match {
+				t.Error("Verify() match = true, want false")
+			}
+			if !errors.Is(err, ErrUnknownHashFormat) {
+				t.Errorf("Verify() error = %v, want %v", err, ErrUnknownHashFormat)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+)
+
+// recoveryCodeLength is the number of random bytes of a recovery code, 8 base32 characters
+const recoveryCodeLength = 5
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewRecoveryCode returns a random single-use recovery code formatted as xxxx-xxxx
+func NewRecoveryCode() (string, error) {
+	codeBytes := make([]byte, recoveryCodeLength)
+	_, err := rand.Read(codeBytes)
+	if err != nil {
+		return "", err
+	}
+	code := strings.ToLower(recoveryCodeEncoding.EncodeToString(codeBytes))
+	return code[:4] + "-" + code[4:], nil
+}
+
+// HashRecoveryCode hashes a recovery code ignoring case and separators, only hashes are stored
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	hash := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(hash[:])
+}
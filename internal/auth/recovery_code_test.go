@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestNewRecoveryCode(t *testing.T) {
+	format := regexp.MustCompile(`^[a-z2-7]{4}-[a-z2-7]{4}$`)
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		code, err := NewRecoveryCode()
+		if err != nil {
+			t.Fatalf("NewRecoveryCode() error = %v", err)
+		}
+		if !format.MatchString(code) {
+			t.Fatalf("NewRecoveryCode() = %q, want format xxxx-xxxx", code)
+		}
+		if seen[code] {
+			t.Fatalf("NewRecoveryCode() returned %q twice", code)
+		}
+		seen[code] = true
+	}
+}
+
+func TestHashRecoveryCode(t *testing.T) {
+	want := HashRecoveryCode("abcd-efgh")
+	tests := []struct {
+		name  string
+		code  string
+		equal bool
+	}{
+		{"same", "abcd-efgh", true},
+		{"uppercase", "ABCD-EFGH", true},
+		{"no separator", "abcdefgh", true},
+		{"space separator", "abcd efgh", true},
+		{"surrounding spaces", " abcd-efgh ", true},
+		{"other code", "abcd-efgi", false},
+		{"prefix", "abcd", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HashRecoveryCode(tt.code); (got == want) != tt.equal {
+				t.Errorf("HashRecoveryCode(%q) == HashRecoveryCode(%q) is %v, want %v", tt.code, "abcd-efgh", got == want, tt.equal)
+			}
+		})
+	}
+	if want == "abcd-efgh" || len(want) != 64 {
+		t.Errorf("HashRecoveryCode() = %q, want a sha256 hex digest", want)
+	}
+}
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OAuth2 scopes of access tokens
+const (
+	ScopeRead  = "read"
+	ScopeWrite = "write"
+	ScopeAdmin = "admin"
+)
+
+// FirstPartyScopes are granted to tokens issued directly to users.
+// admin covers account administration (password, sessions, OAuth2 clients) which third-party apps rarely need.
+var FirstPartyScopes = []string{ScopeRead, ScopeWrite, ScopeAdmin}
+
+// ThirdPartyScopes can be granted to OAuth2 clients, admin is kept for first-party tokens
+var ThirdPartyScopes = []string{ScopeRead, ScopeWrite}
+
+// ParseScope parses a space separated scope string, unknown scopes are rejected
+func ParseScope(scope string) ([]string, error) {
+	var scopes []string
+	seen := make(map[string]bool)
+	for _, s := range strings.Fields(scope) {
+		if s != ScopeRead && s != ScopeWrite && s != ScopeAdmin {
+			return nil, fmt.Errorf("unknown scope %q", s)
+		}
+		if !seen[s] {
+			seen[s] = true
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes, nil
+}
+
+// FormatScope joins scopes into a space separated scope string
+func FormatScope(scopes []string) string {
+	return strings.Join(scopes, " ")
+}
+
+// ScopesAllowed checks if every scope of requested is in allowed
+func ScopesAllowed(requested []string, allowed []string) bool {
+	for _, r := range requested {
+		found := false
+		for _, a := range allowed {
+			if r == a {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// HasScope checks if scopes contain scope
+func HasScope(scopes []string, scope string) bool {
+	return ScopesAllowed([]string{scope}, scopes)
+}
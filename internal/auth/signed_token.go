@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MinSecretLength is the minimum length in bytes of secrets used to sign tokens
+const MinSecretLength = 32
+
+var (
+	ErrInvalidToken  = errors.New("invalid token")
+	ErrWeakSecret    = fmt.Errorf("secret must be at least %d bytes long", MinSecretLength)
+	ErrDefaultSecret = errors.New("secret must not be a default value")
+)
+
+// defaultSecrets are example secrets which were published, secrets containing them are rejected
+var defaultSecrets = []string{"access-token-secret", "email-verification-secret", "example", "changeme"}
+
+// CheckSecret checks if secret is long enough to sign tokens and is not a published default
+func CheckSecret(secret []byte) error {
+	if len(secret) < MinSecretLength {
+		return ErrWeakSecret
+	}
+	lowered := strings.ToLower(string(secret))
+	for _, defaultSecret := range defaultSecrets {
+		if strings.Contains(lowered, defaultSecret) {
+			return ErrDefaultSecret
+		}
+	}
+	return nil
+}
+
+// SignedToken is the payload of a token signed with HMAC-SHA256.
+// Nonce is random and can be used to make the token single-use.
+type SignedToken struct {
+	Purpose   string
+	UserID    int64
+	ExpiresAt time.Time
+	Nonce     string
+}
+
+// NewSignedToken creates a token for userId which is valid for purpose until expiresAt
+func NewSignedToken(secret []byte, purpose string, userId int64, expiresAt time.Time) (string, SignedToken, error) {
+	err := CheckSecret(secret)
+	if err != nil {
+		return "", SignedToken{}, err
+	}
+	nonceBytes := make([]byte, 16)
+	_, err = rand.Read(nonceBytes)
+	if err != nil {
+		return "", SignedToken{}, err
+	}
+	token := SignedToken{
+		Purpose:   purpose,
+		UserID:    userId,
+		ExpiresAt: time.Unix(expiresAt.Unix(), 0),
+		Nonce:     base64.RawURLEncoding.EncodeToString(nonceBytes),
+	}
+
+	payload := fmt.Sprintf("%d.%d.%s", token.UserID, token.ExpiresAt.Unix(), token.Nonce)
+	signature := sign(secret, purpose, payload)
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + signature, token, nil
+}
+
+// ParseSignedToken checks signature, purpose and expiration of a token created by NewSignedToken
+func ParseSignedToken(secret []byte, purpose string, tokenString string) (SignedToken, error) {
+	err := CheckSecret(secret)
+	if err != nil {
+		return SignedToken{}, err
+	}
+	encodedPayload, signature, found := strings.Cut(tokenString, ".")
+	if !found {
+		return SignedToken{}, ErrInvalidToken
+	}
+	payloadBytes, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return SignedToken{}, ErrInvalidToken
+	}
+	payload := string(payloadBytes)
+	if !hmac.Equal([]byte(signature), []byte(sign(secret, purpose, payload))) {
+		return SignedToken{}, ErrInvalidToken
+	}
+
+	parts := strings.Split(payload, ".")
+	if len(parts) != 3 {
+		return SignedToken{}, ErrInvalidToken
+	}
+	userId, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return SignedToken{}, ErrInvalidToken
+	}
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return SignedToken{}, ErrInvalidToken
+	}
+	token := SignedToken{
+		Purpose:   purpose,
+		UserID:    userId,
+		ExpiresAt: time.Unix(expiresAt, 0),
+		Nonce:     parts[2],
+	}
+	if !time.Now().Before(token.ExpiresAt) {
+		return SignedToken{}, ErrInvalidToken
+	}
+	return token, nil
+}
+
+func sign(secret []byte, purpose string, payload string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(purpose + ":" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
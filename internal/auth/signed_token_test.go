@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func TestCheckSecret(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  []byte
+		wantErr error
+	}{
+		{"empty", nil, ErrWeakSecret},
+		{"short", []byte("email-verification-secret"), ErrWeakSecret},
+		{"minimum length", testSecret, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckSecret(tt.secret); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckSecret() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSignedTokenRoundTrip(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	tokenString, token, err := NewSignedToken(testSecret, "email_verification", 42, expiresAt)
+	if err != nil {
+		t.Fatalf("NewSignedToken() error = %v", err)
+	}
+	if token.UserID != 42 || token.Purpose != "email_verification" || token.Nonce == "" {
+		t.Fatalf("NewSignedToken() token = %+v", token)
+	}
+	if token.ExpiresAt.Unix() != expiresAt.Unix() {
+		t.Errorf("NewSignedToken() ExpiresAt = %v, want %v", token.ExpiresAt, expiresAt)
+	}
+
+	parsed, err := ParseSignedToken(testSecret, "email_verification", tokenString)
+	if err != nil {
+		t.Fatalf("ParseSignedToken() error = %v", err)
+	}
+	if parsed != token {
+		t.Errorf("ParseSignedToken() = %+v, want %+v", parsed, token)
+	}
+
+	// Nonces are random
+	_, other, err := NewSignedToken(testSecret, "email_verification", 42, expiresAt)
+	if err != nil {
+		t.Fatalf("NewSignedToken() error = %v", err)
+	}
+	if other.Nonce == token.Nonce {
+		t.Error("NewSignedToken() returned the same nonce twice")
+	}
+}
+
+func TestParseSignedTokenRejects(t *testing.T) {
+	valid, _, err := NewSignedToken(testSecret, "email_verification", 42, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("NewSignedToken() error = %v", err)
+	}
+	expired, _, err := NewSignedToken(testSecret, "email_verification", 42, time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("NewSignedToken() error = %v", err)
+	}
+	encodedPayload, signature, _ := strings.Cut(valid, ".")
+	otherSecret := []byte("fedcba9876543210fedcba9876543210")
+
+	tests := []struct {
+		name    string
+		secret  []byte
+		purpose string
+		token   string
+		wantErr error
+	}{
+		{"empty secret", nil, "email_verification", valid, ErrWeakSecret},
+		{"short secret", []byte("secret"), "email_verification", valid, ErrWeakSecret},
+		{"other secret", otherSecret, "email_verification", valid, ErrInvalidToken},
+		{"other purpose", testSecret, "password_reset", valid, ErrInvalidToken},
+		{"expired", testSecret, "email_verification", expired, ErrInvalidToken},
+		{"no signature", testSecret, "email_verification", encodedPayload, ErrInvalidToken},
+		{"tampered signature", testSecret, "email_verification", encodedPayload + "." + strings.ToUpper(signature), ErrInvalidToken},
+		{"tampered payload", testSecret, "email_verification", "NDMuOTk5OTk5OTk5OS5ub25jZQ." + signature, ErrInvalidToken},
+		{"empty", testSecret, "email_verification", "", ErrInvalidToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSignedToken(tt.secret, tt.purpose, tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseSignedToken() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewSignedTokenRejectsWeakSecret(t *testing.T) {
+	_, _, err := NewSignedToken([]byte(""), "email_verification", 42, time.Now().Add(time.Hour))
+	if !errors.Is(err, ErrWeakSecret) {
+		t.Errorf("NewSignedToken() error = %v, want %v", err, ErrWeakSecret)
+	}
+}
@@ -1,78 +1,150 @@
 package auth
 
 import (
+	"errors"
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt"
+	"github.com/maxuanquang/social-network/configs"
 )
 
-func GenerateToken(user_id uint) (string, error) {
+const (
+	accessTokenType = "access"
 
-	token_lifespan, err := strconv.Atoi(os.Getenv("TOKEN_HOUR_LIFESPAN"))
+	defaultAccessTokenLifetime = 15 * time.Minute
 
-	if err != nil {
-		return "", err
-	}
-
-	claims := jwt.MapClaims{}
-	claims["authorized"] = true
-	claims["user_id"] = user_id
-	claims["exp"] = time.Now().Add(time.Hour * time.Duration(token_lifespan)).Unix()
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	// MaxAccessTokenLifetime bounds the lifetime of access tokens
+	MaxAccessTokenLifetime = 24 * time.Hour
+)
 
-	return token.SignedString([]byte(os.Getenv("API_SECRET")))
+// TokenManager issues and validates access tokens signed with HMAC-SHA256.
+// Several signing keys can be configured, tokens are signed with the current one and its id
+// is put in the "kid" header, so tokens signed with older keys stay valid until they are removed.
+type TokenManager struct {
+	keys       map[string][]byte
+	currentKid string
+	lifetime   time.Duration
 }
 
-func TokenValid(c *gin.Context) error {
-	tokenString := ExtractToken(c)
-	_, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+func NewTokenManager(cfg *configs.TokenConfig) (*TokenManager, error) {
+	m := &TokenManager{
+		keys:       make(map[string][]byte),
+		currentKid: cfg.CurrentKid,
+		lifetime:   cfg.AccessTokenLifetime,
+	}
+	for _, key := range cfg.SigningKeys {
+		if key.Kid == "" {
+			return nil, errors.New("signing key must have kid")
 		}
-		return []byte(os.Getenv("API_SECRET")), nil
-	})
-	if err != nil {
-		return err
+		err := CheckSecret([]byte(key.Secret))
+		if err != nil {
+			return nil, fmt.Errorf("signing key %q: %w", key.Kid, err)
+		}
+		m.keys[key.Kid] = []byte(key.Secret)
+	}
+	if _, ok := m.keys[m.currentKid]; !ok {
+		return nil, fmt.Errorf("current signing key %q is not configured", m.currentKid)
+	}
+	if m.lifetime <= 0 {
+		m.lifetime = defaultAccessTokenLifetime
 	}
-	return nil
+	if m.lifetime > MaxAccessTokenLifetime {
+		return nil, fmt.Errorf("access token lifetime must not exceed %s", MaxAccessTokenLifetime)
+	}
+	return m, nil
 }
 
-func ExtractToken(c *gin.Context) string {
-	token := c.Query("token")
-	if token != "" {
-		return token
+// AccessToken is the content of a validated access token
+type AccessToken struct {
+	UserID int64
+	// ClientID is the OAuth2 client the token was issued to, empty for first-party tokens
+	ClientID string
+	Scopes   []string
+	// Generation is the token generation of the user when the token was issued, tokens of older
+	// generations are revoked
+	Generation int64
+}
+
+// GenerateAccessToken returns a signed access token of an user and its expiration time.
+// generation is the current token generation of the user.
+func (m *TokenManager) GenerateAccessToken(userId int64, clientId string, scopes []string, generation int64) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(m.lifetime)
+	claims := jwt.MapClaims{
+		"sub":   strconv.FormatInt(userId, 10),
+		"typ":   accessTokenType,
+		"scope": FormatScope(scopes),
+		"gen":   generation,
+		"iat":   now.Unix(),
+		"exp":   expiresAt.Unix(),
 	}
-	bearerToken := c.Request.Header.Get("Authorization")
-	if len(strings.Split(bearerToken, " ")) == 2 {
-		return strings.Split(bearerToken, " ")[1]
+	if clientId != "" {
+		claims["client_id"] = clientId
 	}
-	return ""
-}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = m.currentKid
 
-func ExtractTokenID(c *gin.Context) (uint, error) {
+	signed, err := token.SignedString(m.keys[m.currentKid])
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expiresAt, nil
+}
 
-	tokenString := ExtractToken(c)
+// ParseAccessToken validates an access token and returns its content
+func (m *TokenManager) ParseAccessToken(tokenString string) (*AccessToken, error) {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		if token.Method != jwt.SigningMethodHS256 {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return []byte(os.Getenv("API_SECRET")), nil
+		kid, _ := token.Header["kid"].(string)
+		key, ok := m.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+		return key, nil
 	})
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
+
 	claims, ok := token.Claims.(jwt.MapClaims)
-	if ok && token.Valid {
-		uid, err := strconv.ParseUint(fmt.Sprintf("%.0f", claims["user_id"]), 10, 32)
-		if err != nil {
-			return 0, err
-		}
-		return uint(uid), nil
+	if !ok || !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+	if typ, _ := claims["typ"].(string); typ != accessTokenType {
+		return nil, errors.New("invalid token type")
+	}
+	// exp is optional for the jwt package, it is required here
+	if _, ok := claims["exp"]; !ok {
+		return nil, errors.New("token has no expiration")
+	}
+	sub, _ := claims["sub"].(string)
+	userId, err := strconv.ParseInt(sub, 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid token subject")
+	}
+	scope, _ := claims["scope"].(string)
+	scopes, err := ParseScope(scope)
+	if err != nil {
+		return nil, err
+	}
+	clientId, _ := claims["client_id"].(string)
+	// Numbers are decoded as float64, tokens without generation belong to generation 0
+	generation, _ := claims["gen"].(float64)
+	return &AccessToken{UserID: userId, ClientID: clientId, Scopes: scopes, Generation: int64(generation)}, nil
+}
+
+// ExtractToken returns the bearer token of Authorization header, or empty string if there is none
+func ExtractToken(c *gin.Context) string {
+	bearerToken := c.Request.Header.Get("Authorization")
+	scheme, token, found := strings.Cut(bearerToken, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return ""
 	}
-	return 0, nil
+	return strings.TrimSpace(token)
 }
@@ -0,0 +1,225 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/maxuanquang/social-network/configs"
+)
+
+const (
+	testKeyA = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	testKeyB = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+)
+
+func newTestTokenManager(t *testing.T, currentKid string, keys ...configs.SigningKeyConfig) *TokenManager {
+	t.Helper()
+	m, err := NewTokenManager(&configs.TokenConfig{CurrentKid: currentKid, SigningKeys: keys})
+	if err != nil {
+		t.Fatalf("NewTokenManager() error = %v", err)
+	}
+	return m
+}
+
+func TestNewTokenManager(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     configs.TokenConfig
+		wantErr error
+	}{
+		{
+			name: "valid",
+			cfg:  configs.TokenConfig{CurrentKid: "a", SigningKeys: []configs.SigningKeyConfig{{Kid: "a", Secret: testKeyA}}},
+		},
+		{
+			name:    "empty secret",
+			cfg:     configs.TokenConfig{CurrentKid: "a", SigningKeys: []configs.SigningKeyConfig{{Kid: "a"}}},
+			wantErr: ErrWeakSecret,
+		},
+		{
+			name:    "short secret",
+			cfg:     configs.TokenConfig{CurrentKid: "a", SigningKeys: []configs.SigningKeyConfig{{Kid: "a", Secret: "secret"}}},
+			wantErr: ErrWeakSecret,
+		},
+		{
+			name:    "default secret",
+			cfg:     configs.TokenConfig{CurrentKid: "a", SigningKeys: []configs.SigningKeyConfig{{Kid: "a", Secret: "access-token-secret-access-token-secret"}}},
+			wantErr: ErrDefaultSecret,
+		},
+		{
+			name: "weak old key",
+			cfg: configs.TokenConfig{CurrentKid: "a", SigningKeys: []configs.SigningKeyConfig{
+				{Kid: "a", Secret: testKeyA}, {Kid: "b", Secret: "short"},
+			}},
+			wantErr: ErrWeakSecret,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTokenManager(&tt.cfg)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewTokenManager() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	invalid := []struct {
+		name string
+		cfg  configs.TokenConfig
+	}{
+		{"no kid", configs.TokenConfig{CurrentKid: "", SigningKeys: []configs.SigningKeyConfig{{Secret: testKeyA}}}},
+		{"unknown current kid", configs.TokenConfig{CurrentKid: "b", SigningKeys: []configs.SigningKeyConfig{{Kid: "a", Secret: testKeyA}}}},
+		{"lifetime too long", configs.TokenConfig{
+			CurrentKid: "a", SigningKeys: []configs.SigningKeyConfig{{Kid: "a", Secret: testKeyA}},
+			AccessTokenLifetime: MaxAccessTokenLifetime + time.Second,
+		}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTokenManager(&tt.cfg)
+			if err == nil {
+				t.Error("NewTokenManager() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestAccessTokenRoundTrip(t *testing.T) {
+	m := newTestTokenManager(t, "a", configs.SigningKeyConfig{Kid: "a", Secret: testKeyA})
+	tests := []struct {
+		name       string
+		userId     int64
+		clientId   string
+		scopes     []string
+		generation int64
+	}{
+		{"first party", 42, "", FirstPartyScopes, 0},
+		{"oauth client", 7, "client-1", []string{ScopeRead}, 3},
+		{"no scope", 1, "client-2", nil, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokenString, expiresAt, err := m.GenerateAccessToken(tt.userId, tt.clientId, tt.scopes, tt.generation)
+			if err != nil {
+				t.Fatalf("GenerateAccessToken() error = %v", err)
+			}
+			if d := time.Until(expiresAt); d <= 0 || d > defaultAccessTokenLifetime {
+				t.Errorf("GenerateAccessToken() expires in %v", d)
+			}
+
+			token, err := m.ParseAccessToken(tokenString)
+			if err != nil {
+				t.Fatalf("ParseAccessToken() error = %v", err)
+			}
+			if token.UserID != tt.userId || token.ClientID != tt.clientId || token.Generation != tt.generation ||
+				FormatScope(token.Scopes) != FormatScope(tt.scopes) {
+				t.Errorf("ParseAccessToken() = %+v", token)
+			}
+		})
+	}
+}
+
+func TestAccessTokenSigningKeyRotation(t *testing.T) {
+	keyA := configs.SigningKeyConfig{Kid: "a", Secret: testKeyA}
+	keyB := configs.SigningKeyConfig{Kid: "b", Secret: testKeyB}
+	oldManager := newTestTokenManager(t, "a", keyA)
+	rotatedManager := newTestTokenManager(t, "b", keyA, keyB)
+	removedManager := newTestTokenManager(t, "b", keyB)
+
+	oldToken, _, err := oldManager.GenerateAccessToken(42, "", FirstPartyScopes, 0)
+	if err != nil {
+		t.Fatalf("GenerateAccessToken() error = %v", err)
+	}
+	newToken, _, err := rotatedManager.GenerateAccessToken(42, "", FirstPartyScopes, 0)
+	if err != nil {
+		t.Fatalf("GenerateAccessToken() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		manager *TokenManager
+		token   string
+		wantErr bool
+	}{
+		{"old key before rotation", oldManager, oldToken, false},
+		{"old key after rotation", rotatedManager, oldToken, false},
+		{"new key after rotation", rotatedManager, newToken, false},
+		{"new key unknown before rotation", oldManager, newToken, true},
+		{"old key removed", removedManager, oldToken, true},
+		{"new key after removal", removedManager, newToken, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.manager.ParseAccessToken(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseAccessToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseAccessTokenRejects(t *testing.T) {
+	m := newTestTokenManager(t, "a", configs.SigningKeyConfig{Kid: "a", Secret: testKeyA})
+	now := time.Now()
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"sub":   "42",
+			"typ":   accessTokenType,
+			"scope": "read",
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Minute).Unix(),
+		}
+	}
+	sign := func(method jwt.SigningMethod, kid string, key interface{}, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(method, claims)
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatalf("SignedString() error = %v", err)
+		}
+		return signed
+	}
+	withClaim := func(key string, value interface{}) jwt.MapClaims {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+
+	valid := sign(jwt.SigningMethodHS256, "a", []byte(testKeyA), validClaims())
+	if _, err := m.ParseAccessToken(valid); err != nil {
+		t.Fatalf("ParseAccessToken(valid) error = %v", err)
+	}
+	parts := strings.Split(valid, ".")
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"expired", sign(jwt.SigningMethodHS256, "a", []byte(testKeyA), withClaim("exp", now.Add(-time.Minute).Unix()))},
+		{"no expiration", sign(jwt.SigningMethodHS256, "a", []byte(testKeyA), withClaim("exp", nil))},
+		{"refresh type", sign(jwt.SigningMethodHS256, "a", []byte(testKeyA), withClaim("typ", "refresh"))},
+		{"no type", sign(jwt.SigningMethodHS256, "a", []byte(testKeyA), withClaim("typ", nil))},
+		{"invalid subject", sign(jwt.SigningMethodHS256, "a", []byte(testKeyA), withClaim("sub", "abc"))},
+		{"unknown scope", sign(jwt.SigningMethodHS256, "a", []byte(testKeyA), withClaim("scope", "read superuser"))},
+		{"wrong key", sign(jwt.SigningMethodHS256, "a", []byte(testKeyB), validClaims())},
+		{"unknown kid", sign(jwt.SigningMethodHS256, "c", []byte(testKeyA), validClaims())},
+		{"other algorithm", sign(jwt.SigningMethodHS512, "a", []byte(testKeyA), validClaims())},
+		{"none algorithm", sign(jwt.SigningMethodNone, "a", jwt.UnsafeAllowNoneSignatureType, validClaims())},
+		{"tampered payload", parts[0] + "." + strings.TrimRight(parts[1], "=") + "x." + parts[2]},
+		{"malformed", "not-a-token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := m.ParseAccessToken(tt.token); err == nil {
+				t.Error("ParseAccessToken() error = nil, want error")
+			}
+		})
+	}
+}
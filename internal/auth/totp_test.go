@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+// Secret of the SHA1 test vectors of RFC 6238
+var testTOTPKey = []byte("12345678901234567890")
+
+func TestTOTPCode(t *testing.T) {
+	// RFC 6238 appendix B, codes are truncated to their last 6 digits
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		t.Run(time.Unix(tt.unix, 0).UTC().Format(time.RFC3339), func(t *testing.T) {
+			if got := totpCode(testTOTPKey, tt.unix/totpPeriod); got != tt.want {
+				t.Errorf("totpCode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	secret := totpEncoding.EncodeToString(testTOTPKey)
+	now := time.Unix(1111111109, 0)
+	step := now.Unix() / totpPeriod
+
+	tests := []struct {
+		name     string
+		secret   string
+		code     string
+		wantStep int64
+		wantOk   bool
+	}{
+		{"current step", secret, "081804", step, true},
+		{"previous step", secret, totpCode(testTOTPKey, step-1), step - 1, true},
+		{"next step", secret, totpCode(testTOTPKey, step+1), step + 1, true},
+		{"two steps ago", secret, totpCode(testTOTPKey, step-2), 0, false},
+		{"two steps ahead", secret, totpCode(testTOTPKey, step+2), 0, false},
+		{"lowercase secret", "gezdgnbvgy3tqojqgezdgnbvgy3tqojq", "081804", step, true},
+		{"wrong code", secret, "000000", 0, false},
+		{"too short", secret, "81804", 0, false},
+		{"too long", secret, "0081804", 0, false},
+		{"empty", secret, "", 0, false},
+		{"invalid secret", "not base32!", "081804", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStep, gotOk := ValidateTOTP(tt.secret, tt.code, now)
+			if gotStep != tt.wantStep || gotOk != tt.wantOk {
+				t.Errorf("ValidateTOTP() = %v, %v, want %v, %v", gotStep, gotOk, tt.wantStep, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestNewTOTPSecret(t *testing.T) {
+	secret, err := NewTOTPSecret()
+	if err != nil {
+		t.Fatalf("NewTOTPSecret() error = %v", err)
+	}
+	key, err := totpEncoding.DecodeString(secret)
+	if err != nil {
+		t.Fatalf("NewTOTPSecret() = %q is not base32: %v", secret, err)
+	}
+	if len(key) != totpSecretLength {
+		t.Errorf("NewTOTPSecret() key length = %d, want %d", len(key), totpSecretLength)
+	}
+
+	other, err := NewTOTPSecret()
+	if err != nil {
+		t.Fatalf("NewTOTPSecret() error = %v", err)
+	}
+	if other == secret {
+		t.Error("NewTOTPSecret() returned the same secret twice")
+	}
+}
+
+func TestTOTPProvisioningURI(t *testing.T) {
+	uri := TOTPProvisioningURI("Social Network", "alice@example.com", "GEZDGNBVGY3TQOJQ")
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		t.Fatalf("TOTPProvisioningURI() = %q is not an URI: %v", uri, err)
+	}
+	if parsed.Scheme != "otpauth" || parsed.Host != "totp" || parsed.Path != "/Social Network:alice@example.com" {
+		t.Errorf("TOTPProvisioningURI() = %q", uri)
+	}
+
+	query := parsed.Query()
+	want := map[string]string{
+		"secret":    "GEZDGNBVGY3TQOJQ",
+		"issuer":    "Social Network",
+		"algorithm": "SHA1",
+		"digits":    "6",
+		"period":    "30",
+	}
+	for key, value := range want {
+		if got := query.Get(key); got != value {
+			t.Errorf("TOTPProvisioningURI() %s = %q, want %q", key, got, value)
+		}
+	}
+}
@@ -9,18 +9,57 @@ import (
 
 type User struct {
 	gorm.Model
-	HashedPassword string       `gorm:"size:1000;not null" json:"hashed_password"`
-	Salt           []byte       `gorm:"size:1000;not null" json:"salt"`
-	FirstName      string       `gorm:"size:50" json:"first_name"`
-	LastName       string       `gorm:"size:50" json:"last_name"`
-	DateOfBirth    sql.NullTime `json:"date_of_birth"`
-	Email          string       `gorm:"size:50;not null" json:"email"`
-	UserName       string       `gorm:"size:50;not null" json:"user_name"`
-	Posts          []*Post
-	Followers      []*User `gorm:"many2many:following"`
-	Followings     []*User `gorm:"many2many:following;joinForeignKey:follower_id;joinReferences:user_id"`
-	ProfilePicture string  `gorm:"size:500" json:"profile_picture"`
-	CoverPicture   string  `gorm:"size:500" json:"cover_picture"`
+	HashedPassword  string       `gorm:"size:1000;not null" json:"hashed_password"`
+	Salt            []byte       `gorm:"size:1000;not null" json:"salt"`
+	FirstName       string       `gorm:"size:50" json:"first_name"`
+	LastName        string       `gorm:"size:50" json:"last_name"`
+	DateOfBirth     sql.NullTime `json:"date_of_birth"`
+	Email           string       `gorm:"size:100;not null;uniqueIndex" json:"email"`
+	EmailVerifiedAt sql.NullTime `json:"email_verified_at"`
+	UserName        string       `gorm:"size:50;not null" json:"user_name"`
+	Posts           []*Post
+	Followers       []*User `gorm:"many2many:following"`
+	Followings      []*User `gorm:"many2many:following;joinForeignKey:follower_id;joinReferences:user_id"`
+	ProfilePicture  string  `gorm:"size:500" json:"profile_picture"`
+	CoverPicture    string  `gorm:"size:500" json:"cover_picture"`
+	// DeletionScheduledAt is when the account is purged, it is null unless the user asked to delete it
+	DeletionScheduledAt sql.NullTime `json:"deletion_scheduled_at"`
+	// IsPrivate accounts need to approve their followers, their posts are only readable by them
+	IsPrivate bool `gorm:"not null;default:false" json:"is_private"`
+	// Counters of follow edges and visible posts, they are updated along with the rows they count
+	FollowersCount  int64 `gorm:"not null;default:0" json:"followers_count"`
+	FollowingsCount int64 `gorm:"not null;default:0" json:"followings_count"`
+	PostsCount      int64 `gorm:"not null;default:0" json:"posts_count"`
+	// Profile fields, they are empty unless set by the user
+	Bio      string `gorm:"size:500;not null;default:''" json:"bio"`
+	Location string `gorm:"size:100;not null;default:''" json:"location"`
+	Website  string `gorm:"size:500;not null;default:''" json:"website"`
+	Pronouns string `gorm:"size:50;not null;default:''" json:"pronouns"`
+	// Who can see each profile field, one of the Visibility constants
+	EmailVisibility       string `gorm:"size:20;not null;default:only_me" json:"email_visibility"`
+	DateOfBirthVisibility string `gorm:"size:20;not null;default:only_me" json:"date_of_birth_visibility"`
+	BioVisibility         string `gorm:"size:20;not null;default:public" json:"bio_visibility"`
+	LocationVisibility    string `gorm:"size:20;not null;default:public" json:"location_visibility"`
+	WebsiteVisibility     string `gorm:"size:20;not null;default:public" json:"website_visibility"`
+	PronounsVisibility    string `gorm:"size:20;not null;default:public" json:"pronouns_visibility"`
+}
+
+const (
+	VisibilityPublic    = "public"
+	VisibilityFollowers = "followers"
+	VisibilityOnlyMe    = "only_me"
+)
+
+// UserNameHistory is a previous user name, it redirects to its user for a grace period
+type UserNameHistory struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UserID    int64  `gorm:"not null"`
+	UserName  string `gorm:"size:50;not null"`
+}
+
+func (UserNameHistory) TableName() string {
+	return "user_name_history"
 }
 
 func (User) TableName() string {
@@ -34,6 +73,8 @@ type Post struct {
 	UserID           int64  `gorm:"not null" json:"user_id"`
 	Comments         []*Comment
 	LikedUsers       []*User `gorm:"many2many:like"`
+	// AudienceListID restricts the post to the members of an audience list of its author
+	AudienceListID sql.NullInt64 `json:"audience_list_id"`
 }
 
 func (Post) TableName() string {
@@ -58,3 +99,251 @@ type Like struct {
 	PostId    int64 `gorm:"not null"`
 	UserId    int64 `gorm:"not null"`
 }
+
+type PinnedPost struct {
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+	UserID    int64     `gorm:"not null" json:"user_id"`
+	PostID    int64     `gorm:"not null" json:"post_id"`
+}
+
+func (PinnedPost) TableName() string {
+	return "pinned_post"
+}
+
+type Poll struct {
+	PostID         int64         `gorm:"primaryKey" json:"post_id"`
+	CreatedAt      time.Time     `gorm:"not null" json:"created_at"`
+	MultipleChoice bool          `gorm:"not null" json:"multiple_choice"`
+	ClosesAt       sql.NullTime  `json:"closes_at"`
+	Options        []*PollOption `gorm:"foreignKey:PostID;references:PostID"`
+}
+
+func (Poll) TableName() string {
+	return "poll"
+}
+
+type PollOption struct {
+	ID          uint   `gorm:"primaryKey" json:"id"`
+	PostID      int64  `gorm:"not null" json:"post_id"`
+	Position    int    `gorm:"not null" json:"position"`
+	ContentText string `gorm:"size:200;not null" json:"content_text"`
+}
+
+func (PollOption) TableName() string {
+	return "poll_option"
+}
+
+type PollVote struct {
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+	PostID    int64     `gorm:"not null" json:"post_id"`
+	UserID    int64     `gorm:"not null" json:"user_id"`
+}
+
+func (PollVote) TableName() string {
+	return "poll_vote"
+}
+
+type PollVoteOption struct {
+	PostID   int64 `gorm:"not null" json:"post_id"`
+	UserID   int64 `gorm:"not null" json:"user_id"`
+	OptionID int64 `gorm:"not null" json:"option_id"`
+}
+
+func (PollVoteOption) TableName() string {
+	return "poll_vote_option"
+}
+
+type Bookmark struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+	UserID    int64     `gorm:"not null" json:"user_id"`
+	PostID    int64     `gorm:"not null" json:"post_id"`
+}
+
+func (Bookmark) TableName() string {
+	return "bookmark"
+}
+
+type LinkPreview struct {
+	PostID      int64     `gorm:"primaryKey" json:"post_id"`
+	CreatedAt   time.Time `gorm:"not null" json:"created_at"`
+	URL         string    `gorm:"size:2000;not null" json:"url"`
+	Title       string    `gorm:"size:300" json:"title"`
+	Description string    `gorm:"size:1000" json:"description"`
+	ImageURL    string    `gorm:"size:1000" json:"image_url"`
+}
+
+func (LinkPreview) TableName() string {
+	return "link_preview"
+}
+
+type Story struct {
+	ID               uint      `gorm:"primaryKey" json:"id"`
+	CreatedAt        time.Time `gorm:"not null" json:"created_at"`
+	ExpiresAt        time.Time `gorm:"not null" json:"expires_at"`
+	UserID           int64     `gorm:"not null" json:"user_id"`
+	ContentText      string    `gorm:"size:1000" json:"content_text"`
+	ContentImagePath string    `gorm:"size:1000" json:"content_image_path"`
+}
+
+func (Story) TableName() string {
+	return "story"
+}
+
+type StoryView struct {
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+	StoryID   int64     `gorm:"not null" json:"story_id"`
+	UserID    int64     `gorm:"not null" json:"user_id"`
+}
+
+func (StoryView) TableName() string {
+	return "story_view"
+}
+
+// UploadedImage records the user a presigned upload url was issued to
+type UploadedImage struct {
+	S3Key     string    `gorm:"column:s3_key;primaryKey;size:100" json:"s3_key"`
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+	UserID    int64     `gorm:"not null" json:"user_id"`
+}
+
+func (UploadedImage) TableName() string {
+	return "uploaded_image"
+}
+
+type TwoFactor struct {
+	UserID       int64        `gorm:"primaryKey" json:"user_id"`
+	CreatedAt    time.Time    `gorm:"not null" json:"created_at"`
+	Secret       string       `gorm:"size:100;not null" json:"-"`
+	EnabledAt    sql.NullTime `json:"enabled_at"`
+	LastUsedStep int64        `gorm:"not null" json:"last_used_step"`
+}
+
+func (TwoFactor) TableName() string {
+	return "two_factor"
+}
+
+type RecoveryCode struct {
+	ID        uint         `gorm:"primaryKey" json:"id"`
+	CreatedAt time.Time    `gorm:"not null" json:"created_at"`
+	UserID    int64        `gorm:"not null" json:"user_id"`
+	CodeHash  string       `gorm:"size:64;not null" json:"-"`
+	UsedAt    sql.NullTime `json:"used_at"`
+}
+
+func (RecoveryCode) TableName() string {
+	return "recovery_code"
+}
+
+const (
+	DataExportPending = "pending"
+	DataExportReady   = "ready"
+	DataExportFailed  = "failed"
+)
+
+// DataExport is the latest personal data archive requested by an user
+type DataExport struct {
+	UserID      int64        `gorm:"primaryKey" json:"user_id"`
+	CreatedAt   time.Time    `gorm:"not null" json:"created_at"`
+	Status      string       `gorm:"size:20;not null" json:"status"`
+	ObjectKey   string       `gorm:"size:200" json:"object_key"`
+	CompletedAt sql.NullTime `json:"completed_at"`
+	ExpiresAt   sql.NullTime `json:"expires_at"`
+}
+
+func (DataExport) TableName() string {
+	return "data_export"
+}
+
+// Block stops BlockedUserID from interacting with UserID, and the other way around
+type Block struct {
+	CreatedAt     time.Time `gorm:"not null" json:"created_at"`
+	UserID        int64     `gorm:"primaryKey" json:"user_id"`
+	BlockedUserID int64     `gorm:"primaryKey" json:"blocked_user_id"`
+}
+
+func (Block) TableName() string {
+	return "block"
+}
+
+// Mute hides posts of MutedUserID from the newsfeed of UserID
+type Mute struct {
+	CreatedAt   time.Time `gorm:"not null" json:"created_at"`
+	UserID      int64     `gorm:"primaryKey" json:"user_id"`
+	MutedUserID int64     `gorm:"primaryKey" json:"muted_user_id"`
+}
+
+func (Mute) TableName() string {
+	return "mute"
+}
+
+// FollowRequest is a pending request of FollowerID to follow the private account UserID
+type FollowRequest struct {
+	CreatedAt  time.Time `gorm:"not null" json:"created_at"`
+	UserID     int64     `gorm:"primaryKey" json:"user_id"`
+	FollowerID int64     `gorm:"primaryKey" json:"follower_id"`
+}
+
+func (FollowRequest) TableName() string {
+	return "follow_request"
+}
+
+// AudienceList is a named list of users, posts shared with it are only visible to its members
+type AudienceList struct {
+	gorm.Model
+	UserID int64  `gorm:"not null" json:"user_id"`
+	Name   string `gorm:"size:50;not null" json:"name"`
+}
+
+func (AudienceList) TableName() string {
+	return "audience_list"
+}
+
+type AudienceListMember struct {
+	CreatedAt      time.Time `gorm:"not null" json:"created_at"`
+	AudienceListID int64     `gorm:"primaryKey" json:"audience_list_id"`
+	UserID         int64     `gorm:"primaryKey" json:"user_id"`
+}
+
+func (AudienceListMember) TableName() string {
+	return "audience_list_member"
+}
+
+// Conversation is a direct conversation between two users, FirstUserID is the smaller id
+type Conversation struct {
+	ID            uint      `gorm:"primaryKey" json:"id"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	FirstUserID   int64     `gorm:"not null" json:"first_user_id"`
+	SecondUserID  int64     `gorm:"not null" json:"second_user_id"`
+	LastMessageID int64     `gorm:"not null;default:0" json:"last_message_id"`
+}
+
+func (Conversation) TableName() string {
+	return "conversation"
+}
+
+// ConversationMember is the state of a conversation for one of its users
+type ConversationMember struct {
+	ConversationID    int64 `gorm:"primaryKey" json:"conversation_id"`
+	UserID            int64 `gorm:"primaryKey" json:"user_id"`
+	LastReadMessageID int64 `gorm:"not null;default:0" json:"last_read_message_id"`
+	// ClearedMessageID is the last message of the conversation when the user deleted it,
+	// older messages are hidden from the user
+	ClearedMessageID int64 `gorm:"not null;default:0" json:"cleared_message_id"`
+}
+
+func (ConversationMember) TableName() string {
+	return "conversation_member"
+}
+
+type Message struct {
+	gorm.Model
+	ConversationID int64  `gorm:"not null" json:"conversation_id"`
+	SenderID       int64  `gorm:"not null" json:"sender_id"`
+	ContentText    string `gorm:"type:text;not null" json:"content_text"`
+}
+
+func (Message) TableName() string {
+	return "message"
+}
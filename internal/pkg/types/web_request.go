@@ -16,6 +16,39 @@ const (
 type LoginRequest struct {
 	UserName string `json:"user_name" validate:"required,user_name"`
 	Password string `json:"password" validate:"required,password"`
+	OTPCode  string `json:"otp_code"`
+}
+
+type TwoFactorLoginRequest struct {
+	Challenge string `json:"challenge" validate:"required"`
+	OTPCode   string `json:"otp_code" validate:"required"`
+}
+
+type TwoFactorCodeRequest struct {
+	OTPCode string `json:"otp_code" validate:"required"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type CreateOAuthClientRequest struct {
+	Name         string   `json:"name" validate:"required,max=100"`
+	RedirectURIs []string `json:"redirect_uris" validate:"required,min=1,max=10,dive,required,url"`
+	Scope        string   `json:"scope" validate:"required"`
+	Confidential bool     `json:"confidential"`
+}
+
+type OAuthAuthorizeRequest struct {
+	ResponseType        string `json:"response_type" form:"response_type" validate:"required"`
+	ClientID            string `json:"client_id" form:"client_id" validate:"required"`
+	RedirectURI         string `json:"redirect_uri" form:"redirect_uri" validate:"required"`
+	Scope               string `json:"scope" form:"scope"`
+	State               string `json:"state" form:"state"`
+	CodeChallenge       string `json:"code_challenge" form:"code_challenge"`
+	CodeChallengeMethod string `json:"code_challenge_method" form:"code_challenge_method"`
+	Approve             bool   `json:"approve" form:"approve"`
+	ConsentToken        string `json:"consent_token" form:"consent_token"`
 }
 
 type CreateUserRequest struct {
@@ -24,6 +57,15 @@ type CreateUserRequest struct {
 	Email    string `json:"email" validate:"required,email"`
 }
 
+type RequestPasswordResetRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ConfirmPasswordResetRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,password"`
+}
+
 type EditUserRequest struct {
 	Password       *string `json:"password" validate:"omitempty,password"`
 	FirstName      *string `json:"first_name" validate:"omitempty"`
@@ -31,12 +73,42 @@ type EditUserRequest struct {
 	DateOfBirth    *string `json:"date_of_birth" validate:"omitempty,date_of_birth"`
 	ProfilePicture *string `json:"profile_picture" validate:"omitempty,url"`
 	CoverPicture   *string `json:"cover_picture" validate:"omitempty,url"`
+	IsPrivate      *bool   `json:"is_private"`
+	OTPCode        string  `json:"otp_code"`
+	UserName       *string `json:"user_name" validate:"omitempty,user_name"`
+	Bio            *string `json:"bio" validate:"omitempty,max=500"`
+	Location       *string `json:"location" validate:"omitempty,max=100"`
+	Website        *string `json:"website" validate:"omitempty,http_url,max=500"`
+	Pronouns       *string `json:"pronouns" validate:"omitempty,max=50"`
+	// ProfileVisibility changes who can see the profile fields it sets
+	ProfileVisibility *ProfileVisibilityRequest `json:"profile_visibility"`
+}
+
+type ProfileVisibilityRequest struct {
+	Email       *string `json:"email" validate:"omitempty,oneof=public followers only_me"`
+	DateOfBirth *string `json:"date_of_birth" validate:"omitempty,oneof=public followers only_me"`
+	Bio         *string `json:"bio" validate:"omitempty,oneof=public followers only_me"`
+	Location    *string `json:"location" validate:"omitempty,oneof=public followers only_me"`
+	Website     *string `json:"website" validate:"omitempty,oneof=public followers only_me"`
+	Pronouns    *string `json:"pronouns" validate:"omitempty,oneof=public followers only_me"`
 }
 
 type CreatePostRequest struct {
-	ContentText      string   `json:"content_text" validate:"required"`
-	ContentImagePath []string `json:"content_image_path" validate:"omitempty,dive,url"`
-	Visible          *bool    `json:"visible"`
+	ContentText      string             `json:"content_text" validate:"required"`
+	ContentImagePath []string           `json:"content_image_path" validate:"omitempty,dive,url"`
+	Visible          *bool              `json:"visible"`
+	Poll             *CreatePollRequest `json:"poll" validate:"omitempty"`
+	AudienceListID   *int64             `json:"audience_list_id"`
+}
+
+type CreatePollRequest struct {
+	Options        []string   `json:"options" validate:"min=2,max=10,dive,required,max=200"`
+	MultipleChoice bool       `json:"multiple_choice"`
+	ClosesAt       *time.Time `json:"closes_at"`
+}
+
+type VotePollRequest struct {
+	OptionsIds []int64 `json:"options_ids" validate:"required,min=1"`
 }
 
 type EditPostRequest struct {
@@ -45,6 +117,11 @@ type EditPostRequest struct {
 	Visible          *bool     `json:"visible"`
 }
 
+type CreateStoryRequest struct {
+	ContentText      string `json:"content_text" validate:"required_without=ContentImagePath,max=1000"`
+	ContentImagePath string `json:"content_image_path" validate:"omitempty,url"`
+}
+
 type CreatePostCommentRequest struct {
 	ContentText string `json:"content_text" validate:"required"`
 }
@@ -93,3 +170,17 @@ func validateUsername(fl validator.FieldLevel) bool {
 	}
 	return alphaNumRegex.MatchString(fl.Field().String())
 }
+
+type DeleteUserRequest struct {
+	Password string `json:"password" validate:"required"`
+	OTPCode  string `json:"otp_code"`
+}
+
+type CreateAudienceListRequest struct {
+	Name string `json:"name" validate:"required,max=50"`
+}
+
+type SendMessageRequest struct {
+	RecipientID int64  `json:"recipient_id" validate:"required"`
+	ContentText string `json:"content_text" validate:"required,max=5000"`
+}
@@ -11,13 +11,38 @@ type MessageResponse struct {
 
 // PostDetailInfoResponse return post detail in response.
 type PostDetailInfoResponse struct {
-	PostID           int64             `json:"post_id"`
-	UserID           int64             `json:"user_id"`
-	ContentText      string            `json:"content_text"`
-	ContentImagePath []string          `json:"content_image_path"`
-	CreatedAt        string            `json:"created_at"`
-	Comments         []CommentResponse `json:"comments"`
-	UsersLiked       []int64           `json:"users_liked"`
+	PostID           int64                `json:"post_id"`
+	UserID           int64                `json:"user_id"`
+	ContentText      string               `json:"content_text"`
+	ContentImagePath []string             `json:"content_image_path"`
+	CreatedAt        string               `json:"created_at"`
+	Comments         []CommentResponse    `json:"comments"`
+	UsersLiked       []int64              `json:"users_liked"`
+	Poll             *PollResponse        `json:"poll"`
+	LinkPreview      *LinkPreviewResponse `json:"link_preview"`
+}
+
+type PollResponse struct {
+	PostID          int64                `json:"post_id"`
+	MultipleChoice  bool                 `json:"multiple_choice"`
+	ClosesAt        string               `json:"closes_at"`
+	Closed          bool                 `json:"closed"`
+	Options         []PollOptionResponse `json:"options"`
+	TotalVoters     int64                `json:"total_voters"`
+	VotedOptionsIds []int64              `json:"voted_options_ids"`
+}
+
+type PollOptionResponse struct {
+	OptionID    int64  `json:"option_id"`
+	ContentText string `json:"content_text"`
+	Votes       int64  `json:"votes"`
+}
+
+type LinkPreviewResponse struct {
+	URL         string `json:"url"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ImageURL    string `json:"image_url"`
 }
 
 type CommentResponse struct {
@@ -29,37 +54,255 @@ type CommentResponse struct {
 
 type UserFollowerResponse struct {
 	FollowersIds []int64 `json:"followers_ids"`
+	NextCursor   int64   `json:"next_cursor"`
+	TotalCount   int64   `json:"total_count"`
+}
+
+type FollowRequestsResponse struct {
+	FollowersIds []int64 `json:"followers_ids"`
 }
 
 type UserFollowingResponse struct {
 	FollowingsIds []int64 `json:"followings_ids"`
+	NextCursor    int64   `json:"next_cursor"`
+	TotalCount    int64   `json:"total_count"`
 }
 
-type UserPostsResponse struct {
-	PostsIds []int64 `json:"posts_ids"`
+type Relationship struct {
+	UserID      int64 `json:"user_id"`
+	Following   bool  `json:"following"`
+	FollowedBy  bool  `json:"followed_by"`
+	Blocked     bool  `json:"blocked"`
+	Requested   bool  `json:"requested"`
+	MutualCount int64 `json:"mutual_count"`
 }
 
-type NewsfeedResponse struct {
-	PostsIds []int64 `json:"posts_ids"`
+type RelationshipsResponse struct {
+	Relationships []Relationship `json:"relationships"`
 }
 
-type LoginResponse struct {
-	Message string         `json:"message"`
-	User    UserDetailInfo `json:"user"`
+type FollowSuggestionsResponse struct {
+	UsersIds []int64 `json:"users_ids"`
 }
 
-type UserDetailInfo struct {
+type UserSearchResult struct {
 	UserID         int64  `json:"user_id"`
 	UserName       string `json:"user_name"`
 	FirstName      string `json:"first_name"`
 	LastName       string `json:"last_name"`
-	DateOfBirth    string `json:"date_of_birth"`
-	Email          string `json:"email"`
 	ProfilePicture string `json:"profile_picture"`
-	CoverPicture   string `json:"cover_picture"`
+}
+
+type SearchUsersResponse struct {
+	Users []UserSearchResult `json:"users"`
+}
+
+type AudienceListResponse struct {
+	AudienceListID int64   `json:"audience_list_id"`
+	Name           string  `json:"name"`
+	MembersCount   int64   `json:"members_count"`
+	CreatedAt      string  `json:"created_at"`
+	MembersIds     []int64 `json:"members_ids,omitempty"`
+}
+
+type AudienceListsResponse struct {
+	AudienceLists []AudienceListResponse `json:"audience_lists"`
+}
+
+// DirectMessage is a message of a conversation between two users
+type DirectMessage struct {
+	MessageID      int64  `json:"message_id"`
+	ConversationID int64  `json:"conversation_id"`
+	SenderID       int64  `json:"sender_id"`
+	ContentText    string `json:"content_text"`
+	CreatedAt      string `json:"created_at"`
+}
+
+type DirectMessagesResponse struct {
+	Messages   []DirectMessage `json:"messages"`
+	NextCursor int64           `json:"next_cursor"`
+}
+
+type ConversationResponse struct {
+	ConversationID int64          `json:"conversation_id"`
+	OtherUserID    int64          `json:"other_user_id"`
+	LastMessage    *DirectMessage `json:"last_message"`
+	UnreadCount    int64          `json:"unread_count"`
+}
+
+type ConversationsResponse struct {
+	Conversations []ConversationResponse `json:"conversations"`
+	NextCursor    int64                  `json:"next_cursor"`
+}
+
+type UserPostsResponse struct {
+	PostsIds       []int64 `json:"posts_ids"`
+	PinnedPostsIds []int64 `json:"pinned_posts_ids"`
+}
+
+type SavedPostsResponse struct {
+	PostsIds   []int64 `json:"posts_ids"`
+	NextCursor int64   `json:"next_cursor"`
+}
+
+type StoryResponse struct {
+	StoryID          int64  `json:"story_id"`
+	UserID           int64  `json:"user_id"`
+	ContentText      string `json:"content_text"`
+	ContentImagePath string `json:"content_image_path"`
+	CreatedAt        string `json:"created_at"`
+	ExpiresAt        string `json:"expires_at"`
+}
+
+type StoriesTrayResponse struct {
+	Items []StoriesTrayItemResponse `json:"items"`
+}
+
+type StoriesTrayItemResponse struct {
+	UserID          int64   `json:"user_id"`
+	StoriesIds      []int64 `json:"stories_ids"`
+	LatestCreatedAt string  `json:"latest_created_at"`
+	Seen            bool    `json:"seen"`
+}
+
+type StoryViewersResponse struct {
+	Viewers []StoryViewerResponse `json:"viewers"`
+}
+
+type StoryViewerResponse struct {
+	UserID   int64  `json:"user_id"`
+	ViewedAt string `json:"viewed_at"`
+}
+
+type NewsfeedResponse struct {
+	PostsIds []int64 `json:"posts_ids"`
+}
+
+type SessionsResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+type SessionResponse struct {
+	SessionID string `json:"session_id"`
+	CreatedAt string `json:"created_at"`
+	LastSeen  string `json:"last_seen"`
+	IP        string `json:"ip"`
+	UserAgent string `json:"user_agent"`
+	Current   bool   `json:"current"`
+}
+
+type LoginResponse struct {
+	Message            string         `json:"message"`
+	User               UserDetailInfo `json:"user"`
+	TwoFactorChallenge string         `json:"two_factor_challenge,omitempty"`
+	// DeletionScheduledAt is set when the account is scheduled for deletion
+	DeletionScheduledAt string `json:"deletion_scheduled_at,omitempty"`
+}
+
+type TwoFactorEnrollmentResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type TokenResponse struct {
+	Message      string `json:"message"`
+	AccessToken  string `json:"access_token,omitempty"`
+	TokenType    string `json:"token_type,omitempty"`
+	ExpiresIn    int64  `json:"expires_in,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+}
+
+type OAuthClientsResponse struct {
+	Clients []OAuthClientResponse `json:"clients"`
+}
+
+type OAuthClientResponse struct {
+	ClientID     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret,omitempty"`
+	Name         string   `json:"name"`
+	RedirectURIs []string `json:"redirect_uris"`
+	Scope        string   `json:"scope"`
+	Confidential bool     `json:"confidential"`
+	CreatedAt    string   `json:"created_at"`
+}
+
+type OAuthAuthorizationInfoResponse struct {
+	ClientID     string `json:"client_id"`
+	ClientName   string `json:"client_name"`
+	RedirectURI  string `json:"redirect_uri"`
+	Scope        string `json:"scope"`
+	ConsentToken string `json:"consent_token"`
+}
+
+type OAuthAuthorizeResponse struct {
+	RedirectURI string `json:"redirect_uri"`
+}
+
+type OAuthTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope"`
+}
+
+type OAuthErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+type UserDetailInfo struct {
+	UserID          int64  `json:"user_id"`
+	UserName        string `json:"user_name"`
+	FirstName       string `json:"first_name"`
+	LastName        string `json:"last_name"`
+	DateOfBirth     string `json:"date_of_birth"`
+	Email           string `json:"email"`
+	EmailVerified   bool   `json:"email_verified"`
+	ProfilePicture  string `json:"profile_picture"`
+	CoverPicture    string `json:"cover_picture"`
+	IsPrivate       bool   `json:"is_private"`
+	FollowersCount  int64  `json:"followers_count"`
+	FollowingsCount int64  `json:"followings_count"`
+	PostsCount      int64  `json:"posts_count"`
+	Bio             string `json:"bio"`
+	Location        string `json:"location"`
+	Website         string `json:"website"`
+	Pronouns        string `json:"pronouns"`
+	// ProfileVisibility is only returned to the user itself
+	ProfileVisibility *ProfileVisibility `json:"profile_visibility,omitempty"`
+}
+
+// ProfileVisibility tells who can see each profile field: public, followers or only_me
+type ProfileVisibility struct {
+	Email       string `json:"email"`
+	DateOfBirth string `json:"date_of_birth"`
+	Bio         string `json:"bio"`
+	Location    string `json:"location"`
+	Website     string `json:"website"`
+	Pronouns    string `json:"pronouns"`
 }
 
 type GetS3PresignedUrlResponse struct {
 	Url            string    `json:"url"`
 	ExpirationTime time.Time `json:"expiration_time"`
 }
+
+type AccountDeletionResponse struct {
+	Message             string `json:"message"`
+	DeletionScheduledAt string `json:"deletion_scheduled_at,omitempty"`
+}
+
+type DataExportResponse struct {
+	Message              string `json:"message"`
+	State                string `json:"state,omitempty"` // pending, ready, failed
+	CreatedAt            string `json:"created_at,omitempty"`
+	CompletedAt          string `json:"completed_at,omitempty"`
+	DownloadURL          string `json:"download_url,omitempty"`
+	DownloadURLExpiresAt string `json:"download_url_expires_at,omitempty"`
+	ExpiresAt            string `json:"expires_at,omitempty"`
+}
@@ -0,0 +1,278 @@
+package unfurl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+const (
+	DefaultTimeout     = 5 * time.Second
+	DefaultMaxBodySize = 1 << 20 // 1 MiB
+
+	maxRedirects      = 3
+	maxURLLength      = 2000
+	maxTitleLength    = 300
+	maxDescLength     = 1000
+	maxImageURLLength = 1000
+)
+
+var (
+	ErrNotHTML           = errors.New("unfurl: response is not html")
+	ErrUnsupportedScheme = errors.New("unfurl: unsupported url scheme")
+	ErrForbiddenAddress  = errors.New("unfurl: address is not allowed")
+	ErrNoMetadata        = errors.New("unfurl: no metadata found")
+)
+
+var urlPattern = regexp.MustCompile(`https?://[^\s<>"']+`)
+
+// nonPublicNetworks are special-purpose ranges which are not covered by the net.IP predicates
+var nonPublicNetworks = mustParseCIDRs(
+	"0.0.0.0/8",     // "this" network
+	"100.64.0.0/10", // carrier-grade NAT
+	"192.0.0.0/24",  // IETF protocol assignments
+	"198.18.0.0/15", // benchmarking
+	"240.0.0.0/4",   // reserved, including broadcast
+	"64:ff9b::/96",  // NAT64, embeds IPv4 addresses
+)
+
+// Preview holds OpenGraph/Twitter Card metadata of a web page
+type Preview struct {
+	URL         string
+	Title       string
+	Description string
+	ImageURL    string
+}
+
+// Fetcher downloads web pages and extracts their previews.
+// Connections to loopback, private, link-local and other non-public addresses are refused
+// unless AllowPrivateNetworks is set.
+type Fetcher struct {
+	Timeout              time.Duration
+	MaxBodySize          int64
+	AllowPrivateNetworks bool
+
+	client *http.Client
+}
+
+func NewFetcher(timeout time.Duration, maxBodySize int64) *Fetcher {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	if maxBodySize <= 0 {
+		maxBodySize = DefaultMaxBodySize
+	}
+	f := &Fetcher{
+		Timeout:     timeout,
+		MaxBodySize: maxBodySize,
+	}
+
+	// The address is checked after DNS resolution so that hostnames pointing to private
+	// addresses and redirects to them are refused as well
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, c syscall.RawConn) error {
+			if f.AllowPrivateNetworks {
+				return nil
+			}
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !isPublicIP(ip) {
+				return ErrForbiddenAddress
+			}
+			return nil
+		},
+	}
+	f.client = &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   timeout,
+			ResponseHeaderTimeout: timeout,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       30 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return errors.New("unfurl: too many redirects")
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return ErrUnsupportedScheme
+			}
+			return nil
+		},
+	}
+	return f
+}
+
+// FirstURL returns the first http(s) url found in text, or empty string if there is none.
+// Urls longer than maxURLLength are ignored as they can not be stored.
+func FirstURL(text string) string {
+	rawURL := urlPattern.FindString(text)
+	rawURL = strings.TrimRight(rawURL, ".,;:!?)]}")
+	if rawURL == "" {
+		return ""
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" || len(u.String()) > maxURLLength {
+		return ""
+	}
+	return u.String()
+}
+
+// Fetch downloads the page at rawURL and extracts its preview
+func (f *Fetcher) Fetch(ctx context.Context, rawURL string) (*Preview, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, ErrUnsupportedScheme
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, f.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/html")
+	req.Header.Set("User-Agent", "xsocial-unfurl/1.0")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unfurl: unexpected status %d", resp.StatusCode)
+	}
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil || (mediaType != "text/html" && mediaType != "application/xhtml+xml") {
+		return nil, ErrNotHTML
+	}
+
+	preview := parse(io.LimitReader(resp.Body, f.MaxBodySize), resp.Request.URL)
+	if preview.Title == "" && preview.Description == "" && preview.ImageURL == "" {
+		return nil, ErrNoMetadata
+	}
+	preview.URL = u.String()
+	return preview, nil
+}
+
+// parse reads meta tags of the html document. OpenGraph tags take precedence over
+// Twitter Card tags, which take precedence over <title> and the description meta tag.
+func parse(r io.Reader, base *url.URL) *Preview {
+	meta := make(map[string]string)
+	var title string
+
+	tokenizer := html.NewTokenizer(r)
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			break
+		}
+		token := tokenizer.Token()
+		if token.Type == html.EndTagToken && token.Data == "head" {
+			break
+		}
+		if token.Type != html.StartTagToken && token.Type != html.SelfClosingTagToken {
+			continue
+		}
+
+		switch token.Data {
+		case "title":
+			if title == "" && tokenizer.Next() == html.TextToken {
+				title = string(tokenizer.Text())
+			}
+		case "meta":
+			var key, content string
+			for _, attr := range token.Attr {
+				switch attr.Key {
+				case "property", "name":
+					key = strings.ToLower(strings.TrimSpace(attr.Val))
+				case "content":
+					content = attr.Val
+				}
+			}
+			if _, ok := meta[key]; key != "" && !ok {
+				meta[key] = content
+			}
+		}
+	}
+
+	preview := &Preview{
+		Title:       firstNonEmpty(meta["og:title"], meta["twitter:title"], title),
+		Description: firstNonEmpty(meta["og:description"], meta["twitter:description"], meta["description"]),
+	}
+	preview.Title = truncate(preview.Title, maxTitleLength)
+	preview.Description = truncate(preview.Description, maxDescLength)
+
+	image := firstNonEmpty(meta["og:image:secure_url"], meta["og:image"], meta["twitter:image"], meta["twitter:image:src"])
+	if image != "" {
+		imageURL, err := base.Parse(image)
+		if err == nil && (imageURL.Scheme == "http" || imageURL.Scheme == "https") && len(imageURL.String()) <= maxImageURLLength {
+			preview.ImageURL = imageURL.String()
+		}
+	}
+	return preview
+}
+
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		value = strings.Join(strings.Fields(value), " ")
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func truncate(s string, maxLength int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLength {
+		return s
+	}
+	return string(runes[:maxLength])
+}
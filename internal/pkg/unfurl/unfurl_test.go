@@ -0,0 +1,219 @@
+package unfurl
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip     string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"0.1.2.3", false},
+		{"192.0.0.8", false},
+		{"198.18.0.1", false},
+		{"198.19.255.255", false},
+		{"240.0.0.1", false},
+		{"255.255.255.255", false},
+		{"224.0.0.1", false},
+		{"::1", false},
+		{"::", false},
+		{"fc00::1", false},
+		{"fe80::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"::ffff:10.0.0.1", false},
+		{"64:ff9b::7f00:1", false},
+		{"64:ff9b::a9fe:a9fe", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			ip := net.ParseIP(tt.ip)
+			if ip == nil {
+				t.Fatalf("invalid test ip %q", tt.ip)
+			}
+			if got := isPublicIP(ip); got != tt.public {
+				t.Errorf("isPublicIP(%s) = %v, want %v", tt.ip, got, tt.public)
+			}
+		})
+	}
+}
+
+func TestFirstURL(t *testing.T) {
+	longURL := "https://example.com/" + strings.Repeat("a", maxURLLength)
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"no url", "hello world", ""},
+		{"single url", "look at https://example.com/page now", "https://example.com/page"},
+		{"first of many", "http://a.example.com and https://b.example.com", "http://a.example.com"},
+		{"trailing punctuation", "see https://example.com/page.", "https://example.com/page"},
+		{"unsupported scheme", "ftp://example.com/file", ""},
+		{"too long", "read " + longURL, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FirstURL(tt.text); got != tt.want {
+				t.Errorf("FirstURL(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetchRefusesPrivateAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request reached a private address")
+	}))
+	defer server.Close()
+
+	f := NewFetcher(time.Second, DefaultMaxBodySize)
+	_, err := f.Fetch(context.Background(), server.URL)
+	if !errors.Is(err, ErrForbiddenAddress) {
+		t.Fatalf("Fetch() error = %v, want %v", err, ErrForbiddenAddress)
+	}
+}
+
+func TestFetchRefusesRedirectToPrivateAddress(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request reached a private address")
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusFound))
+	defer server.Close()
+
+	// The first hop is allowed, the redirect target is checked when it is dialed
+	f := NewFetcher(time.Second, DefaultMaxBodySize)
+	f.AllowPrivateNetworks = true
+	f.client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		f.AllowPrivateNetworks = false
+		return nil
+	}
+	_, err := f.Fetch(context.Background(), server.URL)
+	if !errors.Is(err, ErrForbiddenAddress) {
+		t.Fatalf("Fetch() error = %v, want %v", err, ErrForbiddenAddress)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		maxBodySize int64
+		want        *Preview
+		wantErr     error
+	}{
+		{
+			name:        "opengraph",
+			contentType: "text/html; charset=utf-8",
+			body: `<html><head>
+				<title>Page title</title>
+				<meta property="og:title" content="OG title">
+				<meta property="og:description" content="OG   description">
+				<meta property="og:image" content="/image.png">
+				<meta name="twitter:title" content="Twitter title">
+				</head><body></body></html>`,
+			want: &Preview{Title: "OG title", Description: "OG description", ImageURL: "/image.png"},
+		},
+		{
+			name:        "twitter card and title fallback",
+			contentType: "text/html",
+			body: `<html><head>
+				<title>Page title</title>
+				<meta name="twitter:description" content="Twitter description">
+				<meta name="twitter:image" content="javascript:alert(1)">
+				</head></html>`,
+			want: &Preview{Title: "Page title", Description: "Twitter description"},
+		},
+		{
+			name:        "not html",
+			contentType: "application/json",
+			body:        `{"title": "json"}`,
+			wantErr:     ErrNotHTML,
+		},
+		{
+			name:        "no metadata",
+			contentType: "text/html",
+			body:        `<html><head></head><body>nothing</body></html>`,
+			wantErr:     ErrNoMetadata,
+		},
+		{
+			name:        "body size cap",
+			contentType: "text/html",
+			body:        `<html><head>` + strings.Repeat(" ", 2048) + `<meta property="og:title" content="Too far"></head></html>`,
+			maxBodySize: 1024,
+			wantErr:     ErrNoMetadata,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			f := NewFetcher(time.Second, tt.maxBodySize)
+			f.AllowPrivateNetworks = true
+			got, err := f.Fetch(context.Background(), server.URL)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Fetch() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Fetch() error = %v", err)
+			}
+
+			want := *tt.want
+			want.URL = server.URL
+			if want.ImageURL != "" {
+				want.ImageURL = server.URL + want.ImageURL
+			}
+			if *got != want {
+				t.Errorf("Fetch() = %+v, want %+v", *got, want)
+			}
+		})
+	}
+}
+
+func TestFetchTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	f := NewFetcher(100*time.Millisecond, DefaultMaxBodySize)
+	f.AllowPrivateNetworks = true
+	start := time.Now()
+	_, err := f.Fetch(context.Background(), server.URL)
+	if err == nil {
+		t.Fatal("Fetch() error = nil, want timeout")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Fetch() took %v, want about %v", elapsed, f.Timeout)
+	}
+}
@@ -100,3 +100,167 @@ func (a *randomClient) LikePost(ctx context.Context, in *pb.LikePostRequest, opt
 func (a *randomClient) GetS3PresignedUrl(ctx context.Context, in *pb.GetS3PresignedUrlRequest, opts ...grpc.CallOption) (*pb.GetS3PresignedUrlResponse, error) {
 	return a.clients[rand.Intn(len(a.clients))].GetS3PresignedUrl(ctx, in, opts...)
 }
+
+func (a *randomClient) PinPost(ctx context.Context, in *pb.PinPostRequest, opts ...grpc.CallOption) (*pb.PinPostResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].PinPost(ctx, in, opts...)
+}
+
+func (a *randomClient) UnpinPost(ctx context.Context, in *pb.UnpinPostRequest, opts ...grpc.CallOption) (*pb.UnpinPostResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].UnpinPost(ctx, in, opts...)
+}
+
+func (a *randomClient) VotePoll(ctx context.Context, in *pb.VotePollRequest, opts ...grpc.CallOption) (*pb.VotePollResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].VotePoll(ctx, in, opts...)
+}
+
+func (a *randomClient) GetPollResults(ctx context.Context, in *pb.GetPollResultsRequest, opts ...grpc.CallOption) (*pb.GetPollResultsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetPollResults(ctx, in, opts...)
+}
+
+func (a *randomClient) SavePost(ctx context.Context, in *pb.SavePostRequest, opts ...grpc.CallOption) (*pb.SavePostResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].SavePost(ctx, in, opts...)
+}
+
+func (a *randomClient) UnsavePost(ctx context.Context, in *pb.UnsavePostRequest, opts ...grpc.CallOption) (*pb.UnsavePostResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].UnsavePost(ctx, in, opts...)
+}
+
+func (a *randomClient) ListSavedPosts(ctx context.Context, in *pb.ListSavedPostsRequest, opts ...grpc.CallOption) (*pb.ListSavedPostsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ListSavedPosts(ctx, in, opts...)
+}
+
+func (a *randomClient) CreateStory(ctx context.Context, in *pb.CreateStoryRequest, opts ...grpc.CallOption) (*pb.CreateStoryResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].CreateStory(ctx, in, opts...)
+}
+
+func (a *randomClient) GetStory(ctx context.Context, in *pb.GetStoryRequest, opts ...grpc.CallOption) (*pb.GetStoryResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetStory(ctx, in, opts...)
+}
+
+func (a *randomClient) DeleteStory(ctx context.Context, in *pb.DeleteStoryRequest, opts ...grpc.CallOption) (*pb.DeleteStoryResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].DeleteStory(ctx, in, opts...)
+}
+
+func (a *randomClient) GetStoriesTray(ctx context.Context, in *pb.GetStoriesTrayRequest, opts ...grpc.CallOption) (*pb.GetStoriesTrayResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetStoriesTray(ctx, in, opts...)
+}
+
+func (a *randomClient) GetStoryViewers(ctx context.Context, in *pb.GetStoryViewersRequest, opts ...grpc.CallOption) (*pb.GetStoryViewersResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetStoryViewers(ctx, in, opts...)
+}
+
+func (a *randomClient) SendVerificationEmail(ctx context.Context, in *pb.SendVerificationEmailRequest, opts ...grpc.CallOption) (*pb.SendVerificationEmailResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].SendVerificationEmail(ctx, in, opts...)
+}
+
+func (a *randomClient) VerifyEmail(ctx context.Context, in *pb.VerifyEmailRequest, opts ...grpc.CallOption) (*pb.VerifyEmailResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].VerifyEmail(ctx, in, opts...)
+}
+
+func (a *randomClient) RequestPasswordReset(ctx context.Context, in *pb.RequestPasswordResetRequest, opts ...grpc.CallOption) (*pb.RequestPasswordResetResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].RequestPasswordReset(ctx, in, opts...)
+}
+
+func (a *randomClient) ConfirmPasswordReset(ctx context.Context, in *pb.ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*pb.ConfirmPasswordResetResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ConfirmPasswordReset(ctx, in, opts...)
+}
+
+func (a *randomClient) EnrollTwoFactor(ctx context.Context, in *pb.EnrollTwoFactorRequest, opts ...grpc.CallOption) (*pb.EnrollTwoFactorResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].EnrollTwoFactor(ctx, in, opts...)
+}
+
+func (a *randomClient) EnableTwoFactor(ctx context.Context, in *pb.EnableTwoFactorRequest, opts ...grpc.CallOption) (*pb.EnableTwoFactorResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].EnableTwoFactor(ctx, in, opts...)
+}
+
+func (a *randomClient) DisableTwoFactor(ctx context.Context, in *pb.DisableTwoFactorRequest, opts ...grpc.CallOption) (*pb.DisableTwoFactorResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].DisableTwoFactor(ctx, in, opts...)
+}
+
+func (a *randomClient) VerifyTwoFactor(ctx context.Context, in *pb.VerifyTwoFactorRequest, opts ...grpc.CallOption) (*pb.VerifyTwoFactorResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].VerifyTwoFactor(ctx, in, opts...)
+}
+
+func (a *randomClient) RegenerateRecoveryCodes(ctx context.Context, in *pb.RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*pb.RegenerateRecoveryCodesResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].RegenerateRecoveryCodes(ctx, in, opts...)
+}
+
+func (a *randomClient) DeleteUser(ctx context.Context, in *pb.DeleteUserRequest, opts ...grpc.CallOption) (*pb.DeleteUserResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].DeleteUser(ctx, in, opts...)
+}
+
+func (a *randomClient) CancelUserDeletion(ctx context.Context, in *pb.CancelUserDeletionRequest, opts ...grpc.CallOption) (*pb.CancelUserDeletionResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].CancelUserDeletion(ctx, in, opts...)
+}
+
+func (a *randomClient) RequestDataExport(ctx context.Context, in *pb.RequestDataExportRequest, opts ...grpc.CallOption) (*pb.RequestDataExportResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].RequestDataExport(ctx, in, opts...)
+}
+
+func (a *randomClient) GetDataExport(ctx context.Context, in *pb.GetDataExportRequest, opts ...grpc.CallOption) (*pb.GetDataExportResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetDataExport(ctx, in, opts...)
+}
+
+func (a *randomClient) BlockUser(ctx context.Context, in *pb.BlockUserRequest, opts ...grpc.CallOption) (*pb.BlockUserResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].BlockUser(ctx, in, opts...)
+}
+
+func (a *randomClient) UnblockUser(ctx context.Context, in *pb.UnblockUserRequest, opts ...grpc.CallOption) (*pb.UnblockUserResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].UnblockUser(ctx, in, opts...)
+}
+
+func (a *randomClient) MuteUser(ctx context.Context, in *pb.MuteUserRequest, opts ...grpc.CallOption) (*pb.MuteUserResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].MuteUser(ctx, in, opts...)
+}
+
+func (a *randomClient) UnmuteUser(ctx context.Context, in *pb.UnmuteUserRequest, opts ...grpc.CallOption) (*pb.UnmuteUserResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].UnmuteUser(ctx, in, opts...)
+}
+
+func (a *randomClient) GetFollowRequests(ctx context.Context, in *pb.GetFollowRequestsRequest, opts ...grpc.CallOption) (*pb.GetFollowRequestsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetFollowRequests(ctx, in, opts...)
+}
+
+func (a *randomClient) ApproveFollowRequest(ctx context.Context, in *pb.ApproveFollowRequestRequest, opts ...grpc.CallOption) (*pb.ApproveFollowRequestResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].ApproveFollowRequest(ctx, in, opts...)
+}
+
+func (a *randomClient) RejectFollowRequest(ctx context.Context, in *pb.RejectFollowRequestRequest, opts ...grpc.CallOption) (*pb.RejectFollowRequestResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].RejectFollowRequest(ctx, in, opts...)
+}
+
+func (a *randomClient) GetRelationship(ctx context.Context, in *pb.GetRelationshipRequest, opts ...grpc.CallOption) (*pb.GetRelationshipResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetRelationship(ctx, in, opts...)
+}
+
+func (a *randomClient) GetFollowSuggestions(ctx context.Context, in *pb.GetFollowSuggestionsRequest, opts ...grpc.CallOption) (*pb.GetFollowSuggestionsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetFollowSuggestions(ctx, in, opts...)
+}
+
+func (a *randomClient) CreateAudienceList(ctx context.Context, in *pb.CreateAudienceListRequest, opts ...grpc.CallOption) (*pb.CreateAudienceListResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].CreateAudienceList(ctx, in, opts...)
+}
+
+func (a *randomClient) DeleteAudienceList(ctx context.Context, in *pb.DeleteAudienceListRequest, opts ...grpc.CallOption) (*pb.DeleteAudienceListResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].DeleteAudienceList(ctx, in, opts...)
+}
+
+func (a *randomClient) GetAudienceLists(ctx context.Context, in *pb.GetAudienceListsRequest, opts ...grpc.CallOption) (*pb.GetAudienceListsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetAudienceLists(ctx, in, opts...)
+}
+
+func (a *randomClient) GetAudienceList(ctx context.Context, in *pb.GetAudienceListRequest, opts ...grpc.CallOption) (*pb.GetAudienceListResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetAudienceList(ctx, in, opts...)
+}
+
+func (a *randomClient) AddAudienceListMember(ctx context.Context, in *pb.AddAudienceListMemberRequest, opts ...grpc.CallOption) (*pb.AddAudienceListMemberResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].AddAudienceListMember(ctx, in, opts...)
+}
+
+func (a *randomClient) RemoveAudienceListMember(ctx context.Context, in *pb.RemoveAudienceListMemberRequest, opts ...grpc.CallOption) (*pb.RemoveAudienceListMemberResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].RemoveAudienceListMember(ctx, in, opts...)
+}
+
+func (a *randomClient) SearchUsers(ctx context.Context, in *pb.SearchUsersRequest, opts ...grpc.CallOption) (*pb.SearchUsersResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].SearchUsers(ctx, in, opts...)
+}
@@ -0,0 +1,56 @@
+package messenger
+
+import (
+	"context"
+	"log"
+	"math/rand"
+
+	pb_msg "github.com/maxuanquang/social-network/pkg/types/proto/pb/messenger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func NewClient(hosts []string) (pb_msg.MessengerClient, error) {
+	var opts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	clients := make([]pb_msg.MessengerClient, 0, len(hosts))
+	for _, host := range hosts {
+		conn, err := grpc.Dial(host, opts...)
+		if err != nil {
+			log.Fatalf("fail to dial: %v", err)
+			return nil, err
+		}
+
+		client := pb_msg.NewMessengerClient(conn)
+		clients = append(clients, client)
+	}
+
+	return &randomClient{clients: clients}, nil
+}
+
+type randomClient struct {
+	clients []pb_msg.MessengerClient
+}
+
+func (a *randomClient) SendMessage(ctx context.Context, in *pb_msg.SendMessageRequest, opts ...grpc.CallOption) (*pb_msg.SendMessageResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].SendMessage(ctx, in, opts...)
+}
+
+func (a *randomClient) GetConversations(ctx context.Context, in *pb_msg.GetConversationsRequest, opts ...grpc.CallOption) (*pb_msg.GetConversationsResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetConversations(ctx, in, opts...)
+}
+
+func (a *randomClient) GetMessages(ctx context.Context, in *pb_msg.GetMessagesRequest, opts ...grpc.CallOption) (*pb_msg.GetMessagesResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].GetMessages(ctx, in, opts...)
+}
+
+func (a *randomClient) MarkConversationRead(ctx context.Context, in *pb_msg.MarkConversationReadRequest, opts ...grpc.CallOption) (*pb_msg.MarkConversationReadResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].MarkConversationRead(ctx, in, opts...)
+}
+
+func (a *randomClient) DeleteMessage(ctx context.Context, in *pb_msg.DeleteMessageRequest, opts ...grpc.CallOption) (*pb_msg.DeleteMessageResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].DeleteMessage(ctx, in, opts...)
+}
+
+func (a *randomClient) DeleteConversation(ctx context.Context, in *pb_msg.DeleteConversationRequest, opts ...grpc.CallOption) (*pb_msg.DeleteConversationResponse, error) {
+	return a.clients[rand.Intn(len(a.clients))].DeleteConversation(ctx, in, opts...)
+}
@@ -24,9 +24,12 @@ const (
 type CheckUserAuthenticationResponse_CheckUserAuthenticationStatus int32
 
 const (
-	CheckUserAuthenticationResponse_OK             CheckUserAuthenticationResponse_CheckUserAuthenticationStatus = 0
-	CheckUserAuthenticationResponse_USER_NOT_FOUND CheckUserAuthenticationResponse_CheckUserAuthenticationStatus = 1
-	CheckUserAuthenticationResponse_WRONG_PASSWORD CheckUserAuthenticationResponse_CheckUserAuthenticationStatus = 2
+	CheckUserAuthenticationResponse_OK                  CheckUserAuthenticationResponse_CheckUserAuthenticationStatus = 0
+	CheckUserAuthenticationResponse_USER_NOT_FOUND      CheckUserAuthenticationResponse_CheckUserAuthenticationStatus = 1
+	CheckUserAuthenticationResponse_WRONG_PASSWORD      CheckUserAuthenticationResponse_CheckUserAuthenticationStatus = 2
+	CheckUserAuthenticationResponse_ACCOUNT_LOCKED      CheckUserAuthenticationResponse_CheckUserAuthenticationStatus = 3
+	CheckUserAuthenticationResponse_TWO_FACTOR_REQUIRED CheckUserAuthenticationResponse_CheckUserAuthenticationStatus = 4
+	CheckUserAuthenticationResponse_WRONG_OTP_CODE      CheckUserAuthenticationResponse_CheckUserAuthenticationStatus = 5
 )
 
 // Enum value maps for CheckUserAuthenticationResponse_CheckUserAuthenticationStatus.
@@ -35,11 +38,17 @@ var (
 		0: "OK",
 		1: "USER_NOT_FOUND",
 		2: "WRONG_PASSWORD",
+		3: "ACCOUNT_LOCKED",
+		4: "TWO_FACTOR_REQUIRED",
+		5: "WRONG_OTP_CODE",
 	}
 	CheckUserAuthenticationResponse_CheckUserAuthenticationStatus_value = map[string]int32{
-		"OK":             0,
-		"USER_NOT_FOUND": 1,
-		"WRONG_PASSWORD": 2,
+		"OK":                  0,
+		"USER_NOT_FOUND":      1,
+		"WRONG_PASSWORD":      2,
+		"ACCOUNT_LOCKED":      3,
+		"TWO_FACTOR_REQUIRED": 4,
+		"WRONG_OTP_CODE":      5,
 	}
 )
 
@@ -122,8 +131,12 @@ func (CreateUserResponse_CreateUserStatus) EnumDescriptor() ([]byte, []int) {
 type EditUserResponse_EditUserStatus int32
 
 const (
-	EditUserResponse_OK             EditUserResponse_EditUserStatus = 0
-	EditUserResponse_USER_NOT_FOUND EditUserResponse_EditUserStatus = 1
+	EditUserResponse_OK                       EditUserResponse_EditUserStatus = 0
+	EditUserResponse_USER_NOT_FOUND           EditUserResponse_EditUserStatus = 1
+	EditUserResponse_TWO_FACTOR_REQUIRED      EditUserResponse_EditUserStatus = 2
+	EditUserResponse_WRONG_OTP_CODE           EditUserResponse_EditUserStatus = 3
+	EditUserResponse_USERNAME_EXISTED         EditUserResponse_EditUserStatus = 4
+	EditUserResponse_USERNAME_CHANGE_TOO_SOON EditUserResponse_EditUserStatus = 5
 )
 
 // Enum value maps for EditUserResponse_EditUserStatus.
@@ -131,10 +144,18 @@ var (
 	EditUserResponse_EditUserStatus_name = map[int32]string{
 		0: "OK",
 		1: "USER_NOT_FOUND",
+		2: "TWO_FACTOR_REQUIRED",
+		3: "WRONG_OTP_CODE",
+		4: "USERNAME_EXISTED",
+		5: "USERNAME_CHANGE_TOO_SOON",
 	}
 	EditUserResponse_EditUserStatus_value = map[string]int32{
-		"OK":             0,
-		"USER_NOT_FOUND": 1,
+		"OK":                       0,
+		"USER_NOT_FOUND":           1,
+		"TWO_FACTOR_REQUIRED":      2,
+		"WRONG_OTP_CODE":           3,
+		"USERNAME_EXISTED":         4,
+		"USERNAME_CHANGE_TOO_SOON": 5,
 	}
 )
 
@@ -211,2578 +232,2783 @@ func (GetUserDetailInfoResponse_GetUserDetailInfoStatus) EnumDescriptor() ([]byt
 	return file_authen_and_post_proto_rawDescGZIP(), []int{7, 0}
 }
 
-type GetUserFollowerResponse_GetUserFollowerStatus int32
+type ProfileVisibility_Visibility int32
 
 const (
-	GetUserFollowerResponse_OK             GetUserFollowerResponse_GetUserFollowerStatus = 0
-	GetUserFollowerResponse_USER_NOT_FOUND GetUserFollowerResponse_GetUserFollowerStatus = 1
+	ProfileVisibility_PUBLIC    ProfileVisibility_Visibility = 0
+	ProfileVisibility_FOLLOWERS ProfileVisibility_Visibility = 1
+	ProfileVisibility_ONLY_ME   ProfileVisibility_Visibility = 2
 )
 
-// Enum value maps for GetUserFollowerResponse_GetUserFollowerStatus.
+// Enum value maps for ProfileVisibility_Visibility.
 var (
-	GetUserFollowerResponse_GetUserFollowerStatus_name = map[int32]string{
-		0: "OK",
-		1: "USER_NOT_FOUND",
+	ProfileVisibility_Visibility_name = map[int32]string{
+		0: "PUBLIC",
+		1: "FOLLOWERS",
+		2: "ONLY_ME",
 	}
-	GetUserFollowerResponse_GetUserFollowerStatus_value = map[string]int32{
-		"OK":             0,
-		"USER_NOT_FOUND": 1,
+	ProfileVisibility_Visibility_value = map[string]int32{
+		"PUBLIC":    0,
+		"FOLLOWERS": 1,
+		"ONLY_ME":   2,
 	}
 )
 
-func (x GetUserFollowerResponse_GetUserFollowerStatus) Enum() *GetUserFollowerResponse_GetUserFollowerStatus {
-	p := new(GetUserFollowerResponse_GetUserFollowerStatus)
+func (x ProfileVisibility_Visibility) Enum() *ProfileVisibility_Visibility {
+	p := new(ProfileVisibility_Visibility)
 	*p = x
 	return p
 }
 
-func (x GetUserFollowerResponse_GetUserFollowerStatus) String() string {
+func (x ProfileVisibility_Visibility) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (GetUserFollowerResponse_GetUserFollowerStatus) Descriptor() protoreflect.EnumDescriptor {
+func (ProfileVisibility_Visibility) Descriptor() protoreflect.EnumDescriptor {
 	return file_authen_and_post_proto_enumTypes[4].Descriptor()
 }
 
-func (GetUserFollowerResponse_GetUserFollowerStatus) Type() protoreflect.EnumType {
+func (ProfileVisibility_Visibility) Type() protoreflect.EnumType {
 	return &file_authen_and_post_proto_enumTypes[4]
 }
 
-func (x GetUserFollowerResponse_GetUserFollowerStatus) Number() protoreflect.EnumNumber {
+func (x ProfileVisibility_Visibility) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use GetUserFollowerResponse_GetUserFollowerStatus.Descriptor instead.
-func (GetUserFollowerResponse_GetUserFollowerStatus) EnumDescriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{10, 0}
+// Deprecated: Use ProfileVisibility_Visibility.Descriptor instead.
+func (ProfileVisibility_Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{9, 0}
 }
 
-type GetUserFollowingResponse_GetUserFollowingStatus int32
+type SendVerificationEmailResponse_SendVerificationEmailStatus int32
 
 const (
-	GetUserFollowingResponse_OK             GetUserFollowingResponse_GetUserFollowingStatus = 0
-	GetUserFollowingResponse_USER_NOT_FOUND GetUserFollowingResponse_GetUserFollowingStatus = 1
+	SendVerificationEmailResponse_OK               SendVerificationEmailResponse_SendVerificationEmailStatus = 0
+	SendVerificationEmailResponse_USER_NOT_FOUND   SendVerificationEmailResponse_SendVerificationEmailStatus = 1
+	SendVerificationEmailResponse_ALREADY_VERIFIED SendVerificationEmailResponse_SendVerificationEmailStatus = 2
 )
 
-// Enum value maps for GetUserFollowingResponse_GetUserFollowingStatus.
+// Enum value maps for SendVerificationEmailResponse_SendVerificationEmailStatus.
 var (
-	GetUserFollowingResponse_GetUserFollowingStatus_name = map[int32]string{
+	SendVerificationEmailResponse_SendVerificationEmailStatus_name = map[int32]string{
 		0: "OK",
 		1: "USER_NOT_FOUND",
+		2: "ALREADY_VERIFIED",
 	}
-	GetUserFollowingResponse_GetUserFollowingStatus_value = map[string]int32{
-		"OK":             0,
-		"USER_NOT_FOUND": 1,
+	SendVerificationEmailResponse_SendVerificationEmailStatus_value = map[string]int32{
+		"OK":               0,
+		"USER_NOT_FOUND":   1,
+		"ALREADY_VERIFIED": 2,
 	}
 )
 
-func (x GetUserFollowingResponse_GetUserFollowingStatus) Enum() *GetUserFollowingResponse_GetUserFollowingStatus {
-	p := new(GetUserFollowingResponse_GetUserFollowingStatus)
+func (x SendVerificationEmailResponse_SendVerificationEmailStatus) Enum() *SendVerificationEmailResponse_SendVerificationEmailStatus {
+	p := new(SendVerificationEmailResponse_SendVerificationEmailStatus)
 	*p = x
 	return p
 }
 
-func (x GetUserFollowingResponse_GetUserFollowingStatus) String() string {
+func (x SendVerificationEmailResponse_SendVerificationEmailStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (GetUserFollowingResponse_GetUserFollowingStatus) Descriptor() protoreflect.EnumDescriptor {
+func (SendVerificationEmailResponse_SendVerificationEmailStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_authen_and_post_proto_enumTypes[5].Descriptor()
 }
 
-func (GetUserFollowingResponse_GetUserFollowingStatus) Type() protoreflect.EnumType {
+func (SendVerificationEmailResponse_SendVerificationEmailStatus) Type() protoreflect.EnumType {
 	return &file_authen_and_post_proto_enumTypes[5]
 }
 
-func (x GetUserFollowingResponse_GetUserFollowingStatus) Number() protoreflect.EnumNumber {
+func (x SendVerificationEmailResponse_SendVerificationEmailStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use GetUserFollowingResponse_GetUserFollowingStatus.Descriptor instead.
-func (GetUserFollowingResponse_GetUserFollowingStatus) EnumDescriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{12, 0}
+// Deprecated: Use SendVerificationEmailResponse_SendVerificationEmailStatus.Descriptor instead.
+func (SendVerificationEmailResponse_SendVerificationEmailStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{11, 0}
 }
 
-type FollowUserResponse_FollowUserStatus int32
+type VerifyEmailResponse_VerifyEmailStatus int32
 
 const (
-	FollowUserResponse_OK               FollowUserResponse_FollowUserStatus = 0
-	FollowUserResponse_USER_NOT_FOUND   FollowUserResponse_FollowUserStatus = 1
-	FollowUserResponse_ALREADY_FOLLOWED FollowUserResponse_FollowUserStatus = 2
+	VerifyEmailResponse_OK               VerifyEmailResponse_VerifyEmailStatus = 0
+	VerifyEmailResponse_INVALID_TOKEN    VerifyEmailResponse_VerifyEmailStatus = 1
+	VerifyEmailResponse_ALREADY_VERIFIED VerifyEmailResponse_VerifyEmailStatus = 2
 )
 
-// Enum value maps for FollowUserResponse_FollowUserStatus.
+// Enum value maps for VerifyEmailResponse_VerifyEmailStatus.
 var (
-	FollowUserResponse_FollowUserStatus_name = map[int32]string{
+	VerifyEmailResponse_VerifyEmailStatus_name = map[int32]string{
 		0: "OK",
-		1: "USER_NOT_FOUND",
-		2: "ALREADY_FOLLOWED",
+		1: "INVALID_TOKEN",
+		2: "ALREADY_VERIFIED",
 	}
-	FollowUserResponse_FollowUserStatus_value = map[string]int32{
+	VerifyEmailResponse_VerifyEmailStatus_value = map[string]int32{
 		"OK":               0,
-		"USER_NOT_FOUND":   1,
-		"ALREADY_FOLLOWED": 2,
+		"INVALID_TOKEN":    1,
+		"ALREADY_VERIFIED": 2,
 	}
 )
 
-func (x FollowUserResponse_FollowUserStatus) Enum() *FollowUserResponse_FollowUserStatus {
-	p := new(FollowUserResponse_FollowUserStatus)
+func (x VerifyEmailResponse_VerifyEmailStatus) Enum() *VerifyEmailResponse_VerifyEmailStatus {
+	p := new(VerifyEmailResponse_VerifyEmailStatus)
 	*p = x
 	return p
 }
 
-func (x FollowUserResponse_FollowUserStatus) String() string {
+func (x VerifyEmailResponse_VerifyEmailStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (FollowUserResponse_FollowUserStatus) Descriptor() protoreflect.EnumDescriptor {
+func (VerifyEmailResponse_VerifyEmailStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_authen_and_post_proto_enumTypes[6].Descriptor()
 }
 
-func (FollowUserResponse_FollowUserStatus) Type() protoreflect.EnumType {
+func (VerifyEmailResponse_VerifyEmailStatus) Type() protoreflect.EnumType {
 	return &file_authen_and_post_proto_enumTypes[6]
 }
 
-func (x FollowUserResponse_FollowUserStatus) Number() protoreflect.EnumNumber {
+func (x VerifyEmailResponse_VerifyEmailStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use FollowUserResponse_FollowUserStatus.Descriptor instead.
-func (FollowUserResponse_FollowUserStatus) EnumDescriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{14, 0}
+// Deprecated: Use VerifyEmailResponse_VerifyEmailStatus.Descriptor instead.
+func (VerifyEmailResponse_VerifyEmailStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{13, 0}
 }
 
-type UnfollowUserResponse_UnfollowUserStatus int32
+type RequestPasswordResetResponse_RequestPasswordResetStatus int32
 
 const (
-	UnfollowUserResponse_OK             UnfollowUserResponse_UnfollowUserStatus = 0
-	UnfollowUserResponse_USER_NOT_FOUND UnfollowUserResponse_UnfollowUserStatus = 1
-	UnfollowUserResponse_NOT_FOLLOWED   UnfollowUserResponse_UnfollowUserStatus = 2
+	RequestPasswordResetResponse_OK             RequestPasswordResetResponse_RequestPasswordResetStatus = 0
+	RequestPasswordResetResponse_USER_NOT_FOUND RequestPasswordResetResponse_RequestPasswordResetStatus = 1
 )
 
-// Enum value maps for UnfollowUserResponse_UnfollowUserStatus.
+// Enum value maps for RequestPasswordResetResponse_RequestPasswordResetStatus.
 var (
-	UnfollowUserResponse_UnfollowUserStatus_name = map[int32]string{
+	RequestPasswordResetResponse_RequestPasswordResetStatus_name = map[int32]string{
 		0: "OK",
 		1: "USER_NOT_FOUND",
-		2: "NOT_FOLLOWED",
 	}
-	UnfollowUserResponse_UnfollowUserStatus_value = map[string]int32{
+	RequestPasswordResetResponse_RequestPasswordResetStatus_value = map[string]int32{
 		"OK":             0,
 		"USER_NOT_FOUND": 1,
-		"NOT_FOLLOWED":   2,
 	}
 )
 
-func (x UnfollowUserResponse_UnfollowUserStatus) Enum() *UnfollowUserResponse_UnfollowUserStatus {
-	p := new(UnfollowUserResponse_UnfollowUserStatus)
+func (x RequestPasswordResetResponse_RequestPasswordResetStatus) Enum() *RequestPasswordResetResponse_RequestPasswordResetStatus {
+	p := new(RequestPasswordResetResponse_RequestPasswordResetStatus)
 	*p = x
 	return p
 }
 
-func (x UnfollowUserResponse_UnfollowUserStatus) String() string {
+func (x RequestPasswordResetResponse_RequestPasswordResetStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (UnfollowUserResponse_UnfollowUserStatus) Descriptor() protoreflect.EnumDescriptor {
+func (RequestPasswordResetResponse_RequestPasswordResetStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_authen_and_post_proto_enumTypes[7].Descriptor()
 }
 
-func (UnfollowUserResponse_UnfollowUserStatus) Type() protoreflect.EnumType {
+func (RequestPasswordResetResponse_RequestPasswordResetStatus) Type() protoreflect.EnumType {
 	return &file_authen_and_post_proto_enumTypes[7]
 }
 
-func (x UnfollowUserResponse_UnfollowUserStatus) Number() protoreflect.EnumNumber {
+func (x RequestPasswordResetResponse_RequestPasswordResetStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use UnfollowUserResponse_UnfollowUserStatus.Descriptor instead.
-func (UnfollowUserResponse_UnfollowUserStatus) EnumDescriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{16, 0}
+// Deprecated: Use RequestPasswordResetResponse_RequestPasswordResetStatus.Descriptor instead.
+func (RequestPasswordResetResponse_RequestPasswordResetStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{15, 0}
 }
 
-type GetUserPostsResponse_GetUserPostsStatus int32
+type ConfirmPasswordResetResponse_ConfirmPasswordResetStatus int32
 
 const (
-	GetUserPostsResponse_OK             GetUserPostsResponse_GetUserPostsStatus = 0
-	GetUserPostsResponse_USER_NOT_FOUND GetUserPostsResponse_GetUserPostsStatus = 1
+	ConfirmPasswordResetResponse_OK            ConfirmPasswordResetResponse_ConfirmPasswordResetStatus = 0
+	ConfirmPasswordResetResponse_INVALID_TOKEN ConfirmPasswordResetResponse_ConfirmPasswordResetStatus = 1
 )
 
-// Enum value maps for GetUserPostsResponse_GetUserPostsStatus.
+// Enum value maps for ConfirmPasswordResetResponse_ConfirmPasswordResetStatus.
 var (
-	GetUserPostsResponse_GetUserPostsStatus_name = map[int32]string{
+	ConfirmPasswordResetResponse_ConfirmPasswordResetStatus_name = map[int32]string{
 		0: "OK",
-		1: "USER_NOT_FOUND",
+		1: "INVALID_TOKEN",
 	}
-	GetUserPostsResponse_GetUserPostsStatus_value = map[string]int32{
-		"OK":             0,
-		"USER_NOT_FOUND": 1,
+	ConfirmPasswordResetResponse_ConfirmPasswordResetStatus_value = map[string]int32{
+		"OK":            0,
+		"INVALID_TOKEN": 1,
 	}
 )
 
-func (x GetUserPostsResponse_GetUserPostsStatus) Enum() *GetUserPostsResponse_GetUserPostsStatus {
-	p := new(GetUserPostsResponse_GetUserPostsStatus)
+func (x ConfirmPasswordResetResponse_ConfirmPasswordResetStatus) Enum() *ConfirmPasswordResetResponse_ConfirmPasswordResetStatus {
+	p := new(ConfirmPasswordResetResponse_ConfirmPasswordResetStatus)
 	*p = x
 	return p
 }
 
-func (x GetUserPostsResponse_GetUserPostsStatus) String() string {
+func (x ConfirmPasswordResetResponse_ConfirmPasswordResetStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (GetUserPostsResponse_GetUserPostsStatus) Descriptor() protoreflect.EnumDescriptor {
+func (ConfirmPasswordResetResponse_ConfirmPasswordResetStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_authen_and_post_proto_enumTypes[8].Descriptor()
 }
 
-func (GetUserPostsResponse_GetUserPostsStatus) Type() protoreflect.EnumType {
+func (ConfirmPasswordResetResponse_ConfirmPasswordResetStatus) Type() protoreflect.EnumType {
 	return &file_authen_and_post_proto_enumTypes[8]
 }
 
-func (x GetUserPostsResponse_GetUserPostsStatus) Number() protoreflect.EnumNumber {
+func (x ConfirmPasswordResetResponse_ConfirmPasswordResetStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use GetUserPostsResponse_GetUserPostsStatus.Descriptor instead.
-func (GetUserPostsResponse_GetUserPostsStatus) EnumDescriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{18, 0}
+// Deprecated: Use ConfirmPasswordResetResponse_ConfirmPasswordResetStatus.Descriptor instead.
+func (ConfirmPasswordResetResponse_ConfirmPasswordResetStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{17, 0}
 }
 
-type CreatePostResponse_CreatePostStatus int32
+type EnrollTwoFactorResponse_EnrollTwoFactorStatus int32
 
 const (
-	CreatePostResponse_OK             CreatePostResponse_CreatePostStatus = 0
-	CreatePostResponse_USER_NOT_FOUND CreatePostResponse_CreatePostStatus = 1
+	EnrollTwoFactorResponse_OK              EnrollTwoFactorResponse_EnrollTwoFactorStatus = 0
+	EnrollTwoFactorResponse_USER_NOT_FOUND  EnrollTwoFactorResponse_EnrollTwoFactorStatus = 1
+	EnrollTwoFactorResponse_ALREADY_ENABLED EnrollTwoFactorResponse_EnrollTwoFactorStatus = 2
 )
 
-// Enum value maps for CreatePostResponse_CreatePostStatus.
+// Enum value maps for EnrollTwoFactorResponse_EnrollTwoFactorStatus.
 var (
-	CreatePostResponse_CreatePostStatus_name = map[int32]string{
+	EnrollTwoFactorResponse_EnrollTwoFactorStatus_name = map[int32]string{
 		0: "OK",
 		1: "USER_NOT_FOUND",
+		2: "ALREADY_ENABLED",
 	}
-	CreatePostResponse_CreatePostStatus_value = map[string]int32{
-		"OK":             0,
-		"USER_NOT_FOUND": 1,
+	EnrollTwoFactorResponse_EnrollTwoFactorStatus_value = map[string]int32{
+		"OK":              0,
+		"USER_NOT_FOUND":  1,
+		"ALREADY_ENABLED": 2,
 	}
 )
 
-func (x CreatePostResponse_CreatePostStatus) Enum() *CreatePostResponse_CreatePostStatus {
-	p := new(CreatePostResponse_CreatePostStatus)
+func (x EnrollTwoFactorResponse_EnrollTwoFactorStatus) Enum() *EnrollTwoFactorResponse_EnrollTwoFactorStatus {
+	p := new(EnrollTwoFactorResponse_EnrollTwoFactorStatus)
 	*p = x
 	return p
 }
 
-func (x CreatePostResponse_CreatePostStatus) String() string {
+func (x EnrollTwoFactorResponse_EnrollTwoFactorStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (CreatePostResponse_CreatePostStatus) Descriptor() protoreflect.EnumDescriptor {
+func (EnrollTwoFactorResponse_EnrollTwoFactorStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_authen_and_post_proto_enumTypes[9].Descriptor()
 }
 
-func (CreatePostResponse_CreatePostStatus) Type() protoreflect.EnumType {
+func (EnrollTwoFactorResponse_EnrollTwoFactorStatus) Type() protoreflect.EnumType {
 	return &file_authen_and_post_proto_enumTypes[9]
 }
 
-func (x CreatePostResponse_CreatePostStatus) Number() protoreflect.EnumNumber {
+func (x EnrollTwoFactorResponse_EnrollTwoFactorStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use CreatePostResponse_CreatePostStatus.Descriptor instead.
-func (CreatePostResponse_CreatePostStatus) EnumDescriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{20, 0}
+// Deprecated: Use EnrollTwoFactorResponse_EnrollTwoFactorStatus.Descriptor instead.
+func (EnrollTwoFactorResponse_EnrollTwoFactorStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{19, 0}
 }
 
-type GetPostDetailInfoResponse_GetPostDetailInfoStatus int32
+type EnableTwoFactorResponse_EnableTwoFactorStatus int32
 
 const (
-	GetPostDetailInfoResponse_OK             GetPostDetailInfoResponse_GetPostDetailInfoStatus = 0
-	GetPostDetailInfoResponse_POST_NOT_FOUND GetPostDetailInfoResponse_GetPostDetailInfoStatus = 1
+	EnableTwoFactorResponse_OK              EnableTwoFactorResponse_EnableTwoFactorStatus = 0
+	EnableTwoFactorResponse_NOT_ENROLLED    EnableTwoFactorResponse_EnableTwoFactorStatus = 1
+	EnableTwoFactorResponse_ALREADY_ENABLED EnableTwoFactorResponse_EnableTwoFactorStatus = 2
+	EnableTwoFactorResponse_WRONG_OTP_CODE  EnableTwoFactorResponse_EnableTwoFactorStatus = 3
 )
 
-// Enum value maps for GetPostDetailInfoResponse_GetPostDetailInfoStatus.
+// Enum value maps for EnableTwoFactorResponse_EnableTwoFactorStatus.
 var (
-	GetPostDetailInfoResponse_GetPostDetailInfoStatus_name = map[int32]string{
+	EnableTwoFactorResponse_EnableTwoFactorStatus_name = map[int32]string{
 		0: "OK",
-		1: "POST_NOT_FOUND",
+		1: "NOT_ENROLLED",
+		2: "ALREADY_ENABLED",
+		3: "WRONG_OTP_CODE",
 	}
-	GetPostDetailInfoResponse_GetPostDetailInfoStatus_value = map[string]int32{
-		"OK":             0,
-		"POST_NOT_FOUND": 1,
+	EnableTwoFactorResponse_EnableTwoFactorStatus_value = map[string]int32{
+		"OK":              0,
+		"NOT_ENROLLED":    1,
+		"ALREADY_ENABLED": 2,
+		"WRONG_OTP_CODE":  3,
 	}
 )
 
-func (x GetPostDetailInfoResponse_GetPostDetailInfoStatus) Enum() *GetPostDetailInfoResponse_GetPostDetailInfoStatus {
-	p := new(GetPostDetailInfoResponse_GetPostDetailInfoStatus)
+func (x EnableTwoFactorResponse_EnableTwoFactorStatus) Enum() *EnableTwoFactorResponse_EnableTwoFactorStatus {
+	p := new(EnableTwoFactorResponse_EnableTwoFactorStatus)
 	*p = x
 	return p
 }
 
-func (x GetPostDetailInfoResponse_GetPostDetailInfoStatus) String() string {
+func (x EnableTwoFactorResponse_EnableTwoFactorStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (GetPostDetailInfoResponse_GetPostDetailInfoStatus) Descriptor() protoreflect.EnumDescriptor {
+func (EnableTwoFactorResponse_EnableTwoFactorStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_authen_and_post_proto_enumTypes[10].Descriptor()
 }
 
-func (GetPostDetailInfoResponse_GetPostDetailInfoStatus) Type() protoreflect.EnumType {
+func (EnableTwoFactorResponse_EnableTwoFactorStatus) Type() protoreflect.EnumType {
 	return &file_authen_and_post_proto_enumTypes[10]
 }
 
-func (x GetPostDetailInfoResponse_GetPostDetailInfoStatus) Number() protoreflect.EnumNumber {
+func (x EnableTwoFactorResponse_EnableTwoFactorStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use GetPostDetailInfoResponse_GetPostDetailInfoStatus.Descriptor instead.
-func (GetPostDetailInfoResponse_GetPostDetailInfoStatus) EnumDescriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{22, 0}
+// Deprecated: Use EnableTwoFactorResponse_EnableTwoFactorStatus.Descriptor instead.
+func (EnableTwoFactorResponse_EnableTwoFactorStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{21, 0}
 }
 
-type EditPostResponse_EditPostStatus int32
+type DisableTwoFactorResponse_DisableTwoFactorStatus int32
 
 const (
-	EditPostResponse_OK             EditPostResponse_EditPostStatus = 0
-	EditPostResponse_POST_NOT_FOUND EditPostResponse_EditPostStatus = 1
-	EditPostResponse_NOT_ALLOWED    EditPostResponse_EditPostStatus = 2
-	EditPostResponse_USER_NOT_FOUND EditPostResponse_EditPostStatus = 3
+	DisableTwoFactorResponse_OK             DisableTwoFactorResponse_DisableTwoFactorStatus = 0
+	DisableTwoFactorResponse_NOT_ENABLED    DisableTwoFactorResponse_DisableTwoFactorStatus = 1
+	DisableTwoFactorResponse_WRONG_OTP_CODE DisableTwoFactorResponse_DisableTwoFactorStatus = 2
 )
 
-// Enum value maps for EditPostResponse_EditPostStatus.
+// Enum value maps for DisableTwoFactorResponse_DisableTwoFactorStatus.
 var (
-	EditPostResponse_EditPostStatus_name = map[int32]string{
+	DisableTwoFactorResponse_DisableTwoFactorStatus_name = map[int32]string{
 		0: "OK",
-		1: "POST_NOT_FOUND",
-		2: "NOT_ALLOWED",
-		3: "USER_NOT_FOUND",
+		1: "NOT_ENABLED",
+		2: "WRONG_OTP_CODE",
 	}
-	EditPostResponse_EditPostStatus_value = map[string]int32{
+	DisableTwoFactorResponse_DisableTwoFactorStatus_value = map[string]int32{
 		"OK":             0,
-		"POST_NOT_FOUND": 1,
-		"NOT_ALLOWED":    2,
-		"USER_NOT_FOUND": 3,
+		"NOT_ENABLED":    1,
+		"WRONG_OTP_CODE": 2,
 	}
 )
 
-func (x EditPostResponse_EditPostStatus) Enum() *EditPostResponse_EditPostStatus {
-	p := new(EditPostResponse_EditPostStatus)
+func (x DisableTwoFactorResponse_DisableTwoFactorStatus) Enum() *DisableTwoFactorResponse_DisableTwoFactorStatus {
+	p := new(DisableTwoFactorResponse_DisableTwoFactorStatus)
 	*p = x
 	return p
 }
 
-func (x EditPostResponse_EditPostStatus) String() string {
+func (x DisableTwoFactorResponse_DisableTwoFactorStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (EditPostResponse_EditPostStatus) Descriptor() protoreflect.EnumDescriptor {
+func (DisableTwoFactorResponse_DisableTwoFactorStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_authen_and_post_proto_enumTypes[11].Descriptor()
 }
 
-func (EditPostResponse_EditPostStatus) Type() protoreflect.EnumType {
+func (DisableTwoFactorResponse_DisableTwoFactorStatus) Type() protoreflect.EnumType {
 	return &file_authen_and_post_proto_enumTypes[11]
 }
 
-func (x EditPostResponse_EditPostStatus) Number() protoreflect.EnumNumber {
+func (x DisableTwoFactorResponse_DisableTwoFactorStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use EditPostResponse_EditPostStatus.Descriptor instead.
-func (EditPostResponse_EditPostStatus) EnumDescriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{24, 0}
+// Deprecated: Use DisableTwoFactorResponse_DisableTwoFactorStatus.Descriptor instead.
+func (DisableTwoFactorResponse_DisableTwoFactorStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{23, 0}
 }
 
-type DeletePostResponse_DeletePostStatus int32
+type VerifyTwoFactorResponse_VerifyTwoFactorStatus int32
 
 const (
-	DeletePostResponse_OK             DeletePostResponse_DeletePostStatus = 0
-	DeletePostResponse_POST_NOT_FOUND DeletePostResponse_DeletePostStatus = 1
-	DeletePostResponse_NOT_ALLOWED    DeletePostResponse_DeletePostStatus = 2
-	DeletePostResponse_USER_NOT_FOUND DeletePostResponse_DeletePostStatus = 3
+	VerifyTwoFactorResponse_OK             VerifyTwoFactorResponse_VerifyTwoFactorStatus = 0
+	VerifyTwoFactorResponse_USER_NOT_FOUND VerifyTwoFactorResponse_VerifyTwoFactorStatus = 1
+	VerifyTwoFactorResponse_NOT_ENABLED    VerifyTwoFactorResponse_VerifyTwoFactorStatus = 2
+	VerifyTwoFactorResponse_WRONG_OTP_CODE VerifyTwoFactorResponse_VerifyTwoFactorStatus = 3
+	VerifyTwoFactorResponse_ACCOUNT_LOCKED VerifyTwoFactorResponse_VerifyTwoFactorStatus = 4
 )
 
-// Enum value maps for DeletePostResponse_DeletePostStatus.
+// Enum value maps for VerifyTwoFactorResponse_VerifyTwoFactorStatus.
 var (
-	DeletePostResponse_DeletePostStatus_name = map[int32]string{
+	VerifyTwoFactorResponse_VerifyTwoFactorStatus_name = map[int32]string{
 		0: "OK",
-		1: "POST_NOT_FOUND",
-		2: "NOT_ALLOWED",
-		3: "USER_NOT_FOUND",
+		1: "USER_NOT_FOUND",
+		2: "NOT_ENABLED",
+		3: "WRONG_OTP_CODE",
+		4: "ACCOUNT_LOCKED",
 	}
-	DeletePostResponse_DeletePostStatus_value = map[string]int32{
+	VerifyTwoFactorResponse_VerifyTwoFactorStatus_value = map[string]int32{
 		"OK":             0,
-		"POST_NOT_FOUND": 1,
-		"NOT_ALLOWED":    2,
-		"USER_NOT_FOUND": 3,
+		"USER_NOT_FOUND": 1,
+		"NOT_ENABLED":    2,
+		"WRONG_OTP_CODE": 3,
+		"ACCOUNT_LOCKED": 4,
 	}
 )
 
-func (x DeletePostResponse_DeletePostStatus) Enum() *DeletePostResponse_DeletePostStatus {
-	p := new(DeletePostResponse_DeletePostStatus)
+func (x VerifyTwoFactorResponse_VerifyTwoFactorStatus) Enum() *VerifyTwoFactorResponse_VerifyTwoFactorStatus {
+	p := new(VerifyTwoFactorResponse_VerifyTwoFactorStatus)
 	*p = x
 	return p
 }
 
-func (x DeletePostResponse_DeletePostStatus) String() string {
+func (x VerifyTwoFactorResponse_VerifyTwoFactorStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (DeletePostResponse_DeletePostStatus) Descriptor() protoreflect.EnumDescriptor {
+func (VerifyTwoFactorResponse_VerifyTwoFactorStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_authen_and_post_proto_enumTypes[12].Descriptor()
 }
 
-func (DeletePostResponse_DeletePostStatus) Type() protoreflect.EnumType {
+func (VerifyTwoFactorResponse_VerifyTwoFactorStatus) Type() protoreflect.EnumType {
 	return &file_authen_and_post_proto_enumTypes[12]
 }
 
-func (x DeletePostResponse_DeletePostStatus) Number() protoreflect.EnumNumber {
+func (x VerifyTwoFactorResponse_VerifyTwoFactorStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use DeletePostResponse_DeletePostStatus.Descriptor instead.
-func (DeletePostResponse_DeletePostStatus) EnumDescriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{26, 0}
+// Deprecated: Use VerifyTwoFactorResponse_VerifyTwoFactorStatus.Descriptor instead.
+func (VerifyTwoFactorResponse_VerifyTwoFactorStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{25, 0}
 }
 
-type CommentPostResponse_CommentPostStatus int32
+type RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus int32
 
 const (
-	CommentPostResponse_OK             CommentPostResponse_CommentPostStatus = 0
-	CommentPostResponse_POST_NOT_FOUND CommentPostResponse_CommentPostStatus = 1
-	CommentPostResponse_USER_NOT_FOUND CommentPostResponse_CommentPostStatus = 2
+	RegenerateRecoveryCodesResponse_OK             RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus = 0
+	RegenerateRecoveryCodesResponse_NOT_ENABLED    RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus = 1
+	RegenerateRecoveryCodesResponse_WRONG_OTP_CODE RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus = 2
 )
 
-// Enum value maps for CommentPostResponse_CommentPostStatus.
+// Enum value maps for RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus.
 var (
-	CommentPostResponse_CommentPostStatus_name = map[int32]string{
+	RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus_name = map[int32]string{
 		0: "OK",
-		1: "POST_NOT_FOUND",
-		2: "USER_NOT_FOUND",
+		1: "NOT_ENABLED",
+		2: "WRONG_OTP_CODE",
 	}
-	CommentPostResponse_CommentPostStatus_value = map[string]int32{
+	RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus_value = map[string]int32{
 		"OK":             0,
-		"POST_NOT_FOUND": 1,
-		"USER_NOT_FOUND": 2,
+		"NOT_ENABLED":    1,
+		"WRONG_OTP_CODE": 2,
 	}
 )
 
-func (x CommentPostResponse_CommentPostStatus) Enum() *CommentPostResponse_CommentPostStatus {
-	p := new(CommentPostResponse_CommentPostStatus)
+func (x RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus) Enum() *RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus {
+	p := new(RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus)
 	*p = x
 	return p
 }
 
-func (x CommentPostResponse_CommentPostStatus) String() string {
+func (x RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (CommentPostResponse_CommentPostStatus) Descriptor() protoreflect.EnumDescriptor {
+func (RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_authen_and_post_proto_enumTypes[13].Descriptor()
 }
 
-func (CommentPostResponse_CommentPostStatus) Type() protoreflect.EnumType {
+func (RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus) Type() protoreflect.EnumType {
 	return &file_authen_and_post_proto_enumTypes[13]
 }
 
-func (x CommentPostResponse_CommentPostStatus) Number() protoreflect.EnumNumber {
+func (x RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use CommentPostResponse_CommentPostStatus.Descriptor instead.
-func (CommentPostResponse_CommentPostStatus) EnumDescriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{28, 0}
+// Deprecated: Use RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus.Descriptor instead.
+func (RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{27, 0}
 }
 
-type LikePostResponse_LikePostStatus int32
+type DeleteUserResponse_DeleteUserStatus int32
 
 const (
-	LikePostResponse_OK             LikePostResponse_LikePostStatus = 0
-	LikePostResponse_POST_NOT_FOUND LikePostResponse_LikePostStatus = 1
-	LikePostResponse_USER_NOT_FOUND LikePostResponse_LikePostStatus = 2
-	LikePostResponse_ALREADY_LIKED  LikePostResponse_LikePostStatus = 3
+	DeleteUserResponse_OK                  DeleteUserResponse_DeleteUserStatus = 0
+	DeleteUserResponse_USER_NOT_FOUND      DeleteUserResponse_DeleteUserStatus = 1
+	DeleteUserResponse_WRONG_PASSWORD      DeleteUserResponse_DeleteUserStatus = 2
+	DeleteUserResponse_TWO_FACTOR_REQUIRED DeleteUserResponse_DeleteUserStatus = 3
+	DeleteUserResponse_WRONG_OTP_CODE      DeleteUserResponse_DeleteUserStatus = 4
+	DeleteUserResponse_ALREADY_SCHEDULED   DeleteUserResponse_DeleteUserStatus = 5
 )
 
-// Enum value maps for LikePostResponse_LikePostStatus.
+// Enum value maps for DeleteUserResponse_DeleteUserStatus.
 var (
-	LikePostResponse_LikePostStatus_name = map[int32]string{
+	DeleteUserResponse_DeleteUserStatus_name = map[int32]string{
 		0: "OK",
-		1: "POST_NOT_FOUND",
-		2: "USER_NOT_FOUND",
-		3: "ALREADY_LIKED",
-	}
-	LikePostResponse_LikePostStatus_value = map[string]int32{
-		"OK":             0,
-		"POST_NOT_FOUND": 1,
-		"USER_NOT_FOUND": 2,
-		"ALREADY_LIKED":  3,
+		1: "USER_NOT_FOUND",
+		2: "WRONG_PASSWORD",
+		3: "TWO_FACTOR_REQUIRED",
+		4: "WRONG_OTP_CODE",
+		5: "ALREADY_SCHEDULED",
+	}
+	DeleteUserResponse_DeleteUserStatus_value = map[string]int32{
+		"OK":                  0,
+		"USER_NOT_FOUND":      1,
+		"WRONG_PASSWORD":      2,
+		"TWO_FACTOR_REQUIRED": 3,
+		"WRONG_OTP_CODE":      4,
+		"ALREADY_SCHEDULED":   5,
 	}
 )
 
-func (x LikePostResponse_LikePostStatus) Enum() *LikePostResponse_LikePostStatus {
-	p := new(LikePostResponse_LikePostStatus)
+func (x DeleteUserResponse_DeleteUserStatus) Enum() *DeleteUserResponse_DeleteUserStatus {
+	p := new(DeleteUserResponse_DeleteUserStatus)
 	*p = x
 	return p
 }
 
-func (x LikePostResponse_LikePostStatus) String() string {
+func (x DeleteUserResponse_DeleteUserStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (LikePostResponse_LikePostStatus) Descriptor() protoreflect.EnumDescriptor {
+func (DeleteUserResponse_DeleteUserStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_authen_and_post_proto_enumTypes[14].Descriptor()
 }
 
-func (LikePostResponse_LikePostStatus) Type() protoreflect.EnumType {
+func (DeleteUserResponse_DeleteUserStatus) Type() protoreflect.EnumType {
 	return &file_authen_and_post_proto_enumTypes[14]
 }
 
-func (x LikePostResponse_LikePostStatus) Number() protoreflect.EnumNumber {
+func (x DeleteUserResponse_DeleteUserStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use LikePostResponse_LikePostStatus.Descriptor instead.
-func (LikePostResponse_LikePostStatus) EnumDescriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{30, 0}
+// Deprecated: Use DeleteUserResponse_DeleteUserStatus.Descriptor instead.
+func (DeleteUserResponse_DeleteUserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{29, 0}
 }
 
-type GetS3PresignedUrlResponse_GetS3PresignedUrlStatus int32
+type CancelUserDeletionResponse_CancelUserDeletionStatus int32
 
 const (
-	GetS3PresignedUrlResponse_OK   GetS3PresignedUrlResponse_GetS3PresignedUrlStatus = 0
-	GetS3PresignedUrlResponse_FAIL GetS3PresignedUrlResponse_GetS3PresignedUrlStatus = 1
+	CancelUserDeletionResponse_OK             CancelUserDeletionResponse_CancelUserDeletionStatus = 0
+	CancelUserDeletionResponse_USER_NOT_FOUND CancelUserDeletionResponse_CancelUserDeletionStatus = 1
+	CancelUserDeletionResponse_NOT_SCHEDULED  CancelUserDeletionResponse_CancelUserDeletionStatus = 2
 )
 
-// Enum value maps for GetS3PresignedUrlResponse_GetS3PresignedUrlStatus.
+// Enum value maps for CancelUserDeletionResponse_CancelUserDeletionStatus.
 var (
-	GetS3PresignedUrlResponse_GetS3PresignedUrlStatus_name = map[int32]string{
+	CancelUserDeletionResponse_CancelUserDeletionStatus_name = map[int32]string{
 		0: "OK",
-		1: "FAIL",
+		1: "USER_NOT_FOUND",
+		2: "NOT_SCHEDULED",
 	}
-	GetS3PresignedUrlResponse_GetS3PresignedUrlStatus_value = map[string]int32{
-		"OK":   0,
-		"FAIL": 1,
+	CancelUserDeletionResponse_CancelUserDeletionStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"NOT_SCHEDULED":  2,
 	}
 )
 
-func (x GetS3PresignedUrlResponse_GetS3PresignedUrlStatus) Enum() *GetS3PresignedUrlResponse_GetS3PresignedUrlStatus {
-	p := new(GetS3PresignedUrlResponse_GetS3PresignedUrlStatus)
+func (x CancelUserDeletionResponse_CancelUserDeletionStatus) Enum() *CancelUserDeletionResponse_CancelUserDeletionStatus {
+	p := new(CancelUserDeletionResponse_CancelUserDeletionStatus)
 	*p = x
 	return p
 }
 
-func (x GetS3PresignedUrlResponse_GetS3PresignedUrlStatus) String() string {
+func (x CancelUserDeletionResponse_CancelUserDeletionStatus) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (GetS3PresignedUrlResponse_GetS3PresignedUrlStatus) Descriptor() protoreflect.EnumDescriptor {
+func (CancelUserDeletionResponse_CancelUserDeletionStatus) Descriptor() protoreflect.EnumDescriptor {
 	return file_authen_and_post_proto_enumTypes[15].Descriptor()
 }
 
-func (GetS3PresignedUrlResponse_GetS3PresignedUrlStatus) Type() protoreflect.EnumType {
+func (CancelUserDeletionResponse_CancelUserDeletionStatus) Type() protoreflect.EnumType {
 	return &file_authen_and_post_proto_enumTypes[15]
 }
 
-func (x GetS3PresignedUrlResponse_GetS3PresignedUrlStatus) Number() protoreflect.EnumNumber {
+func (x CancelUserDeletionResponse_CancelUserDeletionStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use GetS3PresignedUrlResponse_GetS3PresignedUrlStatus.Descriptor instead.
-func (GetS3PresignedUrlResponse_GetS3PresignedUrlStatus) EnumDescriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{32, 0}
+// Deprecated: Use CancelUserDeletionResponse_CancelUserDeletionStatus.Descriptor instead.
+func (CancelUserDeletionResponse_CancelUserDeletionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{31, 0}
 }
 
-type CheckUserAuthenticationRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type DataExportInfo_DataExportState int32
 
-	UserName     string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	UserPassword string `protobuf:"bytes,2,opt,name=user_password,json=userPassword,proto3" json:"user_password,omitempty"`
-}
+const (
+	DataExportInfo_PENDING DataExportInfo_DataExportState = 0
+	DataExportInfo_READY   DataExportInfo_DataExportState = 1
+	DataExportInfo_FAILED  DataExportInfo_DataExportState = 2
+)
 
-func (x *CheckUserAuthenticationRequest) Reset() {
-	*x = CheckUserAuthenticationRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for DataExportInfo_DataExportState.
+var (
+	DataExportInfo_DataExportState_name = map[int32]string{
+		0: "PENDING",
+		1: "READY",
+		2: "FAILED",
+	}
+	DataExportInfo_DataExportState_value = map[string]int32{
+		"PENDING": 0,
+		"READY":   1,
+		"FAILED":  2,
 	}
+)
+
+func (x DataExportInfo_DataExportState) Enum() *DataExportInfo_DataExportState {
+	p := new(DataExportInfo_DataExportState)
+	*p = x
+	return p
 }
 
-func (x *CheckUserAuthenticationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x DataExportInfo_DataExportState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*CheckUserAuthenticationRequest) ProtoMessage() {}
+func (DataExportInfo_DataExportState) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[16].Descriptor()
+}
 
-func (x *CheckUserAuthenticationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CheckUserAuthenticationRequest.ProtoReflect.Descriptor instead.
-func (*CheckUserAuthenticationRequest) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{0}
+func (DataExportInfo_DataExportState) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[16]
 }
 
-func (x *CheckUserAuthenticationRequest) GetUserName() string {
-	if x != nil {
-		return x.UserName
-	}
-	return ""
+func (x DataExportInfo_DataExportState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CheckUserAuthenticationRequest) GetUserPassword() string {
-	if x != nil {
-		return x.UserPassword
-	}
-	return ""
+// Deprecated: Use DataExportInfo_DataExportState.Descriptor instead.
+func (DataExportInfo_DataExportState) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{32, 0}
 }
 
-type CheckUserAuthenticationResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type RequestDataExportResponse_RequestDataExportStatus int32
 
-	Status CheckUserAuthenticationResponse_CheckUserAuthenticationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.CheckUserAuthenticationResponse_CheckUserAuthenticationStatus" json:"status,omitempty"`
-	User   *UserDetailInfo                                               `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-}
+const (
+	RequestDataExportResponse_OK              RequestDataExportResponse_RequestDataExportStatus = 0
+	RequestDataExportResponse_USER_NOT_FOUND  RequestDataExportResponse_RequestDataExportStatus = 1
+	RequestDataExportResponse_ALREADY_PENDING RequestDataExportResponse_RequestDataExportStatus = 2
+)
 
-func (x *CheckUserAuthenticationResponse) Reset() {
-	*x = CheckUserAuthenticationResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for RequestDataExportResponse_RequestDataExportStatus.
+var (
+	RequestDataExportResponse_RequestDataExportStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "ALREADY_PENDING",
 	}
-}
+	RequestDataExportResponse_RequestDataExportStatus_value = map[string]int32{
+		"OK":              0,
+		"USER_NOT_FOUND":  1,
+		"ALREADY_PENDING": 2,
+	}
+)
 
-func (x *CheckUserAuthenticationResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x RequestDataExportResponse_RequestDataExportStatus) Enum() *RequestDataExportResponse_RequestDataExportStatus {
+	p := new(RequestDataExportResponse_RequestDataExportStatus)
+	*p = x
+	return p
 }
 
-func (*CheckUserAuthenticationResponse) ProtoMessage() {}
+func (x RequestDataExportResponse_RequestDataExportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *CheckUserAuthenticationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (RequestDataExportResponse_RequestDataExportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[17].Descriptor()
 }
 
-// Deprecated: Use CheckUserAuthenticationResponse.ProtoReflect.Descriptor instead.
-func (*CheckUserAuthenticationResponse) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{1}
+func (RequestDataExportResponse_RequestDataExportStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[17]
 }
 
-func (x *CheckUserAuthenticationResponse) GetStatus() CheckUserAuthenticationResponse_CheckUserAuthenticationStatus {
-	if x != nil {
-		return x.Status
-	}
-	return CheckUserAuthenticationResponse_OK
+func (x RequestDataExportResponse_RequestDataExportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CheckUserAuthenticationResponse) GetUser() *UserDetailInfo {
-	if x != nil {
-		return x.User
-	}
-	return nil
+// Deprecated: Use RequestDataExportResponse_RequestDataExportStatus.Descriptor instead.
+func (RequestDataExportResponse_RequestDataExportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{34, 0}
 }
 
-type CreateUserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type GetDataExportResponse_GetDataExportStatus int32
 
-	UserName     string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	UserPassword string `protobuf:"bytes,3,opt,name=user_password,json=userPassword,proto3" json:"user_password,omitempty"`
-	Email        string `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
-}
+const (
+	GetDataExportResponse_OK             GetDataExportResponse_GetDataExportStatus = 0
+	GetDataExportResponse_USER_NOT_FOUND GetDataExportResponse_GetDataExportStatus = 1
+	GetDataExportResponse_NOT_FOUND      GetDataExportResponse_GetDataExportStatus = 2
+)
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for GetDataExportResponse_GetDataExportStatus.
+var (
+	GetDataExportResponse_GetDataExportStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "NOT_FOUND",
 	}
-}
+	GetDataExportResponse_GetDataExportStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"NOT_FOUND":      2,
+	}
+)
 
-func (x *CreateUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetDataExportResponse_GetDataExportStatus) Enum() *GetDataExportResponse_GetDataExportStatus {
+	p := new(GetDataExportResponse_GetDataExportStatus)
+	*p = x
+	return p
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
-
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x GetDataExportResponse_GetDataExportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{2}
+func (GetDataExportResponse_GetDataExportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[18].Descriptor()
 }
 
-func (x *CreateUserRequest) GetUserName() string {
-	if x != nil {
-		return x.UserName
-	}
-	return ""
+func (GetDataExportResponse_GetDataExportStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[18]
 }
 
-func (x *CreateUserRequest) GetUserPassword() string {
-	if x != nil {
-		return x.UserPassword
-	}
-	return ""
+func (x GetDataExportResponse_GetDataExportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreateUserRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+// Deprecated: Use GetDataExportResponse_GetDataExportStatus.Descriptor instead.
+func (GetDataExportResponse_GetDataExportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{36, 0}
 }
 
-type CreateUserResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type SearchUsersResponse_SearchUsersStatus int32
 
-	Status CreateUserResponse_CreateUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.CreateUserResponse_CreateUserStatus" json:"status,omitempty"`
-	UserId int64                               `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-}
+const (
+	SearchUsersResponse_OK SearchUsersResponse_SearchUsersStatus = 0
+)
 
-func (x *CreateUserResponse) Reset() {
-	*x = CreateUserResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for SearchUsersResponse_SearchUsersStatus.
+var (
+	SearchUsersResponse_SearchUsersStatus_name = map[int32]string{
+		0: "OK",
 	}
-}
+	SearchUsersResponse_SearchUsersStatus_value = map[string]int32{
+		"OK": 0,
+	}
+)
 
-func (x *CreateUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x SearchUsersResponse_SearchUsersStatus) Enum() *SearchUsersResponse_SearchUsersStatus {
+	p := new(SearchUsersResponse_SearchUsersStatus)
+	*p = x
+	return p
 }
 
-func (*CreateUserResponse) ProtoMessage() {}
+func (x SearchUsersResponse_SearchUsersStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (SearchUsersResponse_SearchUsersStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[19].Descriptor()
 }
 
-// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
-func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{3}
+func (SearchUsersResponse_SearchUsersStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[19]
 }
 
-func (x *CreateUserResponse) GetStatus() CreateUserResponse_CreateUserStatus {
-	if x != nil {
-		return x.Status
-	}
-	return CreateUserResponse_OK
+func (x SearchUsersResponse_SearchUsersStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreateUserResponse) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use SearchUsersResponse_SearchUsersStatus.Descriptor instead.
+func (SearchUsersResponse_SearchUsersStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{39, 0}
 }
 
-type EditUserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type GetUserFollowerResponse_GetUserFollowerStatus int32
 
-	UserId         int64                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserPassword   *string              `protobuf:"bytes,2,opt,name=user_password,json=userPassword,proto3,oneof" json:"user_password,omitempty"`
-	FirstName      *string              `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
-	LastName       *string              `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
-	DateOfBirth    *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date_of_birth,json=dateOfBirth,proto3,oneof" json:"date_of_birth,omitempty"`
-	ProfilePicture *string              `protobuf:"bytes,6,opt,name=profile_picture,json=profilePicture,proto3,oneof" json:"profile_picture,omitempty"`
-	CoverPicture   *string              `protobuf:"bytes,7,opt,name=cover_picture,json=coverPicture,proto3,oneof" json:"cover_picture,omitempty"`
-}
+const (
+	GetUserFollowerResponse_OK             GetUserFollowerResponse_GetUserFollowerStatus = 0
+	GetUserFollowerResponse_USER_NOT_FOUND GetUserFollowerResponse_GetUserFollowerStatus = 1
+)
 
-func (x *EditUserRequest) Reset() {
-	*x = EditUserRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for GetUserFollowerResponse_GetUserFollowerStatus.
+var (
+	GetUserFollowerResponse_GetUserFollowerStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+	}
+	GetUserFollowerResponse_GetUserFollowerStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
 	}
+)
+
+func (x GetUserFollowerResponse_GetUserFollowerStatus) Enum() *GetUserFollowerResponse_GetUserFollowerStatus {
+	p := new(GetUserFollowerResponse_GetUserFollowerStatus)
+	*p = x
+	return p
 }
 
-func (x *EditUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetUserFollowerResponse_GetUserFollowerStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*EditUserRequest) ProtoMessage() {}
+func (GetUserFollowerResponse_GetUserFollowerStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[20].Descriptor()
+}
 
-func (x *EditUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (GetUserFollowerResponse_GetUserFollowerStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[20]
 }
 
-// Deprecated: Use EditUserRequest.ProtoReflect.Descriptor instead.
-func (*EditUserRequest) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{4}
+func (x GetUserFollowerResponse_GetUserFollowerStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *EditUserRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use GetUserFollowerResponse_GetUserFollowerStatus.Descriptor instead.
+func (GetUserFollowerResponse_GetUserFollowerStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{41, 0}
 }
 
-func (x *EditUserRequest) GetUserPassword() string {
-	if x != nil && x.UserPassword != nil {
-		return *x.UserPassword
-	}
-	return ""
-}
+type GetUserFollowingResponse_GetUserFollowingStatus int32
 
-func (x *EditUserRequest) GetFirstName() string {
-	if x != nil && x.FirstName != nil {
-		return *x.FirstName
-	}
-	return ""
-}
+const (
+	GetUserFollowingResponse_OK             GetUserFollowingResponse_GetUserFollowingStatus = 0
+	GetUserFollowingResponse_USER_NOT_FOUND GetUserFollowingResponse_GetUserFollowingStatus = 1
+)
 
-func (x *EditUserRequest) GetLastName() string {
-	if x != nil && x.LastName != nil {
-		return *x.LastName
+// Enum value maps for GetUserFollowingResponse_GetUserFollowingStatus.
+var (
+	GetUserFollowingResponse_GetUserFollowingStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
 	}
-	return ""
-}
-
-func (x *EditUserRequest) GetDateOfBirth() *timestamp.Timestamp {
-	if x != nil {
-		return x.DateOfBirth
+	GetUserFollowingResponse_GetUserFollowingStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
 	}
-	return nil
-}
+)
 
-func (x *EditUserRequest) GetProfilePicture() string {
-	if x != nil && x.ProfilePicture != nil {
-		return *x.ProfilePicture
-	}
-	return ""
+func (x GetUserFollowingResponse_GetUserFollowingStatus) Enum() *GetUserFollowingResponse_GetUserFollowingStatus {
+	p := new(GetUserFollowingResponse_GetUserFollowingStatus)
+	*p = x
+	return p
 }
 
-func (x *EditUserRequest) GetCoverPicture() string {
-	if x != nil && x.CoverPicture != nil {
-		return *x.CoverPicture
-	}
-	return ""
+func (x GetUserFollowingResponse_GetUserFollowingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type EditUserResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (GetUserFollowingResponse_GetUserFollowingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[21].Descriptor()
+}
 
-	Status EditUserResponse_EditUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.EditUserResponse_EditUserStatus" json:"status,omitempty"`
+func (GetUserFollowingResponse_GetUserFollowingStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[21]
 }
 
-func (x *EditUserResponse) Reset() {
-	*x = EditUserResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x GetUserFollowingResponse_GetUserFollowingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *EditUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use GetUserFollowingResponse_GetUserFollowingStatus.Descriptor instead.
+func (GetUserFollowingResponse_GetUserFollowingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{43, 0}
 }
 
-func (*EditUserResponse) ProtoMessage() {}
+type FollowUserResponse_FollowUserStatus int32
 
-func (x *EditUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	FollowUserResponse_OK               FollowUserResponse_FollowUserStatus = 0
+	FollowUserResponse_USER_NOT_FOUND   FollowUserResponse_FollowUserStatus = 1
+	FollowUserResponse_ALREADY_FOLLOWED FollowUserResponse_FollowUserStatus = 2
+	// One of the users blocked the other
+	FollowUserResponse_NOT_ALLOWED FollowUserResponse_FollowUserStatus = 3
+	// The followed account is private, a follow request was sent
+	FollowUserResponse_REQUESTED         FollowUserResponse_FollowUserStatus = 4
+	FollowUserResponse_ALREADY_REQUESTED FollowUserResponse_FollowUserStatus = 5
+)
+
+// Enum value maps for FollowUserResponse_FollowUserStatus.
+var (
+	FollowUserResponse_FollowUserStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "ALREADY_FOLLOWED",
+		3: "NOT_ALLOWED",
+		4: "REQUESTED",
+		5: "ALREADY_REQUESTED",
 	}
-	return mi.MessageOf(x)
-}
+	FollowUserResponse_FollowUserStatus_value = map[string]int32{
+		"OK":                0,
+		"USER_NOT_FOUND":    1,
+		"ALREADY_FOLLOWED":  2,
+		"NOT_ALLOWED":       3,
+		"REQUESTED":         4,
+		"ALREADY_REQUESTED": 5,
+	}
+)
 
-// Deprecated: Use EditUserResponse.ProtoReflect.Descriptor instead.
-func (*EditUserResponse) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{5}
+func (x FollowUserResponse_FollowUserStatus) Enum() *FollowUserResponse_FollowUserStatus {
+	p := new(FollowUserResponse_FollowUserStatus)
+	*p = x
+	return p
 }
 
-func (x *EditUserResponse) GetStatus() EditUserResponse_EditUserStatus {
-	if x != nil {
-		return x.Status
-	}
-	return EditUserResponse_OK
+func (x FollowUserResponse_FollowUserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type GetUserDetailInfoRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (FollowUserResponse_FollowUserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[22].Descriptor()
+}
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (FollowUserResponse_FollowUserStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[22]
 }
 
-func (x *GetUserDetailInfoRequest) Reset() {
-	*x = GetUserDetailInfoRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x FollowUserResponse_FollowUserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetUserDetailInfoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use FollowUserResponse_FollowUserStatus.Descriptor instead.
+func (FollowUserResponse_FollowUserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{45, 0}
 }
 
-func (*GetUserDetailInfoRequest) ProtoMessage() {}
+type UnfollowUserResponse_UnfollowUserStatus int32
 
-func (x *GetUserDetailInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	UnfollowUserResponse_OK             UnfollowUserResponse_UnfollowUserStatus = 0
+	UnfollowUserResponse_USER_NOT_FOUND UnfollowUserResponse_UnfollowUserStatus = 1
+	UnfollowUserResponse_NOT_FOLLOWED   UnfollowUserResponse_UnfollowUserStatus = 2
+)
+
+// Enum value maps for UnfollowUserResponse_UnfollowUserStatus.
+var (
+	UnfollowUserResponse_UnfollowUserStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "NOT_FOLLOWED",
 	}
-	return mi.MessageOf(x)
-}
+	UnfollowUserResponse_UnfollowUserStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"NOT_FOLLOWED":   2,
+	}
+)
 
-// Deprecated: Use GetUserDetailInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetUserDetailInfoRequest) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{6}
+func (x UnfollowUserResponse_UnfollowUserStatus) Enum() *UnfollowUserResponse_UnfollowUserStatus {
+	p := new(UnfollowUserResponse_UnfollowUserStatus)
+	*p = x
+	return p
 }
 
-func (x *GetUserDetailInfoRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x UnfollowUserResponse_UnfollowUserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type GetUserDetailInfoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (UnfollowUserResponse_UnfollowUserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[23].Descriptor()
+}
 
-	Status GetUserDetailInfoResponse_GetUserDetailInfoStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetUserDetailInfoResponse_GetUserDetailInfoStatus" json:"status,omitempty"`
-	User   *UserDetailInfo                                   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+func (UnfollowUserResponse_UnfollowUserStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[23]
 }
 
-func (x *GetUserDetailInfoResponse) Reset() {
-	*x = GetUserDetailInfoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x UnfollowUserResponse_UnfollowUserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetUserDetailInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use UnfollowUserResponse_UnfollowUserStatus.Descriptor instead.
+func (UnfollowUserResponse_UnfollowUserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{47, 0}
 }
 
-func (*GetUserDetailInfoResponse) ProtoMessage() {}
+type GetFollowRequestsResponse_GetFollowRequestsStatus int32
 
-func (x *GetUserDetailInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	GetFollowRequestsResponse_OK             GetFollowRequestsResponse_GetFollowRequestsStatus = 0
+	GetFollowRequestsResponse_USER_NOT_FOUND GetFollowRequestsResponse_GetFollowRequestsStatus = 1
+)
+
+// Enum value maps for GetFollowRequestsResponse_GetFollowRequestsStatus.
+var (
+	GetFollowRequestsResponse_GetFollowRequestsStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
 	}
-	return mi.MessageOf(x)
-}
+	GetFollowRequestsResponse_GetFollowRequestsStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+	}
+)
 
-// Deprecated: Use GetUserDetailInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetUserDetailInfoResponse) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{7}
+func (x GetFollowRequestsResponse_GetFollowRequestsStatus) Enum() *GetFollowRequestsResponse_GetFollowRequestsStatus {
+	p := new(GetFollowRequestsResponse_GetFollowRequestsStatus)
+	*p = x
+	return p
 }
 
-func (x *GetUserDetailInfoResponse) GetStatus() GetUserDetailInfoResponse_GetUserDetailInfoStatus {
-	if x != nil {
-		return x.Status
-	}
-	return GetUserDetailInfoResponse_OK
+func (x GetFollowRequestsResponse_GetFollowRequestsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *GetUserDetailInfoResponse) GetUser() *UserDetailInfo {
-	if x != nil {
-		return x.User
-	}
-	return nil
+func (GetFollowRequestsResponse_GetFollowRequestsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[24].Descriptor()
 }
 
-type UserDetailInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserId         int64                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserName       string               `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	FirstName      string               `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName       string               `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	DateOfBirth    *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
-	Email          string               `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
-	ProfilePicture string               `protobuf:"bytes,7,opt,name=profile_picture,json=profilePicture,proto3" json:"profile_picture,omitempty"`
-	CoverPicture   string               `protobuf:"bytes,8,opt,name=cover_picture,json=coverPicture,proto3" json:"cover_picture,omitempty"`
+func (GetFollowRequestsResponse_GetFollowRequestsStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[24]
 }
 
-func (x *UserDetailInfo) Reset() {
-	*x = UserDetailInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x GetFollowRequestsResponse_GetFollowRequestsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UserDetailInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use GetFollowRequestsResponse_GetFollowRequestsStatus.Descriptor instead.
+func (GetFollowRequestsResponse_GetFollowRequestsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{49, 0}
 }
 
-func (*UserDetailInfo) ProtoMessage() {}
+type ApproveFollowRequestResponse_ApproveFollowRequestStatus int32
 
-func (x *UserDetailInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	ApproveFollowRequestResponse_OK                ApproveFollowRequestResponse_ApproveFollowRequestStatus = 0
+	ApproveFollowRequestResponse_USER_NOT_FOUND    ApproveFollowRequestResponse_ApproveFollowRequestStatus = 1
+	ApproveFollowRequestResponse_REQUEST_NOT_FOUND ApproveFollowRequestResponse_ApproveFollowRequestStatus = 2
+)
+
+// Enum value maps for ApproveFollowRequestResponse_ApproveFollowRequestStatus.
+var (
+	ApproveFollowRequestResponse_ApproveFollowRequestStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "REQUEST_NOT_FOUND",
 	}
-	return mi.MessageOf(x)
-}
+	ApproveFollowRequestResponse_ApproveFollowRequestStatus_value = map[string]int32{
+		"OK":                0,
+		"USER_NOT_FOUND":    1,
+		"REQUEST_NOT_FOUND": 2,
+	}
+)
 
-// Deprecated: Use UserDetailInfo.ProtoReflect.Descriptor instead.
-func (*UserDetailInfo) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{8}
+func (x ApproveFollowRequestResponse_ApproveFollowRequestStatus) Enum() *ApproveFollowRequestResponse_ApproveFollowRequestStatus {
+	p := new(ApproveFollowRequestResponse_ApproveFollowRequestStatus)
+	*p = x
+	return p
 }
 
-func (x *UserDetailInfo) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x ApproveFollowRequestResponse_ApproveFollowRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *UserDetailInfo) GetUserName() string {
-	if x != nil {
-		return x.UserName
-	}
-	return ""
+func (ApproveFollowRequestResponse_ApproveFollowRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[25].Descriptor()
 }
 
-func (x *UserDetailInfo) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
+func (ApproveFollowRequestResponse_ApproveFollowRequestStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[25]
 }
 
-func (x *UserDetailInfo) GetLastName() string {
-	if x != nil {
-		return x.LastName
-	}
-	return ""
+func (x ApproveFollowRequestResponse_ApproveFollowRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UserDetailInfo) GetDateOfBirth() *timestamp.Timestamp {
-	if x != nil {
-		return x.DateOfBirth
-	}
-	return nil
+// Deprecated: Use ApproveFollowRequestResponse_ApproveFollowRequestStatus.Descriptor instead.
+func (ApproveFollowRequestResponse_ApproveFollowRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{51, 0}
 }
 
-func (x *UserDetailInfo) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
+type RejectFollowRequestResponse_RejectFollowRequestStatus int32
 
-func (x *UserDetailInfo) GetProfilePicture() string {
-	if x != nil {
-		return x.ProfilePicture
+const (
+	RejectFollowRequestResponse_OK                RejectFollowRequestResponse_RejectFollowRequestStatus = 0
+	RejectFollowRequestResponse_USER_NOT_FOUND    RejectFollowRequestResponse_RejectFollowRequestStatus = 1
+	RejectFollowRequestResponse_REQUEST_NOT_FOUND RejectFollowRequestResponse_RejectFollowRequestStatus = 2
+)
+
+// Enum value maps for RejectFollowRequestResponse_RejectFollowRequestStatus.
+var (
+	RejectFollowRequestResponse_RejectFollowRequestStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "REQUEST_NOT_FOUND",
 	}
-	return ""
+	RejectFollowRequestResponse_RejectFollowRequestStatus_value = map[string]int32{
+		"OK":                0,
+		"USER_NOT_FOUND":    1,
+		"REQUEST_NOT_FOUND": 2,
+	}
+)
+
+func (x RejectFollowRequestResponse_RejectFollowRequestStatus) Enum() *RejectFollowRequestResponse_RejectFollowRequestStatus {
+	p := new(RejectFollowRequestResponse_RejectFollowRequestStatus)
+	*p = x
+	return p
 }
 
-func (x *UserDetailInfo) GetCoverPicture() string {
-	if x != nil {
-		return x.CoverPicture
-	}
-	return ""
+func (x RejectFollowRequestResponse_RejectFollowRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type GetUserFollowerRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (RejectFollowRequestResponse_RejectFollowRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[26].Descriptor()
+}
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (RejectFollowRequestResponse_RejectFollowRequestStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[26]
 }
 
-func (x *GetUserFollowerRequest) Reset() {
-	*x = GetUserFollowerRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x RejectFollowRequestResponse_RejectFollowRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetUserFollowerRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use RejectFollowRequestResponse_RejectFollowRequestStatus.Descriptor instead.
+func (RejectFollowRequestResponse_RejectFollowRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{53, 0}
 }
 
-func (*GetUserFollowerRequest) ProtoMessage() {}
+type GetRelationshipResponse_GetRelationshipStatus int32
 
-func (x *GetUserFollowerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	GetRelationshipResponse_OK               GetRelationshipResponse_GetRelationshipStatus = 0
+	GetRelationshipResponse_USER_NOT_FOUND   GetRelationshipResponse_GetRelationshipStatus = 1
+	GetRelationshipResponse_TOO_MANY_TARGETS GetRelationshipResponse_GetRelationshipStatus = 2
+)
+
+// Enum value maps for GetRelationshipResponse_GetRelationshipStatus.
+var (
+	GetRelationshipResponse_GetRelationshipStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "TOO_MANY_TARGETS",
 	}
-	return mi.MessageOf(x)
-}
+	GetRelationshipResponse_GetRelationshipStatus_value = map[string]int32{
+		"OK":               0,
+		"USER_NOT_FOUND":   1,
+		"TOO_MANY_TARGETS": 2,
+	}
+)
 
-// Deprecated: Use GetUserFollowerRequest.ProtoReflect.Descriptor instead.
-func (*GetUserFollowerRequest) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{9}
+func (x GetRelationshipResponse_GetRelationshipStatus) Enum() *GetRelationshipResponse_GetRelationshipStatus {
+	p := new(GetRelationshipResponse_GetRelationshipStatus)
+	*p = x
+	return p
 }
 
-func (x *GetUserFollowerRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x GetRelationshipResponse_GetRelationshipStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type GetUserFollowerResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (GetRelationshipResponse_GetRelationshipStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[27].Descriptor()
+}
 
-	Status       GetUserFollowerResponse_GetUserFollowerStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetUserFollowerResponse_GetUserFollowerStatus" json:"status,omitempty"`
-	FollowersIds []int64                                       `protobuf:"varint,2,rep,packed,name=followersIds,proto3" json:"followersIds,omitempty"`
+func (GetRelationshipResponse_GetRelationshipStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[27]
 }
 
-func (x *GetUserFollowerResponse) Reset() {
-	*x = GetUserFollowerResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x GetRelationshipResponse_GetRelationshipStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetUserFollowerResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use GetRelationshipResponse_GetRelationshipStatus.Descriptor instead.
+func (GetRelationshipResponse_GetRelationshipStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{56, 0}
 }
 
-func (*GetUserFollowerResponse) ProtoMessage() {}
+type GetFollowSuggestionsResponse_GetFollowSuggestionsStatus int32
 
-func (x *GetUserFollowerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	GetFollowSuggestionsResponse_OK             GetFollowSuggestionsResponse_GetFollowSuggestionsStatus = 0
+	GetFollowSuggestionsResponse_USER_NOT_FOUND GetFollowSuggestionsResponse_GetFollowSuggestionsStatus = 1
+)
+
+// Enum value maps for GetFollowSuggestionsResponse_GetFollowSuggestionsStatus.
+var (
+	GetFollowSuggestionsResponse_GetFollowSuggestionsStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
 	}
-	return mi.MessageOf(x)
+	GetFollowSuggestionsResponse_GetFollowSuggestionsStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+	}
+)
+
+func (x GetFollowSuggestionsResponse_GetFollowSuggestionsStatus) Enum() *GetFollowSuggestionsResponse_GetFollowSuggestionsStatus {
+	p := new(GetFollowSuggestionsResponse_GetFollowSuggestionsStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use GetUserFollowerResponse.ProtoReflect.Descriptor instead.
-func (*GetUserFollowerResponse) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{10}
+func (x GetFollowSuggestionsResponse_GetFollowSuggestionsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *GetUserFollowerResponse) GetStatus() GetUserFollowerResponse_GetUserFollowerStatus {
-	if x != nil {
-		return x.Status
-	}
-	return GetUserFollowerResponse_OK
+func (GetFollowSuggestionsResponse_GetFollowSuggestionsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[28].Descriptor()
 }
 
-func (x *GetUserFollowerResponse) GetFollowersIds() []int64 {
-	if x != nil {
-		return x.FollowersIds
-	}
-	return nil
+func (GetFollowSuggestionsResponse_GetFollowSuggestionsStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[28]
 }
 
-type GetUserFollowingRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x GetFollowSuggestionsResponse_GetFollowSuggestionsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+// Deprecated: Use GetFollowSuggestionsResponse_GetFollowSuggestionsStatus.Descriptor instead.
+func (GetFollowSuggestionsResponse_GetFollowSuggestionsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{58, 0}
 }
 
-func (x *GetUserFollowingRequest) Reset() {
-	*x = GetUserFollowingRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type CreateAudienceListResponse_CreateAudienceListStatus int32
+
+const (
+	CreateAudienceListResponse_OK             CreateAudienceListResponse_CreateAudienceListStatus = 0
+	CreateAudienceListResponse_USER_NOT_FOUND CreateAudienceListResponse_CreateAudienceListStatus = 1
+	CreateAudienceListResponse_ALREADY_EXISTS CreateAudienceListResponse_CreateAudienceListStatus = 2
+)
+
+// Enum value maps for CreateAudienceListResponse_CreateAudienceListStatus.
+var (
+	CreateAudienceListResponse_CreateAudienceListStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "ALREADY_EXISTS",
+	}
+	CreateAudienceListResponse_CreateAudienceListStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"ALREADY_EXISTS": 2,
 	}
+)
+
+func (x CreateAudienceListResponse_CreateAudienceListStatus) Enum() *CreateAudienceListResponse_CreateAudienceListStatus {
+	p := new(CreateAudienceListResponse_CreateAudienceListStatus)
+	*p = x
+	return p
 }
 
-func (x *GetUserFollowingRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x CreateAudienceListResponse_CreateAudienceListStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetUserFollowingRequest) ProtoMessage() {}
+func (CreateAudienceListResponse_CreateAudienceListStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[29].Descriptor()
+}
 
-func (x *GetUserFollowingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (CreateAudienceListResponse_CreateAudienceListStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[29]
 }
 
-// Deprecated: Use GetUserFollowingRequest.ProtoReflect.Descriptor instead.
-func (*GetUserFollowingRequest) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{11}
+func (x CreateAudienceListResponse_CreateAudienceListStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetUserFollowingRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use CreateAudienceListResponse_CreateAudienceListStatus.Descriptor instead.
+func (CreateAudienceListResponse_CreateAudienceListStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{61, 0}
 }
 
-type GetUserFollowingResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type DeleteAudienceListResponse_DeleteAudienceListStatus int32
 
-	Status        GetUserFollowingResponse_GetUserFollowingStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetUserFollowingResponse_GetUserFollowingStatus" json:"status,omitempty"`
-	FollowingsIds []int64                                         `protobuf:"varint,2,rep,packed,name=followingsIds,proto3" json:"followingsIds,omitempty"`
-}
+const (
+	DeleteAudienceListResponse_OK             DeleteAudienceListResponse_DeleteAudienceListStatus = 0
+	DeleteAudienceListResponse_USER_NOT_FOUND DeleteAudienceListResponse_DeleteAudienceListStatus = 1
+	DeleteAudienceListResponse_LIST_NOT_FOUND DeleteAudienceListResponse_DeleteAudienceListStatus = 2
+)
 
-func (x *GetUserFollowingResponse) Reset() {
-	*x = GetUserFollowingResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for DeleteAudienceListResponse_DeleteAudienceListStatus.
+var (
+	DeleteAudienceListResponse_DeleteAudienceListStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "LIST_NOT_FOUND",
 	}
-}
+	DeleteAudienceListResponse_DeleteAudienceListStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"LIST_NOT_FOUND": 2,
+	}
+)
 
-func (x *GetUserFollowingResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x DeleteAudienceListResponse_DeleteAudienceListStatus) Enum() *DeleteAudienceListResponse_DeleteAudienceListStatus {
+	p := new(DeleteAudienceListResponse_DeleteAudienceListStatus)
+	*p = x
+	return p
 }
 
-func (*GetUserFollowingResponse) ProtoMessage() {}
+func (x DeleteAudienceListResponse_DeleteAudienceListStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *GetUserFollowingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (DeleteAudienceListResponse_DeleteAudienceListStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[30].Descriptor()
 }
 
-// Deprecated: Use GetUserFollowingResponse.ProtoReflect.Descriptor instead.
-func (*GetUserFollowingResponse) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{12}
+func (DeleteAudienceListResponse_DeleteAudienceListStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[30]
 }
 
-func (x *GetUserFollowingResponse) GetStatus() GetUserFollowingResponse_GetUserFollowingStatus {
-	if x != nil {
-		return x.Status
-	}
-	return GetUserFollowingResponse_OK
+func (x DeleteAudienceListResponse_DeleteAudienceListStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetUserFollowingResponse) GetFollowingsIds() []int64 {
-	if x != nil {
-		return x.FollowingsIds
-	}
-	return nil
+// Deprecated: Use DeleteAudienceListResponse_DeleteAudienceListStatus.Descriptor instead.
+func (DeleteAudienceListResponse_DeleteAudienceListStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{63, 0}
 }
 
-type FollowUserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type GetAudienceListsResponse_GetAudienceListsStatus int32
 
-	UserId      int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FollowingId int64 `protobuf:"varint,2,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
-}
+const (
+	GetAudienceListsResponse_OK             GetAudienceListsResponse_GetAudienceListsStatus = 0
+	GetAudienceListsResponse_USER_NOT_FOUND GetAudienceListsResponse_GetAudienceListsStatus = 1
+)
 
-func (x *FollowUserRequest) Reset() {
-	*x = FollowUserRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for GetAudienceListsResponse_GetAudienceListsStatus.
+var (
+	GetAudienceListsResponse_GetAudienceListsStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
 	}
-}
+	GetAudienceListsResponse_GetAudienceListsStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+	}
+)
 
-func (x *FollowUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetAudienceListsResponse_GetAudienceListsStatus) Enum() *GetAudienceListsResponse_GetAudienceListsStatus {
+	p := new(GetAudienceListsResponse_GetAudienceListsStatus)
+	*p = x
+	return p
 }
 
-func (*FollowUserRequest) ProtoMessage() {}
+func (x GetAudienceListsResponse_GetAudienceListsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (GetAudienceListsResponse_GetAudienceListsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[31].Descriptor()
 }
 
-// Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
-func (*FollowUserRequest) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{13}
+func (GetAudienceListsResponse_GetAudienceListsStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[31]
 }
 
-func (x *FollowUserRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x GetAudienceListsResponse_GetAudienceListsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *FollowUserRequest) GetFollowingId() int64 {
-	if x != nil {
-		return x.FollowingId
-	}
-	return 0
+// Deprecated: Use GetAudienceListsResponse_GetAudienceListsStatus.Descriptor instead.
+func (GetAudienceListsResponse_GetAudienceListsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{65, 0}
 }
 
-type FollowUserResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type GetAudienceListResponse_GetAudienceListStatus int32
 
-	Status FollowUserResponse_FollowUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.FollowUserResponse_FollowUserStatus" json:"status,omitempty"`
-}
+const (
+	GetAudienceListResponse_OK             GetAudienceListResponse_GetAudienceListStatus = 0
+	GetAudienceListResponse_USER_NOT_FOUND GetAudienceListResponse_GetAudienceListStatus = 1
+	GetAudienceListResponse_LIST_NOT_FOUND GetAudienceListResponse_GetAudienceListStatus = 2
+)
 
-func (x *FollowUserResponse) Reset() {
-	*x = FollowUserResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for GetAudienceListResponse_GetAudienceListStatus.
+var (
+	GetAudienceListResponse_GetAudienceListStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "LIST_NOT_FOUND",
+	}
+	GetAudienceListResponse_GetAudienceListStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"LIST_NOT_FOUND": 2,
 	}
+)
+
+func (x GetAudienceListResponse_GetAudienceListStatus) Enum() *GetAudienceListResponse_GetAudienceListStatus {
+	p := new(GetAudienceListResponse_GetAudienceListStatus)
+	*p = x
+	return p
 }
 
-func (x *FollowUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetAudienceListResponse_GetAudienceListStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*FollowUserResponse) ProtoMessage() {}
+func (GetAudienceListResponse_GetAudienceListStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[32].Descriptor()
+}
 
-func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (GetAudienceListResponse_GetAudienceListStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[32]
 }
 
-// Deprecated: Use FollowUserResponse.ProtoReflect.Descriptor instead.
-func (*FollowUserResponse) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{14}
+func (x GetAudienceListResponse_GetAudienceListStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *FollowUserResponse) GetStatus() FollowUserResponse_FollowUserStatus {
-	if x != nil {
-		return x.Status
-	}
-	return FollowUserResponse_OK
+// Deprecated: Use GetAudienceListResponse_GetAudienceListStatus.Descriptor instead.
+func (GetAudienceListResponse_GetAudienceListStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{67, 0}
 }
 
-type UnfollowUserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type AddAudienceListMemberResponse_AddAudienceListMemberStatus int32
 
-	UserId      int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FollowingId int64 `protobuf:"varint,2,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
-}
+const (
+	AddAudienceListMemberResponse_OK             AddAudienceListMemberResponse_AddAudienceListMemberStatus = 0
+	AddAudienceListMemberResponse_USER_NOT_FOUND AddAudienceListMemberResponse_AddAudienceListMemberStatus = 1
+	AddAudienceListMemberResponse_LIST_NOT_FOUND AddAudienceListMemberResponse_AddAudienceListMemberStatus = 2
+	AddAudienceListMemberResponse_ALREADY_MEMBER AddAudienceListMemberResponse_AddAudienceListMemberStatus = 3
+)
 
-func (x *UnfollowUserRequest) Reset() {
-	*x = UnfollowUserRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for AddAudienceListMemberResponse_AddAudienceListMemberStatus.
+var (
+	AddAudienceListMemberResponse_AddAudienceListMemberStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "LIST_NOT_FOUND",
+		3: "ALREADY_MEMBER",
 	}
-}
+	AddAudienceListMemberResponse_AddAudienceListMemberStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"LIST_NOT_FOUND": 2,
+		"ALREADY_MEMBER": 3,
+	}
+)
 
-func (x *UnfollowUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x AddAudienceListMemberResponse_AddAudienceListMemberStatus) Enum() *AddAudienceListMemberResponse_AddAudienceListMemberStatus {
+	p := new(AddAudienceListMemberResponse_AddAudienceListMemberStatus)
+	*p = x
+	return p
 }
 
-func (*UnfollowUserRequest) ProtoMessage() {}
+func (x AddAudienceListMemberResponse_AddAudienceListMemberStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (AddAudienceListMemberResponse_AddAudienceListMemberStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[33].Descriptor()
 }
 
-// Deprecated: Use UnfollowUserRequest.ProtoReflect.Descriptor instead.
-func (*UnfollowUserRequest) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{15}
+func (AddAudienceListMemberResponse_AddAudienceListMemberStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[33]
 }
 
-func (x *UnfollowUserRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x AddAudienceListMemberResponse_AddAudienceListMemberStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UnfollowUserRequest) GetFollowingId() int64 {
-	if x != nil {
-		return x.FollowingId
-	}
-	return 0
+// Deprecated: Use AddAudienceListMemberResponse_AddAudienceListMemberStatus.Descriptor instead.
+func (AddAudienceListMemberResponse_AddAudienceListMemberStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{69, 0}
 }
 
-type UnfollowUserResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus int32
 
-	Status UnfollowUserResponse_UnfollowUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.UnfollowUserResponse_UnfollowUserStatus" json:"status,omitempty"`
-}
+const (
+	RemoveAudienceListMemberResponse_OK             RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus = 0
+	RemoveAudienceListMemberResponse_USER_NOT_FOUND RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus = 1
+	RemoveAudienceListMemberResponse_LIST_NOT_FOUND RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus = 2
+	RemoveAudienceListMemberResponse_NOT_MEMBER     RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus = 3
+)
 
-func (x *UnfollowUserResponse) Reset() {
-	*x = UnfollowUserResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus.
+var (
+	RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "LIST_NOT_FOUND",
+		3: "NOT_MEMBER",
 	}
+	RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"LIST_NOT_FOUND": 2,
+		"NOT_MEMBER":     3,
+	}
+)
+
+func (x RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus) Enum() *RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus {
+	p := new(RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus)
+	*p = x
+	return p
 }
 
-func (x *UnfollowUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*UnfollowUserResponse) ProtoMessage() {}
+func (RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[34].Descriptor()
+}
 
-func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[34]
 }
 
-// Deprecated: Use UnfollowUserResponse.ProtoReflect.Descriptor instead.
-func (*UnfollowUserResponse) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{16}
+func (x RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UnfollowUserResponse) GetStatus() UnfollowUserResponse_UnfollowUserStatus {
-	if x != nil {
-		return x.Status
-	}
-	return UnfollowUserResponse_OK
+// Deprecated: Use RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus.Descriptor instead.
+func (RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{71, 0}
 }
 
-type GetUserPostsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type BlockUserResponse_BlockUserStatus int32
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-}
+const (
+	BlockUserResponse_OK              BlockUserResponse_BlockUserStatus = 0
+	BlockUserResponse_USER_NOT_FOUND  BlockUserResponse_BlockUserStatus = 1
+	BlockUserResponse_ALREADY_BLOCKED BlockUserResponse_BlockUserStatus = 2
+	BlockUserResponse_NOT_ALLOWED     BlockUserResponse_BlockUserStatus = 3
+)
 
-func (x *GetUserPostsRequest) Reset() {
-	*x = GetUserPostsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for BlockUserResponse_BlockUserStatus.
+var (
+	BlockUserResponse_BlockUserStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "ALREADY_BLOCKED",
+		3: "NOT_ALLOWED",
 	}
+	BlockUserResponse_BlockUserStatus_value = map[string]int32{
+		"OK":              0,
+		"USER_NOT_FOUND":  1,
+		"ALREADY_BLOCKED": 2,
+		"NOT_ALLOWED":     3,
+	}
+)
+
+func (x BlockUserResponse_BlockUserStatus) Enum() *BlockUserResponse_BlockUserStatus {
+	p := new(BlockUserResponse_BlockUserStatus)
+	*p = x
+	return p
 }
 
-func (x *GetUserPostsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x BlockUserResponse_BlockUserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetUserPostsRequest) ProtoMessage() {}
+func (BlockUserResponse_BlockUserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[35].Descriptor()
+}
 
-func (x *GetUserPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (BlockUserResponse_BlockUserStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[35]
 }
 
-// Deprecated: Use GetUserPostsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserPostsRequest) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{17}
+func (x BlockUserResponse_BlockUserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetUserPostsRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use BlockUserResponse_BlockUserStatus.Descriptor instead.
+func (BlockUserResponse_BlockUserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{73, 0}
 }
 
-type GetUserPostsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type UnblockUserResponse_UnblockUserStatus int32
 
-	Status   GetUserPostsResponse_GetUserPostsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetUserPostsResponse_GetUserPostsStatus" json:"status,omitempty"`
-	PostsIds []int64                                 `protobuf:"varint,2,rep,packed,name=posts_ids,json=postsIds,proto3" json:"posts_ids,omitempty"`
-}
+const (
+	UnblockUserResponse_OK             UnblockUserResponse_UnblockUserStatus = 0
+	UnblockUserResponse_USER_NOT_FOUND UnblockUserResponse_UnblockUserStatus = 1
+	UnblockUserResponse_NOT_BLOCKED    UnblockUserResponse_UnblockUserStatus = 2
+)
 
-func (x *GetUserPostsResponse) Reset() {
-	*x = GetUserPostsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for UnblockUserResponse_UnblockUserStatus.
+var (
+	UnblockUserResponse_UnblockUserStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "NOT_BLOCKED",
 	}
-}
+	UnblockUserResponse_UnblockUserStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"NOT_BLOCKED":    2,
+	}
+)
 
-func (x *GetUserPostsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x UnblockUserResponse_UnblockUserStatus) Enum() *UnblockUserResponse_UnblockUserStatus {
+	p := new(UnblockUserResponse_UnblockUserStatus)
+	*p = x
+	return p
 }
 
-func (*GetUserPostsResponse) ProtoMessage() {}
+func (x UnblockUserResponse_UnblockUserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *GetUserPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (UnblockUserResponse_UnblockUserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[36].Descriptor()
 }
 
-// Deprecated: Use GetUserPostsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserPostsResponse) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{18}
+func (UnblockUserResponse_UnblockUserStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[36]
 }
 
-func (x *GetUserPostsResponse) GetStatus() GetUserPostsResponse_GetUserPostsStatus {
-	if x != nil {
-		return x.Status
-	}
-	return GetUserPostsResponse_OK
+func (x UnblockUserResponse_UnblockUserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetUserPostsResponse) GetPostsIds() []int64 {
-	if x != nil {
-		return x.PostsIds
-	}
-	return nil
+// Deprecated: Use UnblockUserResponse_UnblockUserStatus.Descriptor instead.
+func (UnblockUserResponse_UnblockUserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{75, 0}
 }
 
-type CreatePostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type MuteUserResponse_MuteUserStatus int32
 
-	UserId           int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ContentText      string   `protobuf:"bytes,2,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
-	ContentImagePath []string `protobuf:"bytes,3,rep,name=content_image_path,json=contentImagePath,proto3" json:"content_image_path,omitempty"`
-	Visible          bool     `protobuf:"varint,4,opt,name=visible,proto3" json:"visible,omitempty"`
-}
+const (
+	MuteUserResponse_OK             MuteUserResponse_MuteUserStatus = 0
+	MuteUserResponse_USER_NOT_FOUND MuteUserResponse_MuteUserStatus = 1
+	MuteUserResponse_ALREADY_MUTED  MuteUserResponse_MuteUserStatus = 2
+	MuteUserResponse_NOT_ALLOWED    MuteUserResponse_MuteUserStatus = 3
+)
 
-func (x *CreatePostRequest) Reset() {
-	*x = CreatePostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for MuteUserResponse_MuteUserStatus.
+var (
+	MuteUserResponse_MuteUserStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "ALREADY_MUTED",
+		3: "NOT_ALLOWED",
 	}
-}
+	MuteUserResponse_MuteUserStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"ALREADY_MUTED":  2,
+		"NOT_ALLOWED":    3,
+	}
+)
 
-func (x *CreatePostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x MuteUserResponse_MuteUserStatus) Enum() *MuteUserResponse_MuteUserStatus {
+	p := new(MuteUserResponse_MuteUserStatus)
+	*p = x
+	return p
 }
 
-func (*CreatePostRequest) ProtoMessage() {}
+func (x MuteUserResponse_MuteUserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (MuteUserResponse_MuteUserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[37].Descriptor()
 }
 
-// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
-func (*CreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{19}
+func (MuteUserResponse_MuteUserStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[37]
 }
 
-func (x *CreatePostRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x MuteUserResponse_MuteUserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreatePostRequest) GetContentText() string {
-	if x != nil {
-		return x.ContentText
-	}
-	return ""
+// Deprecated: Use MuteUserResponse_MuteUserStatus.Descriptor instead.
+func (MuteUserResponse_MuteUserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{77, 0}
 }
 
-func (x *CreatePostRequest) GetContentImagePath() []string {
-	if x != nil {
-		return x.ContentImagePath
+type UnmuteUserResponse_UnmuteUserStatus int32
+
+const (
+	UnmuteUserResponse_OK             UnmuteUserResponse_UnmuteUserStatus = 0
+	UnmuteUserResponse_USER_NOT_FOUND UnmuteUserResponse_UnmuteUserStatus = 1
+	UnmuteUserResponse_NOT_MUTED      UnmuteUserResponse_UnmuteUserStatus = 2
+)
+
+// Enum value maps for UnmuteUserResponse_UnmuteUserStatus.
+var (
+	UnmuteUserResponse_UnmuteUserStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "NOT_MUTED",
 	}
-	return nil
+	UnmuteUserResponse_UnmuteUserStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"NOT_MUTED":      2,
+	}
+)
+
+func (x UnmuteUserResponse_UnmuteUserStatus) Enum() *UnmuteUserResponse_UnmuteUserStatus {
+	p := new(UnmuteUserResponse_UnmuteUserStatus)
+	*p = x
+	return p
 }
 
-func (x *CreatePostRequest) GetVisible() bool {
-	if x != nil {
-		return x.Visible
-	}
-	return false
+func (x UnmuteUserResponse_UnmuteUserStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type CreatePostResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (UnmuteUserResponse_UnmuteUserStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[38].Descriptor()
+}
 
-	Status CreatePostResponse_CreatePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.CreatePostResponse_CreatePostStatus" json:"status,omitempty"`
-	PostId int64                               `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+func (UnmuteUserResponse_UnmuteUserStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[38]
 }
 
-func (x *CreatePostResponse) Reset() {
-	*x = CreatePostResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x UnmuteUserResponse_UnmuteUserStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreatePostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use UnmuteUserResponse_UnmuteUserStatus.Descriptor instead.
+func (UnmuteUserResponse_UnmuteUserStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{79, 0}
 }
 
-func (*CreatePostResponse) ProtoMessage() {}
+type GetUserPostsResponse_GetUserPostsStatus int32
 
-func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	GetUserPostsResponse_OK              GetUserPostsResponse_GetUserPostsStatus = 0
+	GetUserPostsResponse_USER_NOT_FOUND  GetUserPostsResponse_GetUserPostsStatus = 1
+	GetUserPostsResponse_PRIVATE_ACCOUNT GetUserPostsResponse_GetUserPostsStatus = 2
+)
+
+// Enum value maps for GetUserPostsResponse_GetUserPostsStatus.
+var (
+	GetUserPostsResponse_GetUserPostsStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "PRIVATE_ACCOUNT",
 	}
-	return mi.MessageOf(x)
+	GetUserPostsResponse_GetUserPostsStatus_value = map[string]int32{
+		"OK":              0,
+		"USER_NOT_FOUND":  1,
+		"PRIVATE_ACCOUNT": 2,
+	}
+)
+
+func (x GetUserPostsResponse_GetUserPostsStatus) Enum() *GetUserPostsResponse_GetUserPostsStatus {
+	p := new(GetUserPostsResponse_GetUserPostsStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
-func (*CreatePostResponse) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{20}
+func (x GetUserPostsResponse_GetUserPostsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *CreatePostResponse) GetStatus() CreatePostResponse_CreatePostStatus {
-	if x != nil {
-		return x.Status
-	}
-	return CreatePostResponse_OK
+func (GetUserPostsResponse_GetUserPostsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[39].Descriptor()
 }
 
-func (x *CreatePostResponse) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+func (GetUserPostsResponse_GetUserPostsStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[39]
 }
 
-type GetPostDetailInfoRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x GetUserPostsResponse_GetUserPostsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+// Deprecated: Use GetUserPostsResponse_GetUserPostsStatus.Descriptor instead.
+func (GetUserPostsResponse_GetUserPostsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{81, 0}
 }
 
-func (x *GetPostDetailInfoRequest) Reset() {
-	*x = GetPostDetailInfoRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type CreatePostResponse_CreatePostStatus int32
+
+const (
+	CreatePostResponse_OK                      CreatePostResponse_CreatePostStatus = 0
+	CreatePostResponse_USER_NOT_FOUND          CreatePostResponse_CreatePostStatus = 1
+	CreatePostResponse_INVALID_POLL            CreatePostResponse_CreatePostStatus = 2
+	CreatePostResponse_EMAIL_NOT_VERIFIED      CreatePostResponse_CreatePostStatus = 3
+	CreatePostResponse_AUDIENCE_LIST_NOT_FOUND CreatePostResponse_CreatePostStatus = 4
+)
+
+// Enum value maps for CreatePostResponse_CreatePostStatus.
+var (
+	CreatePostResponse_CreatePostStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "INVALID_POLL",
+		3: "EMAIL_NOT_VERIFIED",
+		4: "AUDIENCE_LIST_NOT_FOUND",
 	}
+	CreatePostResponse_CreatePostStatus_value = map[string]int32{
+		"OK":                      0,
+		"USER_NOT_FOUND":          1,
+		"INVALID_POLL":            2,
+		"EMAIL_NOT_VERIFIED":      3,
+		"AUDIENCE_LIST_NOT_FOUND": 4,
+	}
+)
+
+func (x CreatePostResponse_CreatePostStatus) Enum() *CreatePostResponse_CreatePostStatus {
+	p := new(CreatePostResponse_CreatePostStatus)
+	*p = x
+	return p
 }
 
-func (x *GetPostDetailInfoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x CreatePostResponse_CreatePostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetPostDetailInfoRequest) ProtoMessage() {}
+func (CreatePostResponse_CreatePostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[40].Descriptor()
+}
 
-func (x *GetPostDetailInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (CreatePostResponse_CreatePostStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[40]
 }
 
-// Deprecated: Use GetPostDetailInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetPostDetailInfoRequest) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{21}
+func (x CreatePostResponse_CreatePostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetPostDetailInfoRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+// Deprecated: Use CreatePostResponse_CreatePostStatus.Descriptor instead.
+func (CreatePostResponse_CreatePostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{84, 0}
 }
 
-type GetPostDetailInfoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type GetPostDetailInfoResponse_GetPostDetailInfoStatus int32
 
-	Status GetPostDetailInfoResponse_GetPostDetailInfoStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetPostDetailInfoResponse_GetPostDetailInfoStatus" json:"status,omitempty"`
-	Post   *PostDetailInfo                                   `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
-}
+const (
+	GetPostDetailInfoResponse_OK             GetPostDetailInfoResponse_GetPostDetailInfoStatus = 0
+	GetPostDetailInfoResponse_POST_NOT_FOUND GetPostDetailInfoResponse_GetPostDetailInfoStatus = 1
+)
 
-func (x *GetPostDetailInfoResponse) Reset() {
-	*x = GetPostDetailInfoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for GetPostDetailInfoResponse_GetPostDetailInfoStatus.
+var (
+	GetPostDetailInfoResponse_GetPostDetailInfoStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
 	}
-}
-
-func (x *GetPostDetailInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetPostDetailInfoResponse) ProtoMessage() {}
-
-func (x *GetPostDetailInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+	GetPostDetailInfoResponse_GetPostDetailInfoStatus_value = map[string]int32{
+		"OK":             0,
+		"POST_NOT_FOUND": 1,
 	}
-	return mi.MessageOf(x)
-}
+)
 
-// Deprecated: Use GetPostDetailInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetPostDetailInfoResponse) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{22}
+func (x GetPostDetailInfoResponse_GetPostDetailInfoStatus) Enum() *GetPostDetailInfoResponse_GetPostDetailInfoStatus {
+	p := new(GetPostDetailInfoResponse_GetPostDetailInfoStatus)
+	*p = x
+	return p
 }
 
-func (x *GetPostDetailInfoResponse) GetStatus() GetPostDetailInfoResponse_GetPostDetailInfoStatus {
-	if x != nil {
-		return x.Status
-	}
-	return GetPostDetailInfoResponse_OK
+func (x GetPostDetailInfoResponse_GetPostDetailInfoStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *GetPostDetailInfoResponse) GetPost() *PostDetailInfo {
-	if x != nil {
-		return x.Post
-	}
-	return nil
+func (GetPostDetailInfoResponse_GetPostDetailInfoStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[41].Descriptor()
 }
 
-type EditPostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserId           int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId           int64   `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	ContentText      *string `protobuf:"bytes,3,opt,name=content_text,json=contentText,proto3,oneof" json:"content_text,omitempty"`
-	ContentImagePath *string `protobuf:"bytes,4,opt,name=content_image_path,json=contentImagePath,proto3,oneof" json:"content_image_path,omitempty"`
-	Visible          *bool   `protobuf:"varint,5,opt,name=visible,proto3,oneof" json:"visible,omitempty"`
+func (GetPostDetailInfoResponse_GetPostDetailInfoStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[41]
 }
 
-func (x *EditPostRequest) Reset() {
-	*x = EditPostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x GetPostDetailInfoResponse_GetPostDetailInfoStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *EditPostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use GetPostDetailInfoResponse_GetPostDetailInfoStatus.Descriptor instead.
+func (GetPostDetailInfoResponse_GetPostDetailInfoStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{86, 0}
 }
 
-func (*EditPostRequest) ProtoMessage() {}
+type EditPostResponse_EditPostStatus int32
 
-func (x *EditPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	EditPostResponse_OK             EditPostResponse_EditPostStatus = 0
+	EditPostResponse_POST_NOT_FOUND EditPostResponse_EditPostStatus = 1
+	EditPostResponse_NOT_ALLOWED    EditPostResponse_EditPostStatus = 2
+	EditPostResponse_USER_NOT_FOUND EditPostResponse_EditPostStatus = 3
+)
+
+// Enum value maps for EditPostResponse_EditPostStatus.
+var (
+	EditPostResponse_EditPostStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "NOT_ALLOWED",
+		3: "USER_NOT_FOUND",
 	}
-	return mi.MessageOf(x)
-}
+	EditPostResponse_EditPostStatus_value = map[string]int32{
+		"OK":             0,
+		"POST_NOT_FOUND": 1,
+		"NOT_ALLOWED":    2,
+		"USER_NOT_FOUND": 3,
+	}
+)
 
-// Deprecated: Use EditPostRequest.ProtoReflect.Descriptor instead.
-func (*EditPostRequest) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{23}
+func (x EditPostResponse_EditPostStatus) Enum() *EditPostResponse_EditPostStatus {
+	p := new(EditPostResponse_EditPostStatus)
+	*p = x
+	return p
 }
 
-func (x *EditPostRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x EditPostResponse_EditPostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *EditPostRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+func (EditPostResponse_EditPostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[42].Descriptor()
 }
 
-func (x *EditPostRequest) GetContentText() string {
-	if x != nil && x.ContentText != nil {
-		return *x.ContentText
-	}
-	return ""
+func (EditPostResponse_EditPostStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[42]
 }
 
-func (x *EditPostRequest) GetContentImagePath() string {
-	if x != nil && x.ContentImagePath != nil {
-		return *x.ContentImagePath
-	}
-	return ""
+func (x EditPostResponse_EditPostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *EditPostRequest) GetVisible() bool {
-	if x != nil && x.Visible != nil {
-		return *x.Visible
-	}
-	return false
+// Deprecated: Use EditPostResponse_EditPostStatus.Descriptor instead.
+func (EditPostResponse_EditPostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{88, 0}
 }
 
-type EditPostResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type DeletePostResponse_DeletePostStatus int32
 
-	Status EditPostResponse_EditPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.EditPostResponse_EditPostStatus" json:"status,omitempty"`
-}
+const (
+	DeletePostResponse_OK             DeletePostResponse_DeletePostStatus = 0
+	DeletePostResponse_POST_NOT_FOUND DeletePostResponse_DeletePostStatus = 1
+	DeletePostResponse_NOT_ALLOWED    DeletePostResponse_DeletePostStatus = 2
+	DeletePostResponse_USER_NOT_FOUND DeletePostResponse_DeletePostStatus = 3
+)
 
-func (x *EditPostResponse) Reset() {
-	*x = EditPostResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for DeletePostResponse_DeletePostStatus.
+var (
+	DeletePostResponse_DeletePostStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "NOT_ALLOWED",
+		3: "USER_NOT_FOUND",
+	}
+	DeletePostResponse_DeletePostStatus_value = map[string]int32{
+		"OK":             0,
+		"POST_NOT_FOUND": 1,
+		"NOT_ALLOWED":    2,
+		"USER_NOT_FOUND": 3,
 	}
+)
+
+func (x DeletePostResponse_DeletePostStatus) Enum() *DeletePostResponse_DeletePostStatus {
+	p := new(DeletePostResponse_DeletePostStatus)
+	*p = x
+	return p
 }
 
-func (x *EditPostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x DeletePostResponse_DeletePostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*EditPostResponse) ProtoMessage() {}
+func (DeletePostResponse_DeletePostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[43].Descriptor()
+}
 
-func (x *EditPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (DeletePostResponse_DeletePostStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[43]
 }
 
-// Deprecated: Use EditPostResponse.ProtoReflect.Descriptor instead.
-func (*EditPostResponse) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{24}
+func (x DeletePostResponse_DeletePostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *EditPostResponse) GetStatus() EditPostResponse_EditPostStatus {
-	if x != nil {
-		return x.Status
-	}
-	return EditPostResponse_OK
+// Deprecated: Use DeletePostResponse_DeletePostStatus.Descriptor instead.
+func (DeletePostResponse_DeletePostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{90, 0}
 }
 
-type DeletePostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type CommentPostResponse_CommentPostStatus int32
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-}
+const (
+	CommentPostResponse_OK             CommentPostResponse_CommentPostStatus = 0
+	CommentPostResponse_POST_NOT_FOUND CommentPostResponse_CommentPostStatus = 1
+	CommentPostResponse_USER_NOT_FOUND CommentPostResponse_CommentPostStatus = 2
+	CommentPostResponse_NOT_ALLOWED    CommentPostResponse_CommentPostStatus = 3
+)
 
-func (x *DeletePostRequest) Reset() {
-	*x = DeletePostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for CommentPostResponse_CommentPostStatus.
+var (
+	CommentPostResponse_CommentPostStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "USER_NOT_FOUND",
+		3: "NOT_ALLOWED",
 	}
-}
+	CommentPostResponse_CommentPostStatus_value = map[string]int32{
+		"OK":             0,
+		"POST_NOT_FOUND": 1,
+		"USER_NOT_FOUND": 2,
+		"NOT_ALLOWED":    3,
+	}
+)
 
-func (x *DeletePostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x CommentPostResponse_CommentPostStatus) Enum() *CommentPostResponse_CommentPostStatus {
+	p := new(CommentPostResponse_CommentPostStatus)
+	*p = x
+	return p
 }
 
-func (*DeletePostRequest) ProtoMessage() {}
+func (x CommentPostResponse_CommentPostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[25]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (CommentPostResponse_CommentPostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[44].Descriptor()
 }
 
-// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
-func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{25}
+func (CommentPostResponse_CommentPostStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[44]
 }
 
-func (x *DeletePostRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (x CommentPostResponse_CommentPostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *DeletePostRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+// Deprecated: Use CommentPostResponse_CommentPostStatus.Descriptor instead.
+func (CommentPostResponse_CommentPostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{92, 0}
 }
 
-type DeletePostResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type LikePostResponse_LikePostStatus int32
 
-	Status DeletePostResponse_DeletePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.DeletePostResponse_DeletePostStatus" json:"status,omitempty"`
-}
+const (
+	LikePostResponse_OK             LikePostResponse_LikePostStatus = 0
+	LikePostResponse_POST_NOT_FOUND LikePostResponse_LikePostStatus = 1
+	LikePostResponse_USER_NOT_FOUND LikePostResponse_LikePostStatus = 2
+	LikePostResponse_ALREADY_LIKED  LikePostResponse_LikePostStatus = 3
+	LikePostResponse_NOT_ALLOWED    LikePostResponse_LikePostStatus = 4
+)
 
-func (x *DeletePostResponse) Reset() {
-	*x = DeletePostResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for LikePostResponse_LikePostStatus.
+var (
+	LikePostResponse_LikePostStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "USER_NOT_FOUND",
+		3: "ALREADY_LIKED",
+		4: "NOT_ALLOWED",
+	}
+	LikePostResponse_LikePostStatus_value = map[string]int32{
+		"OK":             0,
+		"POST_NOT_FOUND": 1,
+		"USER_NOT_FOUND": 2,
+		"ALREADY_LIKED":  3,
+		"NOT_ALLOWED":    4,
 	}
+)
+
+func (x LikePostResponse_LikePostStatus) Enum() *LikePostResponse_LikePostStatus {
+	p := new(LikePostResponse_LikePostStatus)
+	*p = x
+	return p
 }
 
-func (x *DeletePostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x LikePostResponse_LikePostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*DeletePostResponse) ProtoMessage() {}
+func (LikePostResponse_LikePostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[45].Descriptor()
+}
 
-func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (LikePostResponse_LikePostStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[45]
 }
 
-// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
-func (*DeletePostResponse) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{26}
+func (x LikePostResponse_LikePostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *DeletePostResponse) GetStatus() DeletePostResponse_DeletePostStatus {
-	if x != nil {
-		return x.Status
-	}
-	return DeletePostResponse_OK
+// Deprecated: Use LikePostResponse_LikePostStatus.Descriptor instead.
+func (LikePostResponse_LikePostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{94, 0}
 }
 
-type CommentPostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type PinPostResponse_PinPostStatus int32
 
-	UserId      int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId      int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	ContentText string `protobuf:"bytes,3,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
-}
+const (
+	PinPostResponse_OK                PinPostResponse_PinPostStatus = 0
+	PinPostResponse_POST_NOT_FOUND    PinPostResponse_PinPostStatus = 1
+	PinPostResponse_NOT_ALLOWED       PinPostResponse_PinPostStatus = 2
+	PinPostResponse_USER_NOT_FOUND    PinPostResponse_PinPostStatus = 3
+	PinPostResponse_ALREADY_PINNED    PinPostResponse_PinPostStatus = 4
+	PinPostResponse_PIN_LIMIT_REACHED PinPostResponse_PinPostStatus = 5
+)
 
-func (x *CommentPostRequest) Reset() {
-	*x = CommentPostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for PinPostResponse_PinPostStatus.
+var (
+	PinPostResponse_PinPostStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "NOT_ALLOWED",
+		3: "USER_NOT_FOUND",
+		4: "ALREADY_PINNED",
+		5: "PIN_LIMIT_REACHED",
 	}
-}
+	PinPostResponse_PinPostStatus_value = map[string]int32{
+		"OK":                0,
+		"POST_NOT_FOUND":    1,
+		"NOT_ALLOWED":       2,
+		"USER_NOT_FOUND":    3,
+		"ALREADY_PINNED":    4,
+		"PIN_LIMIT_REACHED": 5,
+	}
+)
 
-func (x *CommentPostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x PinPostResponse_PinPostStatus) Enum() *PinPostResponse_PinPostStatus {
+	p := new(PinPostResponse_PinPostStatus)
+	*p = x
+	return p
 }
 
-func (*CommentPostRequest) ProtoMessage() {}
-
-func (x *CommentPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x PinPostResponse_PinPostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// Deprecated: Use CommentPostRequest.ProtoReflect.Descriptor instead.
-func (*CommentPostRequest) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{27}
+func (PinPostResponse_PinPostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[46].Descriptor()
 }
 
-func (x *CommentPostRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (PinPostResponse_PinPostStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[46]
 }
 
-func (x *CommentPostRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+func (x PinPostResponse_PinPostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CommentPostRequest) GetContentText() string {
-	if x != nil {
-		return x.ContentText
-	}
-	return ""
+// Deprecated: Use PinPostResponse_PinPostStatus.Descriptor instead.
+func (PinPostResponse_PinPostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{96, 0}
 }
 
-type CommentPostResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type UnpinPostResponse_UnpinPostStatus int32
 
-	Status    CommentPostResponse_CommentPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.CommentPostResponse_CommentPostStatus" json:"status,omitempty"`
-	CommentId int64                                 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-}
+const (
+	UnpinPostResponse_OK             UnpinPostResponse_UnpinPostStatus = 0
+	UnpinPostResponse_POST_NOT_FOUND UnpinPostResponse_UnpinPostStatus = 1
+	UnpinPostResponse_USER_NOT_FOUND UnpinPostResponse_UnpinPostStatus = 2
+	UnpinPostResponse_NOT_PINNED     UnpinPostResponse_UnpinPostStatus = 3
+)
 
-func (x *CommentPostResponse) Reset() {
-	*x = CommentPostResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[28]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for UnpinPostResponse_UnpinPostStatus.
+var (
+	UnpinPostResponse_UnpinPostStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "USER_NOT_FOUND",
+		3: "NOT_PINNED",
 	}
-}
+	UnpinPostResponse_UnpinPostStatus_value = map[string]int32{
+		"OK":             0,
+		"POST_NOT_FOUND": 1,
+		"USER_NOT_FOUND": 2,
+		"NOT_PINNED":     3,
+	}
+)
 
-func (x *CommentPostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x UnpinPostResponse_UnpinPostStatus) Enum() *UnpinPostResponse_UnpinPostStatus {
+	p := new(UnpinPostResponse_UnpinPostStatus)
+	*p = x
+	return p
 }
 
-func (*CommentPostResponse) ProtoMessage() {}
+func (x UnpinPostResponse_UnpinPostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *CommentPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[28]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (UnpinPostResponse_UnpinPostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[47].Descriptor()
 }
 
-// Deprecated: Use CommentPostResponse.ProtoReflect.Descriptor instead.
-func (*CommentPostResponse) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{28}
+func (UnpinPostResponse_UnpinPostStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[47]
 }
 
-func (x *CommentPostResponse) GetStatus() CommentPostResponse_CommentPostStatus {
-	if x != nil {
-		return x.Status
-	}
-	return CommentPostResponse_OK
+func (x UnpinPostResponse_UnpinPostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CommentPostResponse) GetCommentId() int64 {
-	if x != nil {
-		return x.CommentId
-	}
-	return 0
+// Deprecated: Use UnpinPostResponse_UnpinPostStatus.Descriptor instead.
+func (UnpinPostResponse_UnpinPostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{98, 0}
 }
 
-type LikePostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type VotePollResponse_VotePollStatus int32
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-}
+const (
+	VotePollResponse_OK              VotePollResponse_VotePollStatus = 0
+	VotePollResponse_POLL_NOT_FOUND  VotePollResponse_VotePollStatus = 1
+	VotePollResponse_USER_NOT_FOUND  VotePollResponse_VotePollStatus = 2
+	VotePollResponse_POLL_CLOSED     VotePollResponse_VotePollStatus = 3
+	VotePollResponse_INVALID_OPTIONS VotePollResponse_VotePollStatus = 4
+	VotePollResponse_ALREADY_VOTED   VotePollResponse_VotePollStatus = 5
+)
 
-func (x *LikePostRequest) Reset() {
-	*x = LikePostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for VotePollResponse_VotePollStatus.
+var (
+	VotePollResponse_VotePollStatus_name = map[int32]string{
+		0: "OK",
+		1: "POLL_NOT_FOUND",
+		2: "USER_NOT_FOUND",
+		3: "POLL_CLOSED",
+		4: "INVALID_OPTIONS",
+		5: "ALREADY_VOTED",
+	}
+	VotePollResponse_VotePollStatus_value = map[string]int32{
+		"OK":              0,
+		"POLL_NOT_FOUND":  1,
+		"USER_NOT_FOUND":  2,
+		"POLL_CLOSED":     3,
+		"INVALID_OPTIONS": 4,
+		"ALREADY_VOTED":   5,
 	}
+)
+
+func (x VotePollResponse_VotePollStatus) Enum() *VotePollResponse_VotePollStatus {
+	p := new(VotePollResponse_VotePollStatus)
+	*p = x
+	return p
 }
 
-func (x *LikePostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x VotePollResponse_VotePollStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*LikePostRequest) ProtoMessage() {}
+func (VotePollResponse_VotePollStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[48].Descriptor()
+}
 
-func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (VotePollResponse_VotePollStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[48]
 }
 
-// Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
-func (*LikePostRequest) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{29}
+func (x VotePollResponse_VotePollStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *LikePostRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use VotePollResponse_VotePollStatus.Descriptor instead.
+func (VotePollResponse_VotePollStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{100, 0}
 }
 
-func (x *LikePostRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
+type GetPollResultsResponse_GetPollResultsStatus int32
+
+const (
+	GetPollResultsResponse_OK             GetPollResultsResponse_GetPollResultsStatus = 0
+	GetPollResultsResponse_POLL_NOT_FOUND GetPollResultsResponse_GetPollResultsStatus = 1
+)
+
+// Enum value maps for GetPollResultsResponse_GetPollResultsStatus.
+var (
+	GetPollResultsResponse_GetPollResultsStatus_name = map[int32]string{
+		0: "OK",
+		1: "POLL_NOT_FOUND",
 	}
-	return 0
+	GetPollResultsResponse_GetPollResultsStatus_value = map[string]int32{
+		"OK":             0,
+		"POLL_NOT_FOUND": 1,
+	}
+)
+
+func (x GetPollResultsResponse_GetPollResultsStatus) Enum() *GetPollResultsResponse_GetPollResultsStatus {
+	p := new(GetPollResultsResponse_GetPollResultsStatus)
+	*p = x
+	return p
 }
 
-type LikePostResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x GetPollResultsResponse_GetPollResultsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-	Status LikePostResponse_LikePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.LikePostResponse_LikePostStatus" json:"status,omitempty"`
+func (GetPollResultsResponse_GetPollResultsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[49].Descriptor()
 }
 
-func (x *LikePostResponse) Reset() {
-	*x = LikePostResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[30]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (GetPollResultsResponse_GetPollResultsStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[49]
 }
 
-func (x *LikePostResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GetPollResultsResponse_GetPollResultsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*LikePostResponse) ProtoMessage() {}
+// Deprecated: Use GetPollResultsResponse_GetPollResultsStatus.Descriptor instead.
+func (GetPollResultsResponse_GetPollResultsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{102, 0}
+}
 
-func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[30]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+type SavePostResponse_SavePostStatus int32
+
+const (
+	SavePostResponse_OK             SavePostResponse_SavePostStatus = 0
+	SavePostResponse_POST_NOT_FOUND SavePostResponse_SavePostStatus = 1
+	SavePostResponse_USER_NOT_FOUND SavePostResponse_SavePostStatus = 2
+	SavePostResponse_ALREADY_SAVED  SavePostResponse_SavePostStatus = 3
+)
+
+// Enum value maps for SavePostResponse_SavePostStatus.
+var (
+	SavePostResponse_SavePostStatus_name = map[int32]string{
+		0: "OK",
+		1: "POST_NOT_FOUND",
+		2: "USER_NOT_FOUND",
+		3: "ALREADY_SAVED",
 	}
-	return mi.MessageOf(x)
+	SavePostResponse_SavePostStatus_value = map[string]int32{
+		"OK":             0,
+		"POST_NOT_FOUND": 1,
+		"USER_NOT_FOUND": 2,
+		"ALREADY_SAVED":  3,
+	}
+)
+
+func (x SavePostResponse_SavePostStatus) Enum() *SavePostResponse_SavePostStatus {
+	p := new(SavePostResponse_SavePostStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use LikePostResponse.ProtoReflect.Descriptor instead.
-func (*LikePostResponse) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{30}
+func (x SavePostResponse_SavePostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *LikePostResponse) GetStatus() LikePostResponse_LikePostStatus {
-	if x != nil {
-		return x.Status
-	}
-	return LikePostResponse_OK
+func (SavePostResponse_SavePostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[50].Descriptor()
 }
 
-type GetS3PresignedUrlRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (SavePostResponse_SavePostStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[50]
 }
 
-func (x *GetS3PresignedUrlRequest) Reset() {
-	*x = GetS3PresignedUrlRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[31]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x SavePostResponse_SavePostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetS3PresignedUrlRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use SavePostResponse_SavePostStatus.Descriptor instead.
+func (SavePostResponse_SavePostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{104, 0}
 }
 
-func (*GetS3PresignedUrlRequest) ProtoMessage() {}
+type UnsavePostResponse_UnsavePostStatus int32
 
-func (x *GetS3PresignedUrlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[31]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	UnsavePostResponse_OK             UnsavePostResponse_UnsavePostStatus = 0
+	UnsavePostResponse_USER_NOT_FOUND UnsavePostResponse_UnsavePostStatus = 1
+	UnsavePostResponse_NOT_SAVED      UnsavePostResponse_UnsavePostStatus = 2
+)
+
+// Enum value maps for UnsavePostResponse_UnsavePostStatus.
+var (
+	UnsavePostResponse_UnsavePostStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "NOT_SAVED",
 	}
-	return mi.MessageOf(x)
+	UnsavePostResponse_UnsavePostStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"NOT_SAVED":      2,
+	}
+)
+
+func (x UnsavePostResponse_UnsavePostStatus) Enum() *UnsavePostResponse_UnsavePostStatus {
+	p := new(UnsavePostResponse_UnsavePostStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use GetS3PresignedUrlRequest.ProtoReflect.Descriptor instead.
-func (*GetS3PresignedUrlRequest) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{31}
+func (x UnsavePostResponse_UnsavePostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type GetS3PresignedUrlResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (UnsavePostResponse_UnsavePostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[51].Descriptor()
+}
 
-	Status         GetS3PresignedUrlResponse_GetS3PresignedUrlStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetS3PresignedUrlResponse_GetS3PresignedUrlStatus" json:"status,omitempty"`
-	Url            string                                            `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	ExpirationTime *timestamp.Timestamp                              `protobuf:"bytes,3,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+func (UnsavePostResponse_UnsavePostStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[51]
 }
 
-func (x *GetS3PresignedUrlResponse) Reset() {
-	*x = GetS3PresignedUrlResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[32]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x UnsavePostResponse_UnsavePostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetS3PresignedUrlResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use UnsavePostResponse_UnsavePostStatus.Descriptor instead.
+func (UnsavePostResponse_UnsavePostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{106, 0}
 }
 
-func (*GetS3PresignedUrlResponse) ProtoMessage() {}
+type ListSavedPostsResponse_ListSavedPostsStatus int32
 
-func (x *GetS3PresignedUrlResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[32]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	ListSavedPostsResponse_OK             ListSavedPostsResponse_ListSavedPostsStatus = 0
+	ListSavedPostsResponse_USER_NOT_FOUND ListSavedPostsResponse_ListSavedPostsStatus = 1
+)
+
+// Enum value maps for ListSavedPostsResponse_ListSavedPostsStatus.
+var (
+	ListSavedPostsResponse_ListSavedPostsStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
 	}
-	return mi.MessageOf(x)
+	ListSavedPostsResponse_ListSavedPostsStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+	}
+)
+
+func (x ListSavedPostsResponse_ListSavedPostsStatus) Enum() *ListSavedPostsResponse_ListSavedPostsStatus {
+	p := new(ListSavedPostsResponse_ListSavedPostsStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use GetS3PresignedUrlResponse.ProtoReflect.Descriptor instead.
-func (*GetS3PresignedUrlResponse) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{32}
+func (x ListSavedPostsResponse_ListSavedPostsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *GetS3PresignedUrlResponse) GetStatus() GetS3PresignedUrlResponse_GetS3PresignedUrlStatus {
-	if x != nil {
-		return x.Status
-	}
-	return GetS3PresignedUrlResponse_OK
+func (ListSavedPostsResponse_ListSavedPostsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[52].Descriptor()
 }
 
-func (x *GetS3PresignedUrlResponse) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
+func (ListSavedPostsResponse_ListSavedPostsStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[52]
 }
 
-func (x *GetS3PresignedUrlResponse) GetExpirationTime() *timestamp.Timestamp {
-	if x != nil {
-		return x.ExpirationTime
+func (x ListSavedPostsResponse_ListSavedPostsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListSavedPostsResponse_ListSavedPostsStatus.Descriptor instead.
+func (ListSavedPostsResponse_ListSavedPostsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{108, 0}
+}
+
+type CreateStoryResponse_CreateStoryStatus int32
+
+const (
+	CreateStoryResponse_OK             CreateStoryResponse_CreateStoryStatus = 0
+	CreateStoryResponse_USER_NOT_FOUND CreateStoryResponse_CreateStoryStatus = 1
+	CreateStoryResponse_INVALID_STORY  CreateStoryResponse_CreateStoryStatus = 2
+)
+
+// Enum value maps for CreateStoryResponse_CreateStoryStatus.
+var (
+	CreateStoryResponse_CreateStoryStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
+		2: "INVALID_STORY",
 	}
-	return nil
+	CreateStoryResponse_CreateStoryStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+		"INVALID_STORY":  2,
+	}
+)
+
+func (x CreateStoryResponse_CreateStoryStatus) Enum() *CreateStoryResponse_CreateStoryStatus {
+	p := new(CreateStoryResponse_CreateStoryStatus)
+	*p = x
+	return p
 }
 
-type PostDetailInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x CreateStoryResponse_CreateStoryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-	PostId           int64                `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	UserId           int64                `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ContentText      string               `protobuf:"bytes,3,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
-	ContentImagePath []string             `protobuf:"bytes,4,rep,name=content_image_path,json=contentImagePath,proto3" json:"content_image_path,omitempty"`
-	Visible          bool                 `protobuf:"varint,5,opt,name=visible,proto3" json:"visible,omitempty"`
-	CreatedAt        *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Comments         []*Comment           `protobuf:"bytes,7,rep,name=comments,proto3" json:"comments,omitempty"`
-	LikedUsers       []*Like              `protobuf:"bytes,8,rep,name=liked_users,json=likedUsers,proto3" json:"liked_users,omitempty"`
+func (CreateStoryResponse_CreateStoryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[53].Descriptor()
 }
 
-func (x *PostDetailInfo) Reset() {
-	*x = PostDetailInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[33]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (CreateStoryResponse_CreateStoryStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[53]
 }
 
-func (x *PostDetailInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x CreateStoryResponse_CreateStoryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*PostDetailInfo) ProtoMessage() {}
+// Deprecated: Use CreateStoryResponse_CreateStoryStatus.Descriptor instead.
+func (CreateStoryResponse_CreateStoryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{110, 0}
+}
 
-func (x *PostDetailInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[33]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+type GetStoryResponse_GetStoryStatus int32
+
+const (
+	GetStoryResponse_OK              GetStoryResponse_GetStoryStatus = 0
+	GetStoryResponse_STORY_NOT_FOUND GetStoryResponse_GetStoryStatus = 1
+	GetStoryResponse_USER_NOT_FOUND  GetStoryResponse_GetStoryStatus = 2
+)
+
+// Enum value maps for GetStoryResponse_GetStoryStatus.
+var (
+	GetStoryResponse_GetStoryStatus_name = map[int32]string{
+		0: "OK",
+		1: "STORY_NOT_FOUND",
+		2: "USER_NOT_FOUND",
 	}
-	return mi.MessageOf(x)
+	GetStoryResponse_GetStoryStatus_value = map[string]int32{
+		"OK":              0,
+		"STORY_NOT_FOUND": 1,
+		"USER_NOT_FOUND":  2,
+	}
+)
+
+func (x GetStoryResponse_GetStoryStatus) Enum() *GetStoryResponse_GetStoryStatus {
+	p := new(GetStoryResponse_GetStoryStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use PostDetailInfo.ProtoReflect.Descriptor instead.
-func (*PostDetailInfo) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{33}
+func (x GetStoryResponse_GetStoryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *PostDetailInfo) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+func (GetStoryResponse_GetStoryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[54].Descriptor()
 }
 
-func (x *PostDetailInfo) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+func (GetStoryResponse_GetStoryStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[54]
 }
 
-func (x *PostDetailInfo) GetContentText() string {
-	if x != nil {
-		return x.ContentText
-	}
-	return ""
+func (x GetStoryResponse_GetStoryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *PostDetailInfo) GetContentImagePath() []string {
-	if x != nil {
-		return x.ContentImagePath
-	}
-	return nil
+// Deprecated: Use GetStoryResponse_GetStoryStatus.Descriptor instead.
+func (GetStoryResponse_GetStoryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{112, 0}
 }
 
-func (x *PostDetailInfo) GetVisible() bool {
-	if x != nil {
-		return x.Visible
+type DeleteStoryResponse_DeleteStoryStatus int32
+
+const (
+	DeleteStoryResponse_OK              DeleteStoryResponse_DeleteStoryStatus = 0
+	DeleteStoryResponse_STORY_NOT_FOUND DeleteStoryResponse_DeleteStoryStatus = 1
+	DeleteStoryResponse_USER_NOT_FOUND  DeleteStoryResponse_DeleteStoryStatus = 2
+	DeleteStoryResponse_NOT_ALLOWED     DeleteStoryResponse_DeleteStoryStatus = 3
+)
+
+// Enum value maps for DeleteStoryResponse_DeleteStoryStatus.
+var (
+	DeleteStoryResponse_DeleteStoryStatus_name = map[int32]string{
+		0: "OK",
+		1: "STORY_NOT_FOUND",
+		2: "USER_NOT_FOUND",
+		3: "NOT_ALLOWED",
 	}
-	return false
+	DeleteStoryResponse_DeleteStoryStatus_value = map[string]int32{
+		"OK":              0,
+		"STORY_NOT_FOUND": 1,
+		"USER_NOT_FOUND":  2,
+		"NOT_ALLOWED":     3,
+	}
+)
+
+func (x DeleteStoryResponse_DeleteStoryStatus) Enum() *DeleteStoryResponse_DeleteStoryStatus {
+	p := new(DeleteStoryResponse_DeleteStoryStatus)
+	*p = x
+	return p
 }
 
-func (x *PostDetailInfo) GetCreatedAt() *timestamp.Timestamp {
-	if x != nil {
-		return x.CreatedAt
+func (x DeleteStoryResponse_DeleteStoryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeleteStoryResponse_DeleteStoryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[55].Descriptor()
+}
+
+func (DeleteStoryResponse_DeleteStoryStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[55]
+}
+
+func (x DeleteStoryResponse_DeleteStoryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeleteStoryResponse_DeleteStoryStatus.Descriptor instead.
+func (DeleteStoryResponse_DeleteStoryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{114, 0}
+}
+
+type GetStoriesTrayResponse_GetStoriesTrayStatus int32
+
+const (
+	GetStoriesTrayResponse_OK             GetStoriesTrayResponse_GetStoriesTrayStatus = 0
+	GetStoriesTrayResponse_USER_NOT_FOUND GetStoriesTrayResponse_GetStoriesTrayStatus = 1
+)
+
+// Enum value maps for GetStoriesTrayResponse_GetStoriesTrayStatus.
+var (
+	GetStoriesTrayResponse_GetStoriesTrayStatus_name = map[int32]string{
+		0: "OK",
+		1: "USER_NOT_FOUND",
 	}
-	return nil
+	GetStoriesTrayResponse_GetStoriesTrayStatus_value = map[string]int32{
+		"OK":             0,
+		"USER_NOT_FOUND": 1,
+	}
+)
+
+func (x GetStoriesTrayResponse_GetStoriesTrayStatus) Enum() *GetStoriesTrayResponse_GetStoriesTrayStatus {
+	p := new(GetStoriesTrayResponse_GetStoriesTrayStatus)
+	*p = x
+	return p
 }
 
-func (x *PostDetailInfo) GetComments() []*Comment {
-	if x != nil {
-		return x.Comments
+func (x GetStoriesTrayResponse_GetStoriesTrayStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetStoriesTrayResponse_GetStoriesTrayStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[56].Descriptor()
+}
+
+func (GetStoriesTrayResponse_GetStoriesTrayStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[56]
+}
+
+func (x GetStoriesTrayResponse_GetStoriesTrayStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetStoriesTrayResponse_GetStoriesTrayStatus.Descriptor instead.
+func (GetStoriesTrayResponse_GetStoriesTrayStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{116, 0}
+}
+
+type GetStoryViewersResponse_GetStoryViewersStatus int32
+
+const (
+	GetStoryViewersResponse_OK              GetStoryViewersResponse_GetStoryViewersStatus = 0
+	GetStoryViewersResponse_STORY_NOT_FOUND GetStoryViewersResponse_GetStoryViewersStatus = 1
+	GetStoryViewersResponse_USER_NOT_FOUND  GetStoryViewersResponse_GetStoryViewersStatus = 2
+	GetStoryViewersResponse_NOT_ALLOWED     GetStoryViewersResponse_GetStoryViewersStatus = 3
+)
+
+// Enum value maps for GetStoryViewersResponse_GetStoryViewersStatus.
+var (
+	GetStoryViewersResponse_GetStoryViewersStatus_name = map[int32]string{
+		0: "OK",
+		1: "STORY_NOT_FOUND",
+		2: "USER_NOT_FOUND",
+		3: "NOT_ALLOWED",
 	}
-	return nil
+	GetStoryViewersResponse_GetStoryViewersStatus_value = map[string]int32{
+		"OK":              0,
+		"STORY_NOT_FOUND": 1,
+		"USER_NOT_FOUND":  2,
+		"NOT_ALLOWED":     3,
+	}
+)
+
+func (x GetStoryViewersResponse_GetStoryViewersStatus) Enum() *GetStoryViewersResponse_GetStoryViewersStatus {
+	p := new(GetStoryViewersResponse_GetStoryViewersStatus)
+	*p = x
+	return p
 }
 
-func (x *PostDetailInfo) GetLikedUsers() []*Like {
-	if x != nil {
-		return x.LikedUsers
+func (x GetStoryViewersResponse_GetStoryViewersStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetStoryViewersResponse_GetStoryViewersStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[57].Descriptor()
+}
+
+func (GetStoryViewersResponse_GetStoryViewersStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[57]
+}
+
+func (x GetStoryViewersResponse_GetStoryViewersStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetStoryViewersResponse_GetStoryViewersStatus.Descriptor instead.
+func (GetStoryViewersResponse_GetStoryViewersStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{118, 0}
+}
+
+type GetS3PresignedUrlResponse_GetS3PresignedUrlStatus int32
+
+const (
+	GetS3PresignedUrlResponse_OK             GetS3PresignedUrlResponse_GetS3PresignedUrlStatus = 0
+	GetS3PresignedUrlResponse_FAIL           GetS3PresignedUrlResponse_GetS3PresignedUrlStatus = 1
+	GetS3PresignedUrlResponse_USER_NOT_FOUND GetS3PresignedUrlResponse_GetS3PresignedUrlStatus = 2
+)
+
+// Enum value maps for GetS3PresignedUrlResponse_GetS3PresignedUrlStatus.
+var (
+	GetS3PresignedUrlResponse_GetS3PresignedUrlStatus_name = map[int32]string{
+		0: "OK",
+		1: "FAIL",
+		2: "USER_NOT_FOUND",
 	}
-	return nil
+	GetS3PresignedUrlResponse_GetS3PresignedUrlStatus_value = map[string]int32{
+		"OK":             0,
+		"FAIL":           1,
+		"USER_NOT_FOUND": 2,
+	}
+)
+
+func (x GetS3PresignedUrlResponse_GetS3PresignedUrlStatus) Enum() *GetS3PresignedUrlResponse_GetS3PresignedUrlStatus {
+	p := new(GetS3PresignedUrlResponse_GetS3PresignedUrlStatus)
+	*p = x
+	return p
 }
 
-type Comment struct {
+func (x GetS3PresignedUrlResponse_GetS3PresignedUrlStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetS3PresignedUrlResponse_GetS3PresignedUrlStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_authen_and_post_proto_enumTypes[58].Descriptor()
+}
+
+func (GetS3PresignedUrlResponse_GetS3PresignedUrlStatus) Type() protoreflect.EnumType {
+	return &file_authen_and_post_proto_enumTypes[58]
+}
+
+func (x GetS3PresignedUrlResponse_GetS3PresignedUrlStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetS3PresignedUrlResponse_GetS3PresignedUrlStatus.Descriptor instead.
+func (GetS3PresignedUrlResponse_GetS3PresignedUrlStatus) EnumDescriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{120, 0}
+}
+
+type CheckUserAuthenticationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommentId   int64  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	PostId      int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	UserId      int64  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ContentText string `protobuf:"bytes,4,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	UserName     string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserPassword string `protobuf:"bytes,2,opt,name=user_password,json=userPassword,proto3" json:"user_password,omitempty"`
+	ClientIp     string `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	// TOTP or recovery code, required when two-factor authentication is enabled
+	OtpCode string `protobuf:"bytes,4,opt,name=otp_code,json=otpCode,proto3" json:"otp_code,omitempty"`
 }
 
-func (x *Comment) Reset() {
-	*x = Comment{}
+func (x *CheckUserAuthenticationRequest) Reset() {
+	*x = CheckUserAuthenticationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[34]
+		mi := &file_authen_and_post_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Comment) String() string {
+func (x *CheckUserAuthenticationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Comment) ProtoMessage() {}
+func (*CheckUserAuthenticationRequest) ProtoMessage() {}
 
-func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[34]
+func (x *CheckUserAuthenticationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2793,65 +3019,70 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
-func (*Comment) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use CheckUserAuthenticationRequest.ProtoReflect.Descriptor instead.
+func (*CheckUserAuthenticationRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Comment) GetCommentId() int64 {
+func (x *CheckUserAuthenticationRequest) GetUserName() string {
 	if x != nil {
-		return x.CommentId
+		return x.UserName
 	}
-	return 0
+	return ""
 }
 
-func (x *Comment) GetPostId() int64 {
+func (x *CheckUserAuthenticationRequest) GetUserPassword() string {
 	if x != nil {
-		return x.PostId
+		return x.UserPassword
 	}
-	return 0
+	return ""
 }
 
-func (x *Comment) GetUserId() int64 {
+func (x *CheckUserAuthenticationRequest) GetClientIp() string {
 	if x != nil {
-		return x.UserId
+		return x.ClientIp
 	}
-	return 0
+	return ""
 }
 
-func (x *Comment) GetContentText() string {
+func (x *CheckUserAuthenticationRequest) GetOtpCode() string {
 	if x != nil {
-		return x.ContentText
+		return x.OtpCode
 	}
 	return ""
 }
 
-type Like struct {
+type CheckUserAuthenticationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status      CheckUserAuthenticationResponse_CheckUserAuthenticationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.CheckUserAuthenticationResponse_CheckUserAuthenticationStatus" json:"status,omitempty"`
+	User        *UserDetailInfo                                               `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	LockedUntil *timestamp.Timestamp                                          `protobuf:"bytes,3,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`
+	// Set with TWO_FACTOR_REQUIRED, the password was right but the second factor is missing
+	UserId int64 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Set when the account is scheduled for deletion
+	DeletionScheduledAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=deletion_scheduled_at,json=deletionScheduledAt,proto3" json:"deletion_scheduled_at,omitempty"`
 }
 
-func (x *Like) Reset() {
-	*x = Like{}
+func (x *CheckUserAuthenticationResponse) Reset() {
+	*x = CheckUserAuthenticationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_authen_and_post_proto_msgTypes[35]
+		mi := &file_authen_and_post_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Like) String() string {
+func (x *CheckUserAuthenticationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Like) ProtoMessage() {}
+func (*CheckUserAuthenticationResponse) ProtoMessage() {}
 
-func (x *Like) ProtoReflect() protoreflect.Message {
-	mi := &file_authen_and_post_proto_msgTypes[35]
+func (x *CheckUserAuthenticationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2862,640 +3093,10817 @@ func (x *Like) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Like.ProtoReflect.Descriptor instead.
-func (*Like) Descriptor() ([]byte, []int) {
-	return file_authen_and_post_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use CheckUserAuthenticationResponse.ProtoReflect.Descriptor instead.
+func (*CheckUserAuthenticationResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Like) GetPostId() int64 {
+func (x *CheckUserAuthenticationResponse) GetStatus() CheckUserAuthenticationResponse_CheckUserAuthenticationStatus {
 	if x != nil {
-		return x.PostId
+		return x.Status
 	}
-	return 0
+	return CheckUserAuthenticationResponse_OK
 }
 
-func (x *Like) GetUserId() int64 {
+func (x *CheckUserAuthenticationResponse) GetUser() *UserDetailInfo {
 	if x != nil {
-		return x.UserId
+		return x.User
 	}
-	return 0
+	return nil
 }
 
-var File_authen_and_post_proto protoreflect.FileDescriptor
+func (x *CheckUserAuthenticationResponse) GetLockedUntil() *timestamp.Timestamp {
+	if x != nil {
+		return x.LockedUntil
+	}
+	return nil
+}
 
-var file_authen_and_post_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f,
-	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x62, 0x0a, 0x1e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x8f, 0x02,
-	0x0a, 0x1f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65,
-	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x66, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x4e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
-	0x6f, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x4f,
-	0x0a, 0x1d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65,
-	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f,
-	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x57,
-	0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x02, 0x22,
-	0x6b, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xc0, 0x01, 0x0a,
-	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64,
-	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x10, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
-	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41,
-	0x4d, 0x45, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
-	0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x45, 0x44, 0x10, 0x02, 0x22,
-	0x9e, 0x03, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0d,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x66, 0x69,
-	0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
-	0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x0d,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
-	0x03, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x88, 0x01,
-	0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x69, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0e, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x28, 0x0a, 0x0d, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x50,
-	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
-	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x42, 0x10,
-	0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
-	0x22, 0x8a, 0x01, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61,
-	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x2c, 0x0a, 0x0e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45,
-	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x33, 0x0a,
-	0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0xe3, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x42, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
-	0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x22, 0x35, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
-	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
-	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0xa6, 0x02, 0x0a, 0x0e, 0x55, 0x73, 0x65,
-	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a,
-	0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70,
-	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d,
-	0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72,
-	0x65, 0x22, 0x31, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0xca, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x56, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x3e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
-	0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x72, 0x73, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c,
-	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x49, 0x64, 0x73, 0x22, 0x33, 0x0a, 0x15,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a,
-	0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
-	0x01, 0x22, 0x32, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd0, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x58, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x40, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
-	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x0d,
-	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x49,
-	0x64, 0x73, 0x22, 0x34, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
-	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
-	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x4f, 0x0a, 0x11, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0xa8, 0x01, 0x0a, 0x12, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x34, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
-	0x73, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x44,
-	0x0a, 0x10, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53,
-	0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x14,
-	0x0a, 0x10, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57,
-	0x45, 0x44, 0x10, 0x02, 0x22, 0x51, 0x0a, 0x13, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
-	0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0xac, 0x01, 0x0a, 0x14, 0x55, 0x6e, 0x66, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x50, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x38, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
-	0x73, 0x74, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x42, 0x0a, 0x12, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00,
-	0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
-	0x4e, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x4c, 0x4c,
-	0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x22, 0x2e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb7, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x50, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x38, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
-	0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f,
-	0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x49, 0x64, 0x73, 0x22, 0x30,
-	0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
-	0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01,
-	0x22, 0x97, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65,
-	0x78, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x12, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x34, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
-	0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
-	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
-	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x33, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xe3, 0x01,
-	0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x42, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61,
-	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x35, 0x0a, 0x17,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66,
-	0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12,
-	0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
-	0x44, 0x10, 0x01, 0x22, 0xf1, 0x01, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x31, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
-	0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74,
-	0x68, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65,
-	0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
-	0x74, 0x65, 0x78, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45,
-	0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x51, 0x0a, 0x0e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00,
-	0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
-	0x4e, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f,
-	0x57, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f,
-	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x22, 0x45, 0x0a, 0x11, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64,
-	0x22, 0xb7, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x53, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
-	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
-	0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c,
-	0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
-	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x22, 0x69, 0x0a, 0x12, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x54, 0x65, 0x78, 0x74, 0x22, 0xc9, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x11,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53,
-	0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a,
-	0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
-	0x02, 0x22, 0x43, 0x0a, 0x0f, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a,
-	0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x6b, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69,
-	0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c,
-	0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x53, 0x0a, 0x0e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12,
-	0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
-	0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
-	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x4c, 0x52, 0x45, 0x41,
-	0x44, 0x59, 0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65,
-	0x74, 0x53, 0x33, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xfb, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x33,
-	0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x42, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
-	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x33, 0x50, 0x72, 0x65, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x33, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55,
-	0x72, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x6c, 0x12, 0x43, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x33,
-	0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x41,
-	0x49, 0x4c, 0x10, 0x01, 0x22, 0xd6, 0x02, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a, 0x12,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69,
-	0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73,
-	0x69, 0x62, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x34, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
-	0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x5f, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6b,
-	0x65, 0x52, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x7d, 0x0a,
-	0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x22, 0x38, 0x0a, 0x04,
-	0x4c, 0x69, 0x6b, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x32, 0xa9, 0x0c, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x65,
-	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x7e,
-	0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65,
-	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57,
-	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
-	0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64,
-	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61,
-	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x29, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
-	0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
-	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x69, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
-	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0a, 0x46,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
-	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
-	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x66,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f,
-	0x73, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64,
-	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x57, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x12, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
-	0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
-	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
-	0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x08, 0x45, 0x64, 0x69,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61,
-	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0a,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
-	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x51, 0x0a, 0x08, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x20, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
-	0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
-	0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x33, 0x50, 0x72, 0x65,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x33, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
-	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x33, 0x50, 0x72, 0x65, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x42, 0x4a, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6d, 0x61, 0x78, 0x75, 0x61, 0x6e, 0x71, 0x75, 0x61, 0x6e, 0x67, 0x2f, 0x73, 0x6f, 0x63,
-	0x69, 0x61, 0x6c, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x62, 0x2f, 0x61,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *CheckUserAuthenticationResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
 }
 
-var (
-	file_authen_and_post_proto_rawDescOnce sync.Once
-	file_authen_and_post_proto_rawDescData = file_authen_and_post_proto_rawDesc
-)
+func (x *CheckUserAuthenticationResponse) GetDeletionScheduledAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DeletionScheduledAt
+	}
+	return nil
+}
 
-func file_authen_and_post_proto_rawDescGZIP() []byte {
-	file_authen_and_post_proto_rawDescOnce.Do(func() {
-		file_authen_and_post_proto_rawDescData = protoimpl.X.CompressGZIP(file_authen_and_post_proto_rawDescData)
-	})
-	return file_authen_and_post_proto_rawDescData
+type CreateUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserName     string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserPassword string `protobuf:"bytes,3,opt,name=user_password,json=userPassword,proto3" json:"user_password,omitempty"`
+	Email        string `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
 }
 
-var file_authen_and_post_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
-var file_authen_and_post_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
-var file_authen_and_post_proto_goTypes = []interface{}{
-	(CheckUserAuthenticationResponse_CheckUserAuthenticationStatus)(0), // 0: authen_and_post.CheckUserAuthenticationResponse.CheckUserAuthenticationStatus
-	(CreateUserResponse_CreateUserStatus)(0),                           // 1: authen_and_post.CreateUserResponse.CreateUserStatus
-	(EditUserResponse_EditUserStatus)(0),                               // 2: authen_and_post.EditUserResponse.EditUserStatus
-	(GetUserDetailInfoResponse_GetUserDetailInfoStatus)(0),             // 3: authen_and_post.GetUserDetailInfoResponse.GetUserDetailInfoStatus
-	(GetUserFollowerResponse_GetUserFollowerStatus)(0),                 // 4: authen_and_post.GetUserFollowerResponse.GetUserFollowerStatus
-	(GetUserFollowingResponse_GetUserFollowingStatus)(0),               // 5: authen_and_post.GetUserFollowingResponse.GetUserFollowingStatus
-	(FollowUserResponse_FollowUserStatus)(0),                           // 6: authen_and_post.FollowUserResponse.FollowUserStatus
-	(UnfollowUserResponse_UnfollowUserStatus)(0),                       // 7: authen_and_post.UnfollowUserResponse.UnfollowUserStatus
-	(GetUserPostsResponse_GetUserPostsStatus)(0),                       // 8: authen_and_post.GetUserPostsResponse.GetUserPostsStatus
-	(CreatePostResponse_CreatePostStatus)(0),                           // 9: authen_and_post.CreatePostResponse.CreatePostStatus
-	(GetPostDetailInfoResponse_GetPostDetailInfoStatus)(0),             // 10: authen_and_post.GetPostDetailInfoResponse.GetPostDetailInfoStatus
-	(EditPostResponse_EditPostStatus)(0),                               // 11: authen_and_post.EditPostResponse.EditPostStatus
-	(DeletePostResponse_DeletePostStatus)(0),                           // 12: authen_and_post.DeletePostResponse.DeletePostStatus
-	(CommentPostResponse_CommentPostStatus)(0),                         // 13: authen_and_post.CommentPostResponse.CommentPostStatus
-	(LikePostResponse_LikePostStatus)(0),                               // 14: authen_and_post.LikePostResponse.LikePostStatus
-	(GetS3PresignedUrlResponse_GetS3PresignedUrlStatus)(0),             // 15: authen_and_post.GetS3PresignedUrlResponse.GetS3PresignedUrlStatus
-	(*CheckUserAuthenticationRequest)(nil),                             // 16: authen_and_post.CheckUserAuthenticationRequest
-	(*CheckUserAuthenticationResponse)(nil),                            // 17: authen_and_post.CheckUserAuthenticationResponse
-	(*CreateUserRequest)(nil),                                          // 18: authen_and_post.CreateUserRequest
-	(*CreateUserResponse)(nil),                                         // 19: authen_and_post.CreateUserResponse
-	(*EditUserRequest)(nil),                                            // 20: authen_and_post.EditUserRequest
-	(*EditUserResponse)(nil),                                           // 21: authen_and_post.EditUserResponse
-	(*GetUserDetailInfoRequest)(nil),                                   // 22: authen_and_post.GetUserDetailInfoRequest
-	(*GetUserDetailInfoResponse)(nil),                                  // 23: authen_and_post.GetUserDetailInfoResponse
-	(*UserDetailInfo)(nil),                                             // 24: authen_and_post.UserDetailInfo
-	(*GetUserFollowerRequest)(nil),                                     // 25: authen_and_post.GetUserFollowerRequest
-	(*GetUserFollowerResponse)(nil),                                    // 26: authen_and_post.GetUserFollowerResponse
-	(*GetUserFollowingRequest)(nil),                                    // 27: authen_and_post.GetUserFollowingRequest
-	(*GetUserFollowingResponse)(nil),                                   // 28: authen_and_post.GetUserFollowingResponse
-	(*FollowUserRequest)(nil),                                          // 29: authen_and_post.FollowUserRequest
-	(*FollowUserResponse)(nil),                                         // 30: authen_and_post.FollowUserResponse
-	(*UnfollowUserRequest)(nil),                                        // 31: authen_and_post.UnfollowUserRequest
-	(*UnfollowUserResponse)(nil),                                       // 32: authen_and_post.UnfollowUserResponse
-	(*GetUserPostsRequest)(nil),                                        // 33: authen_and_post.GetUserPostsRequest
-	(*GetUserPostsResponse)(nil),                                       // 34: authen_and_post.GetUserPostsResponse
-	(*CreatePostRequest)(nil),                                          // 35: authen_and_post.CreatePostRequest
-	(*CreatePostResponse)(nil),                                         // 36: authen_and_post.CreatePostResponse
-	(*GetPostDetailInfoRequest)(nil),                                   // 37: authen_and_post.GetPostDetailInfoRequest
-	(*GetPostDetailInfoResponse)(nil),                                  // 38: authen_and_post.GetPostDetailInfoResponse
-	(*EditPostRequest)(nil),                                            // 39: authen_and_post.EditPostRequest
-	(*EditPostResponse)(nil),                                           // 40: authen_and_post.EditPostResponse
-	(*DeletePostRequest)(nil),                                          // 41: authen_and_post.DeletePostRequest
-	(*DeletePostResponse)(nil),                                         // 42: authen_and_post.DeletePostResponse
-	(*CommentPostRequest)(nil),                                         // 43: authen_and_post.CommentPostRequest
-	(*CommentPostResponse)(nil),                                        // 44: authen_and_post.CommentPostResponse
-	(*LikePostRequest)(nil),                                            // 45: authen_and_post.LikePostRequest
-	(*LikePostResponse)(nil),                                           // 46: authen_and_post.LikePostResponse
-	(*GetS3PresignedUrlRequest)(nil),                                   // 47: authen_and_post.GetS3PresignedUrlRequest
-	(*GetS3PresignedUrlResponse)(nil),                                  // 48: authen_and_post.GetS3PresignedUrlResponse
-	(*PostDetailInfo)(nil),                                             // 49: authen_and_post.PostDetailInfo
-	(*Comment)(nil),                                                    // 50: authen_and_post.Comment
-	(*Like)(nil),                                                       // 51: authen_and_post.Like
-	(*timestamp.Timestamp)(nil),                                        // 52: google.protobuf.Timestamp
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_authen_and_post_proto_depIdxs = []int32{
-	0,  // 0: authen_and_post.CheckUserAuthenticationResponse.status:type_name -> authen_and_post.CheckUserAuthenticationResponse.CheckUserAuthenticationStatus
-	24, // 1: authen_and_post.CheckUserAuthenticationResponse.user:type_name -> authen_and_post.UserDetailInfo
-	1,  // 2: authen_and_post.CreateUserResponse.status:type_name -> authen_and_post.CreateUserResponse.CreateUserStatus
-	52, // 3: authen_and_post.EditUserRequest.date_of_birth:type_name -> google.protobuf.Timestamp
-	2,  // 4: authen_and_post.EditUserResponse.status:type_name -> authen_and_post.EditUserResponse.EditUserStatus
-	3,  // 5: authen_and_post.GetUserDetailInfoResponse.status:type_name -> authen_and_post.GetUserDetailInfoResponse.GetUserDetailInfoStatus
-	24, // 6: authen_and_post.GetUserDetailInfoResponse.user:type_name -> authen_and_post.UserDetailInfo
-	52, // 7: authen_and_post.UserDetailInfo.date_of_birth:type_name -> google.protobuf.Timestamp
-	4,  // 8: authen_and_post.GetUserFollowerResponse.status:type_name -> authen_and_post.GetUserFollowerResponse.GetUserFollowerStatus
-	5,  // 9: authen_and_post.GetUserFollowingResponse.status:type_name -> authen_and_post.GetUserFollowingResponse.GetUserFollowingStatus
-	6,  // 10: authen_and_post.FollowUserResponse.status:type_name -> authen_and_post.FollowUserResponse.FollowUserStatus
-	7,  // 11: authen_and_post.UnfollowUserResponse.status:type_name -> authen_and_post.UnfollowUserResponse.UnfollowUserStatus
-	8,  // 12: authen_and_post.GetUserPostsResponse.status:type_name -> authen_and_post.GetUserPostsResponse.GetUserPostsStatus
-	9,  // 13: authen_and_post.CreatePostResponse.status:type_name -> authen_and_post.CreatePostResponse.CreatePostStatus
-	10, // 14: authen_and_post.GetPostDetailInfoResponse.status:type_name -> authen_and_post.GetPostDetailInfoResponse.GetPostDetailInfoStatus
-	49, // 15: authen_and_post.GetPostDetailInfoResponse.post:type_name -> authen_and_post.PostDetailInfo
-	11, // 16: authen_and_post.EditPostResponse.status:type_name -> authen_and_post.EditPostResponse.EditPostStatus
-	12, // 17: authen_and_post.DeletePostResponse.status:type_name -> authen_and_post.DeletePostResponse.DeletePostStatus
-	13, // 18: authen_and_post.CommentPostResponse.status:type_name -> authen_and_post.CommentPostResponse.CommentPostStatus
-	14, // 19: authen_and_post.LikePostResponse.status:type_name -> authen_and_post.LikePostResponse.LikePostStatus
-	15, // 20: authen_and_post.GetS3PresignedUrlResponse.status:type_name -> authen_and_post.GetS3PresignedUrlResponse.GetS3PresignedUrlStatus
-	52, // 21: authen_and_post.GetS3PresignedUrlResponse.expiration_time:type_name -> google.protobuf.Timestamp
-	52, // 22: authen_and_post.PostDetailInfo.created_at:type_name -> google.protobuf.Timestamp
-	50, // 23: authen_and_post.PostDetailInfo.comments:type_name -> authen_and_post.Comment
-	51, // 24: authen_and_post.PostDetailInfo.liked_users:type_name -> authen_and_post.Like
-	16, // 25: authen_and_post.AuthenticateAndPost.CheckUserAuthentication:input_type -> authen_and_post.CheckUserAuthenticationRequest
-	18, // 26: authen_and_post.AuthenticateAndPost.CreateUser:input_type -> authen_and_post.CreateUserRequest
-	20, // 27: authen_and_post.AuthenticateAndPost.EditUser:input_type -> authen_and_post.EditUserRequest
-	22, // 28: authen_and_post.AuthenticateAndPost.GetUserDetailInfo:input_type -> authen_and_post.GetUserDetailInfoRequest
-	25, // 29: authen_and_post.AuthenticateAndPost.GetUserFollower:input_type -> authen_and_post.GetUserFollowerRequest
-	27, // 30: authen_and_post.AuthenticateAndPost.GetUserFollowing:input_type -> authen_and_post.GetUserFollowingRequest
-	29, // 31: authen_and_post.AuthenticateAndPost.FollowUser:input_type -> authen_and_post.FollowUserRequest
-	31, // 32: authen_and_post.AuthenticateAndPost.UnfollowUser:input_type -> authen_and_post.UnfollowUserRequest
-	33, // 33: authen_and_post.AuthenticateAndPost.GetUserPosts:input_type -> authen_and_post.GetUserPostsRequest
-	35, // 34: authen_and_post.AuthenticateAndPost.CreatePost:input_type -> authen_and_post.CreatePostRequest
-	37, // 35: authen_and_post.AuthenticateAndPost.GetPostDetailInfo:input_type -> authen_and_post.GetPostDetailInfoRequest
-	39, // 36: authen_and_post.AuthenticateAndPost.EditPost:input_type -> authen_and_post.EditPostRequest
-	41, // 37: authen_and_post.AuthenticateAndPost.DeletePost:input_type -> authen_and_post.DeletePostRequest
-	43, // 38: authen_and_post.AuthenticateAndPost.CommentPost:input_type -> authen_and_post.CommentPostRequest
-	45, // 39: authen_and_post.AuthenticateAndPost.LikePost:input_type -> authen_and_post.LikePostRequest
-	47, // 40: authen_and_post.AuthenticateAndPost.GetS3PresignedUrl:input_type -> authen_and_post.GetS3PresignedUrlRequest
-	17, // 41: authen_and_post.AuthenticateAndPost.CheckUserAuthentication:output_type -> authen_and_post.CheckUserAuthenticationResponse
-	19, // 42: authen_and_post.AuthenticateAndPost.CreateUser:output_type -> authen_and_post.CreateUserResponse
-	21, // 43: authen_and_post.AuthenticateAndPost.EditUser:output_type -> authen_and_post.EditUserResponse
-	23, // 44: authen_and_post.AuthenticateAndPost.GetUserDetailInfo:output_type -> authen_and_post.GetUserDetailInfoResponse
-	26, // 45: authen_and_post.AuthenticateAndPost.GetUserFollower:output_type -> authen_and_post.GetUserFollowerResponse
-	28, // 46: authen_and_post.AuthenticateAndPost.GetUserFollowing:output_type -> authen_and_post.GetUserFollowingResponse
-	30, // 47: authen_and_post.AuthenticateAndPost.FollowUser:output_type -> authen_and_post.FollowUserResponse
-	32, // 48: authen_and_post.AuthenticateAndPost.UnfollowUser:output_type -> authen_and_post.UnfollowUserResponse
-	34, // 49: authen_and_post.AuthenticateAndPost.GetUserPosts:output_type -> authen_and_post.GetUserPostsResponse
-	36, // 50: authen_and_post.AuthenticateAndPost.CreatePost:output_type -> authen_and_post.CreatePostResponse
-	38, // 51: authen_and_post.AuthenticateAndPost.GetPostDetailInfo:output_type -> authen_and_post.GetPostDetailInfoResponse
-	40, // 52: authen_and_post.AuthenticateAndPost.EditPost:output_type -> authen_and_post.EditPostResponse
-	42, // 53: authen_and_post.AuthenticateAndPost.DeletePost:output_type -> authen_and_post.DeletePostResponse
-	44, // 54: authen_and_post.AuthenticateAndPost.CommentPost:output_type -> authen_and_post.CommentPostResponse
-	46, // 55: authen_and_post.AuthenticateAndPost.LikePost:output_type -> authen_and_post.LikePostResponse
-	48, // 56: authen_and_post.AuthenticateAndPost.GetS3PresignedUrl:output_type -> authen_and_post.GetS3PresignedUrlResponse
-	41, // [41:57] is the sub-list for method output_type
-	25, // [25:41] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_authen_and_post_proto_init() }
-func file_authen_and_post_proto_init() {
-	if File_authen_and_post_proto != nil {
-		return
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_authen_and_post_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckUserAuthenticationRequest); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateUserRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetUserPassword() string {
+	if x != nil {
+		return x.UserPassword
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type CreateUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status CreateUserResponse_CreateUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.CreateUserResponse_CreateUserStatus" json:"status,omitempty"`
+	UserId int64                               `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *CreateUserResponse) Reset() {
+	*x = CreateUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponse) ProtoMessage() {}
+
+func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateUserResponse) GetStatus() CreateUserResponse_CreateUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CreateUserResponse_OK
+}
+
+func (x *CreateUserResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type EditUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserPassword   *string              `protobuf:"bytes,2,opt,name=user_password,json=userPassword,proto3,oneof" json:"user_password,omitempty"`
+	FirstName      *string              `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3,oneof" json:"first_name,omitempty"`
+	LastName       *string              `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3,oneof" json:"last_name,omitempty"`
+	DateOfBirth    *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date_of_birth,json=dateOfBirth,proto3,oneof" json:"date_of_birth,omitempty"`
+	ProfilePicture *string              `protobuf:"bytes,6,opt,name=profile_picture,json=profilePicture,proto3,oneof" json:"profile_picture,omitempty"`
+	CoverPicture   *string              `protobuf:"bytes,7,opt,name=cover_picture,json=coverPicture,proto3,oneof" json:"cover_picture,omitempty"`
+	// TOTP or recovery code, required to change password when two-factor authentication is enabled
+	OtpCode string `protobuf:"bytes,8,opt,name=otp_code,json=otpCode,proto3" json:"otp_code,omitempty"`
+	// Following a private account needs the approval of its owner, pending requests are approved when it becomes public
+	IsPrivate *bool `protobuf:"varint,9,opt,name=is_private,json=isPrivate,proto3,oneof" json:"is_private,omitempty"`
+	// User names can be changed once per cooldown, the previous one redirects to the user for a grace period
+	UserName *string `protobuf:"bytes,10,opt,name=user_name,json=userName,proto3,oneof" json:"user_name,omitempty"`
+	Bio      *string `protobuf:"bytes,11,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	Location *string `protobuf:"bytes,12,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	Website  *string `protobuf:"bytes,13,opt,name=website,proto3,oneof" json:"website,omitempty"`
+	Pronouns *string `protobuf:"bytes,14,opt,name=pronouns,proto3,oneof" json:"pronouns,omitempty"`
+	// Only the set fields are changed
+	ProfileVisibility *ProfileVisibility `protobuf:"bytes,15,opt,name=profile_visibility,json=profileVisibility,proto3" json:"profile_visibility,omitempty"`
+}
+
+func (x *EditUserRequest) Reset() {
+	*x = EditUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditUserRequest) ProtoMessage() {}
+
+func (x *EditUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditUserRequest.ProtoReflect.Descriptor instead.
+func (*EditUserRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EditUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EditUserRequest) GetUserPassword() string {
+	if x != nil && x.UserPassword != nil {
+		return *x.UserPassword
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetDateOfBirth() *timestamp.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *EditUserRequest) GetProfilePicture() string {
+	if x != nil && x.ProfilePicture != nil {
+		return *x.ProfilePicture
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetCoverPicture() string {
+	if x != nil && x.CoverPicture != nil {
+		return *x.CoverPicture
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetOtpCode() string {
+	if x != nil {
+		return x.OtpCode
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetIsPrivate() bool {
+	if x != nil && x.IsPrivate != nil {
+		return *x.IsPrivate
+	}
+	return false
+}
+
+func (x *EditUserRequest) GetUserName() string {
+	if x != nil && x.UserName != nil {
+		return *x.UserName
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetBio() string {
+	if x != nil && x.Bio != nil {
+		return *x.Bio
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetWebsite() string {
+	if x != nil && x.Website != nil {
+		return *x.Website
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetPronouns() string {
+	if x != nil && x.Pronouns != nil {
+		return *x.Pronouns
+	}
+	return ""
+}
+
+func (x *EditUserRequest) GetProfileVisibility() *ProfileVisibility {
+	if x != nil {
+		return x.ProfileVisibility
+	}
+	return nil
+}
+
+type EditUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status EditUserResponse_EditUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.EditUserResponse_EditUserStatus" json:"status,omitempty"`
+	// Set when the status is USERNAME_CHANGE_TOO_SOON
+	NextUserNameChangeAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=next_user_name_change_at,json=nextUserNameChangeAt,proto3" json:"next_user_name_change_at,omitempty"`
+}
+
+func (x *EditUserResponse) Reset() {
+	*x = EditUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditUserResponse) ProtoMessage() {}
+
+func (x *EditUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditUserResponse.ProtoReflect.Descriptor instead.
+func (*EditUserResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EditUserResponse) GetStatus() EditUserResponse_EditUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EditUserResponse_OK
+}
+
+func (x *EditUserResponse) GetNextUserNameChangeAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.NextUserNameChangeAt
+	}
+	return nil
+}
+
+type GetUserDetailInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Looked up when user_id is not set, previous user names are resolved during their grace period
+	UserName string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// Profile fields are filtered by their visibility for the viewer, 0 for anonymous viewers
+	ViewerId int64 `protobuf:"varint,3,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+}
+
+func (x *GetUserDetailInfoRequest) Reset() {
+	*x = GetUserDetailInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserDetailInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDetailInfoRequest) ProtoMessage() {}
+
+func (x *GetUserDetailInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDetailInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetUserDetailInfoRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetUserDetailInfoRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserDetailInfoRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *GetUserDetailInfoRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type GetUserDetailInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetUserDetailInfoResponse_GetUserDetailInfoStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetUserDetailInfoResponse_GetUserDetailInfoStatus" json:"status,omitempty"`
+	User   *UserDetailInfo                                   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *GetUserDetailInfoResponse) Reset() {
+	*x = GetUserDetailInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserDetailInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDetailInfoResponse) ProtoMessage() {}
+
+func (x *GetUserDetailInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDetailInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetUserDetailInfoResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetUserDetailInfoResponse) GetStatus() GetUserDetailInfoResponse_GetUserDetailInfoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetUserDetailInfoResponse_OK
+}
+
+func (x *GetUserDetailInfoResponse) GetUser() *UserDetailInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type UserDetailInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId          int64                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName        string               `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	FirstName       string               `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName        string               `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	DateOfBirth     *timestamp.Timestamp `protobuf:"bytes,5,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	Email           string               `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	ProfilePicture  string               `protobuf:"bytes,7,opt,name=profile_picture,json=profilePicture,proto3" json:"profile_picture,omitempty"`
+	CoverPicture    string               `protobuf:"bytes,8,opt,name=cover_picture,json=coverPicture,proto3" json:"cover_picture,omitempty"`
+	EmailVerified   bool                 `protobuf:"varint,9,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	IsPrivate       bool                 `protobuf:"varint,10,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	FollowersCount  int64                `protobuf:"varint,11,opt,name=followers_count,json=followersCount,proto3" json:"followers_count,omitempty"`
+	FollowingsCount int64                `protobuf:"varint,12,opt,name=followings_count,json=followingsCount,proto3" json:"followings_count,omitempty"`
+	PostsCount      int64                `protobuf:"varint,13,opt,name=posts_count,json=postsCount,proto3" json:"posts_count,omitempty"`
+	Bio             string               `protobuf:"bytes,14,opt,name=bio,proto3" json:"bio,omitempty"`
+	Location        string               `protobuf:"bytes,15,opt,name=location,proto3" json:"location,omitempty"`
+	Website         string               `protobuf:"bytes,16,opt,name=website,proto3" json:"website,omitempty"`
+	Pronouns        string               `protobuf:"bytes,17,opt,name=pronouns,proto3" json:"pronouns,omitempty"`
+	// Only returned to the user itself
+	ProfileVisibility *ProfileVisibility `protobuf:"bytes,18,opt,name=profile_visibility,json=profileVisibility,proto3" json:"profile_visibility,omitempty"`
+}
+
+func (x *UserDetailInfo) Reset() {
+	*x = UserDetailInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserDetailInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDetailInfo) ProtoMessage() {}
+
+func (x *UserDetailInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDetailInfo.ProtoReflect.Descriptor instead.
+func (*UserDetailInfo) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UserDetailInfo) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserDetailInfo) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetDateOfBirth() *timestamp.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *UserDetailInfo) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetProfilePicture() string {
+	if x != nil {
+		return x.ProfilePicture
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetCoverPicture() string {
+	if x != nil {
+		return x.CoverPicture
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *UserDetailInfo) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+func (x *UserDetailInfo) GetFollowersCount() int64 {
+	if x != nil {
+		return x.FollowersCount
+	}
+	return 0
+}
+
+func (x *UserDetailInfo) GetFollowingsCount() int64 {
+	if x != nil {
+		return x.FollowingsCount
+	}
+	return 0
+}
+
+func (x *UserDetailInfo) GetPostsCount() int64 {
+	if x != nil {
+		return x.PostsCount
+	}
+	return 0
+}
+
+func (x *UserDetailInfo) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetPronouns() string {
+	if x != nil {
+		return x.Pronouns
+	}
+	return ""
+}
+
+func (x *UserDetailInfo) GetProfileVisibility() *ProfileVisibility {
+	if x != nil {
+		return x.ProfileVisibility
+	}
+	return nil
+}
+
+// ProfileVisibility tells who can see each profile field
+type ProfileVisibility struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email       *ProfileVisibility_Visibility `protobuf:"varint,1,opt,name=email,proto3,enum=authen_and_post.ProfileVisibility_Visibility,oneof" json:"email,omitempty"`
+	DateOfBirth *ProfileVisibility_Visibility `protobuf:"varint,2,opt,name=date_of_birth,json=dateOfBirth,proto3,enum=authen_and_post.ProfileVisibility_Visibility,oneof" json:"date_of_birth,omitempty"`
+	Bio         *ProfileVisibility_Visibility `protobuf:"varint,3,opt,name=bio,proto3,enum=authen_and_post.ProfileVisibility_Visibility,oneof" json:"bio,omitempty"`
+	Location    *ProfileVisibility_Visibility `protobuf:"varint,4,opt,name=location,proto3,enum=authen_and_post.ProfileVisibility_Visibility,oneof" json:"location,omitempty"`
+	Website     *ProfileVisibility_Visibility `protobuf:"varint,5,opt,name=website,proto3,enum=authen_and_post.ProfileVisibility_Visibility,oneof" json:"website,omitempty"`
+	Pronouns    *ProfileVisibility_Visibility `protobuf:"varint,6,opt,name=pronouns,proto3,enum=authen_and_post.ProfileVisibility_Visibility,oneof" json:"pronouns,omitempty"`
+}
+
+func (x *ProfileVisibility) Reset() {
+	*x = ProfileVisibility{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProfileVisibility) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileVisibility) ProtoMessage() {}
+
+func (x *ProfileVisibility) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileVisibility.ProtoReflect.Descriptor instead.
+func (*ProfileVisibility) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProfileVisibility) GetEmail() ProfileVisibility_Visibility {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ProfileVisibility_PUBLIC
+}
+
+func (x *ProfileVisibility) GetDateOfBirth() ProfileVisibility_Visibility {
+	if x != nil && x.DateOfBirth != nil {
+		return *x.DateOfBirth
+	}
+	return ProfileVisibility_PUBLIC
+}
+
+func (x *ProfileVisibility) GetBio() ProfileVisibility_Visibility {
+	if x != nil && x.Bio != nil {
+		return *x.Bio
+	}
+	return ProfileVisibility_PUBLIC
+}
+
+func (x *ProfileVisibility) GetLocation() ProfileVisibility_Visibility {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ProfileVisibility_PUBLIC
+}
+
+func (x *ProfileVisibility) GetWebsite() ProfileVisibility_Visibility {
+	if x != nil && x.Website != nil {
+		return *x.Website
+	}
+	return ProfileVisibility_PUBLIC
+}
+
+func (x *ProfileVisibility) GetPronouns() ProfileVisibility_Visibility {
+	if x != nil && x.Pronouns != nil {
+		return *x.Pronouns
+	}
+	return ProfileVisibility_PUBLIC
+}
+
+type SendVerificationEmailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *SendVerificationEmailRequest) Reset() {
+	*x = SendVerificationEmailRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendVerificationEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendVerificationEmailRequest) ProtoMessage() {}
+
+func (x *SendVerificationEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendVerificationEmailRequest.ProtoReflect.Descriptor instead.
+func (*SendVerificationEmailRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SendVerificationEmailRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type SendVerificationEmailResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status SendVerificationEmailResponse_SendVerificationEmailStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.SendVerificationEmailResponse_SendVerificationEmailStatus" json:"status,omitempty"`
+}
+
+func (x *SendVerificationEmailResponse) Reset() {
+	*x = SendVerificationEmailResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendVerificationEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendVerificationEmailResponse) ProtoMessage() {}
+
+func (x *SendVerificationEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendVerificationEmailResponse.ProtoReflect.Descriptor instead.
+func (*SendVerificationEmailResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SendVerificationEmailResponse) GetStatus() SendVerificationEmailResponse_SendVerificationEmailStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendVerificationEmailResponse_OK
+}
+
+type VerifyEmailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyEmailResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status VerifyEmailResponse_VerifyEmailStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.VerifyEmailResponse_VerifyEmailStatus" json:"status,omitempty"`
+}
+
+func (x *VerifyEmailResponse) Reset() {
+	*x = VerifyEmailResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailResponse) ProtoMessage() {}
+
+func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VerifyEmailResponse) GetStatus() VerifyEmailResponse_VerifyEmailStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VerifyEmailResponse_OK
+}
+
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RequestPasswordResetResponse_RequestPasswordResetStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.RequestPasswordResetResponse_RequestPasswordResetStatus" json:"status,omitempty"`
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RequestPasswordResetResponse) GetStatus() RequestPasswordResetResponse_RequestPasswordResetStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RequestPasswordResetResponse_OK
+}
+
+type ConfirmPasswordResetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token       string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+}
+
+func (x *ConfirmPasswordResetRequest) Reset() {
+	*x = ConfirmPasswordResetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetRequest) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ConfirmPasswordResetRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ConfirmPasswordResetRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ConfirmPasswordResetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status ConfirmPasswordResetResponse_ConfirmPasswordResetStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.ConfirmPasswordResetResponse_ConfirmPasswordResetStatus" json:"status,omitempty"`
+	UserId int64                                                   `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ConfirmPasswordResetResponse) Reset() {
+	*x = ConfirmPasswordResetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetResponse) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ConfirmPasswordResetResponse) GetStatus() ConfirmPasswordResetResponse_ConfirmPasswordResetStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ConfirmPasswordResetResponse_OK
+}
+
+func (x *ConfirmPasswordResetResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type EnrollTwoFactorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *EnrollTwoFactorRequest) Reset() {
+	*x = EnrollTwoFactorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTwoFactorRequest) ProtoMessage() {}
+
+func (x *EnrollTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*EnrollTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EnrollTwoFactorRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type EnrollTwoFactorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status          EnrollTwoFactorResponse_EnrollTwoFactorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.EnrollTwoFactorResponse_EnrollTwoFactorStatus" json:"status,omitempty"`
+	Secret          string                                        `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	ProvisioningUri string                                        `protobuf:"bytes,3,opt,name=provisioning_uri,json=provisioningUri,proto3" json:"provisioning_uri,omitempty"`
+}
+
+func (x *EnrollTwoFactorResponse) Reset() {
+	*x = EnrollTwoFactorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnrollTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTwoFactorResponse) ProtoMessage() {}
+
+func (x *EnrollTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*EnrollTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EnrollTwoFactorResponse) GetStatus() EnrollTwoFactorResponse_EnrollTwoFactorStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EnrollTwoFactorResponse_OK
+}
+
+func (x *EnrollTwoFactorResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTwoFactorResponse) GetProvisioningUri() string {
+	if x != nil {
+		return x.ProvisioningUri
+	}
+	return ""
+}
+
+type EnableTwoFactorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OtpCode string `protobuf:"bytes,2,opt,name=otp_code,json=otpCode,proto3" json:"otp_code,omitempty"`
+}
+
+func (x *EnableTwoFactorRequest) Reset() {
+	*x = EnableTwoFactorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableTwoFactorRequest) ProtoMessage() {}
+
+func (x *EnableTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*EnableTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EnableTwoFactorRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EnableTwoFactorRequest) GetOtpCode() string {
+	if x != nil {
+		return x.OtpCode
+	}
+	return ""
+}
+
+type EnableTwoFactorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        EnableTwoFactorResponse_EnableTwoFactorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.EnableTwoFactorResponse_EnableTwoFactorStatus" json:"status,omitempty"`
+	RecoveryCodes []string                                      `protobuf:"bytes,2,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+}
+
+func (x *EnableTwoFactorResponse) Reset() {
+	*x = EnableTwoFactorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableTwoFactorResponse) ProtoMessage() {}
+
+func (x *EnableTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*EnableTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *EnableTwoFactorResponse) GetStatus() EnableTwoFactorResponse_EnableTwoFactorStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EnableTwoFactorResponse_OK
+}
+
+func (x *EnableTwoFactorResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type DisableTwoFactorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OtpCode string `protobuf:"bytes,2,opt,name=otp_code,json=otpCode,proto3" json:"otp_code,omitempty"`
+}
+
+func (x *DisableTwoFactorRequest) Reset() {
+	*x = DisableTwoFactorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTwoFactorRequest) ProtoMessage() {}
+
+func (x *DisableTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*DisableTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DisableTwoFactorRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DisableTwoFactorRequest) GetOtpCode() string {
+	if x != nil {
+		return x.OtpCode
+	}
+	return ""
+}
+
+type DisableTwoFactorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status DisableTwoFactorResponse_DisableTwoFactorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.DisableTwoFactorResponse_DisableTwoFactorStatus" json:"status,omitempty"`
+}
+
+func (x *DisableTwoFactorResponse) Reset() {
+	*x = DisableTwoFactorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTwoFactorResponse) ProtoMessage() {}
+
+func (x *DisableTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*DisableTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DisableTwoFactorResponse) GetStatus() DisableTwoFactorResponse_DisableTwoFactorStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DisableTwoFactorResponse_OK
+}
+
+type VerifyTwoFactorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OtpCode  string `protobuf:"bytes,2,opt,name=otp_code,json=otpCode,proto3" json:"otp_code,omitempty"`
+	ClientIp string `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+}
+
+func (x *VerifyTwoFactorRequest) Reset() {
+	*x = VerifyTwoFactorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTwoFactorRequest) ProtoMessage() {}
+
+func (x *VerifyTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*VerifyTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *VerifyTwoFactorRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VerifyTwoFactorRequest) GetOtpCode() string {
+	if x != nil {
+		return x.OtpCode
+	}
+	return ""
+}
+
+func (x *VerifyTwoFactorRequest) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+type VerifyTwoFactorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      VerifyTwoFactorResponse_VerifyTwoFactorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.VerifyTwoFactorResponse_VerifyTwoFactorStatus" json:"status,omitempty"`
+	User        *UserDetailInfo                               `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	LockedUntil *timestamp.Timestamp                          `protobuf:"bytes,3,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`
+	// Set when the account is scheduled for deletion
+	DeletionScheduledAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=deletion_scheduled_at,json=deletionScheduledAt,proto3" json:"deletion_scheduled_at,omitempty"`
+}
+
+func (x *VerifyTwoFactorResponse) Reset() {
+	*x = VerifyTwoFactorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTwoFactorResponse) ProtoMessage() {}
+
+func (x *VerifyTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*VerifyTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *VerifyTwoFactorResponse) GetStatus() VerifyTwoFactorResponse_VerifyTwoFactorStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VerifyTwoFactorResponse_OK
+}
+
+func (x *VerifyTwoFactorResponse) GetUser() *UserDetailInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *VerifyTwoFactorResponse) GetLockedUntil() *timestamp.Timestamp {
+	if x != nil {
+		return x.LockedUntil
+	}
+	return nil
+}
+
+func (x *VerifyTwoFactorResponse) GetDeletionScheduledAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DeletionScheduledAt
+	}
+	return nil
+}
+
+type RegenerateRecoveryCodesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OtpCode string `protobuf:"bytes,2,opt,name=otp_code,json=otpCode,proto3" json:"otp_code,omitempty"`
+}
+
+func (x *RegenerateRecoveryCodesRequest) Reset() {
+	*x = RegenerateRecoveryCodesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegenerateRecoveryCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesRequest) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RegenerateRecoveryCodesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RegenerateRecoveryCodesRequest) GetOtpCode() string {
+	if x != nil {
+		return x.OtpCode
+	}
+	return ""
+}
+
+type RegenerateRecoveryCodesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus" json:"status,omitempty"`
+	RecoveryCodes []string                                                      `protobuf:"bytes,2,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+}
+
+func (x *RegenerateRecoveryCodesResponse) Reset() {
+	*x = RegenerateRecoveryCodesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegenerateRecoveryCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesResponse) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RegenerateRecoveryCodesResponse) GetStatus() RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RegenerateRecoveryCodesResponse_OK
+}
+
+func (x *RegenerateRecoveryCodesResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserPassword string `protobuf:"bytes,2,opt,name=user_password,json=userPassword,proto3" json:"user_password,omitempty"`
+	OtpCode      string `protobuf:"bytes,3,opt,name=otp_code,json=otpCode,proto3" json:"otp_code,omitempty"`
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteUserRequest) GetUserPassword() string {
+	if x != nil {
+		return x.UserPassword
+	}
+	return ""
+}
+
+func (x *DeleteUserRequest) GetOtpCode() string {
+	if x != nil {
+		return x.OtpCode
+	}
+	return ""
+}
+
+type DeleteUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status DeleteUserResponse_DeleteUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.DeleteUserResponse_DeleteUserStatus" json:"status,omitempty"`
+	// The account and all of its data are purged after this time unless the deletion is cancelled
+	DeletionScheduledAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=deletion_scheduled_at,json=deletionScheduledAt,proto3" json:"deletion_scheduled_at,omitempty"`
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteUserResponse) GetStatus() DeleteUserResponse_DeleteUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeleteUserResponse_OK
+}
+
+func (x *DeleteUserResponse) GetDeletionScheduledAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DeletionScheduledAt
+	}
+	return nil
+}
+
+type CancelUserDeletionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *CancelUserDeletionRequest) Reset() {
+	*x = CancelUserDeletionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelUserDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelUserDeletionRequest) ProtoMessage() {}
+
+func (x *CancelUserDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelUserDeletionRequest.ProtoReflect.Descriptor instead.
+func (*CancelUserDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CancelUserDeletionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CancelUserDeletionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status CancelUserDeletionResponse_CancelUserDeletionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.CancelUserDeletionResponse_CancelUserDeletionStatus" json:"status,omitempty"`
+}
+
+func (x *CancelUserDeletionResponse) Reset() {
+	*x = CancelUserDeletionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelUserDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelUserDeletionResponse) ProtoMessage() {}
+
+func (x *CancelUserDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelUserDeletionResponse.ProtoReflect.Descriptor instead.
+func (*CancelUserDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CancelUserDeletionResponse) GetStatus() CancelUserDeletionResponse_CancelUserDeletionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CancelUserDeletionResponse_OK
+}
+
+type DataExportInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State       DataExportInfo_DataExportState `protobuf:"varint,1,opt,name=state,proto3,enum=authen_and_post.DataExportInfo_DataExportState" json:"state,omitempty"`
+	CreatedAt   *timestamp.Timestamp           `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt *timestamp.Timestamp           `protobuf:"bytes,3,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// Set when the archive is ready, the link expires at download_url_expires_at
+	DownloadUrl          string               `protobuf:"bytes,4,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	DownloadUrlExpiresAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=download_url_expires_at,json=downloadUrlExpiresAt,proto3" json:"download_url_expires_at,omitempty"`
+	// The archive is deleted after this time
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *DataExportInfo) Reset() {
+	*x = DataExportInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataExportInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataExportInfo) ProtoMessage() {}
+
+func (x *DataExportInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataExportInfo.ProtoReflect.Descriptor instead.
+func (*DataExportInfo) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DataExportInfo) GetState() DataExportInfo_DataExportState {
+	if x != nil {
+		return x.State
+	}
+	return DataExportInfo_PENDING
+}
+
+func (x *DataExportInfo) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DataExportInfo) GetCompletedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *DataExportInfo) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *DataExportInfo) GetDownloadUrlExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DownloadUrlExpiresAt
+	}
+	return nil
+}
+
+func (x *DataExportInfo) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type RequestDataExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *RequestDataExportRequest) Reset() {
+	*x = RequestDataExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDataExportRequest) ProtoMessage() {}
+
+func (x *RequestDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDataExportRequest.ProtoReflect.Descriptor instead.
+func (*RequestDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RequestDataExportRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RequestDataExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RequestDataExportResponse_RequestDataExportStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.RequestDataExportResponse_RequestDataExportStatus" json:"status,omitempty"`
+	Export *DataExportInfo                                   `protobuf:"bytes,2,opt,name=export,proto3" json:"export,omitempty"`
+}
+
+func (x *RequestDataExportResponse) Reset() {
+	*x = RequestDataExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDataExportResponse) ProtoMessage() {}
+
+func (x *RequestDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDataExportResponse.ProtoReflect.Descriptor instead.
+func (*RequestDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RequestDataExportResponse) GetStatus() RequestDataExportResponse_RequestDataExportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RequestDataExportResponse_OK
+}
+
+func (x *RequestDataExportResponse) GetExport() *DataExportInfo {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type GetDataExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetDataExportRequest) Reset() {
+	*x = GetDataExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportRequest) ProtoMessage() {}
+
+func (x *GetDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportRequest.ProtoReflect.Descriptor instead.
+func (*GetDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetDataExportRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetDataExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetDataExportResponse_GetDataExportStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetDataExportResponse_GetDataExportStatus" json:"status,omitempty"`
+	Export *DataExportInfo                           `protobuf:"bytes,2,opt,name=export,proto3" json:"export,omitempty"`
+}
+
+func (x *GetDataExportResponse) Reset() {
+	*x = GetDataExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportResponse) ProtoMessage() {}
+
+func (x *GetDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportResponse.ProtoReflect.Descriptor instead.
+func (*GetDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetDataExportResponse) GetStatus() GetDataExportResponse_GetDataExportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetDataExportResponse_OK
+}
+
+func (x *GetDataExportResponse) GetExport() *DataExportInfo {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type SearchUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Prefix of an user name, first name, last name or full name
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Users blocked by or blocking the viewer are skipped, 0 for anonymous viewers
+	ViewerId int64 `protobuf:"varint,3,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SearchUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type UserSearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName       string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	FirstName      string `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName       string `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	ProfilePicture string `protobuf:"bytes,5,opt,name=profile_picture,json=profilePicture,proto3" json:"profile_picture,omitempty"`
+}
+
+func (x *UserSearchResult) Reset() {
+	*x = UserSearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSearchResult) ProtoMessage() {}
+
+func (x *UserSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSearchResult.ProtoReflect.Descriptor instead.
+func (*UserSearchResult) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UserSearchResult) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserSearchResult) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *UserSearchResult) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UserSearchResult) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *UserSearchResult) GetProfilePicture() string {
+	if x != nil {
+		return x.ProfilePicture
+	}
+	return ""
+}
+
+type SearchUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status SearchUsersResponse_SearchUsersStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.SearchUsersResponse_SearchUsersStatus" json:"status,omitempty"`
+	Users  []*UserSearchResult                   `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SearchUsersResponse) GetStatus() SearchUsersResponse_SearchUsersStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SearchUsersResponse_OK
+}
+
+func (x *SearchUsersResponse) GetUsers() []*UserSearchResult {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type GetUserFollowerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cursor int64 `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetUserFollowerRequest) Reset() {
+	*x = GetUserFollowerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserFollowerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserFollowerRequest) ProtoMessage() {}
+
+func (x *GetUserFollowerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserFollowerRequest.ProtoReflect.Descriptor instead.
+func (*GetUserFollowerRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetUserFollowerRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserFollowerRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *GetUserFollowerRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetUserFollowerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status       GetUserFollowerResponse_GetUserFollowerStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetUserFollowerResponse_GetUserFollowerStatus" json:"status,omitempty"`
+	FollowersIds []int64                                       `protobuf:"varint,2,rep,packed,name=followersIds,proto3" json:"followersIds,omitempty"`
+	NextCursor   int64                                         `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	TotalCount   int64                                         `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+}
+
+func (x *GetUserFollowerResponse) Reset() {
+	*x = GetUserFollowerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserFollowerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserFollowerResponse) ProtoMessage() {}
+
+func (x *GetUserFollowerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserFollowerResponse.ProtoReflect.Descriptor instead.
+func (*GetUserFollowerResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetUserFollowerResponse) GetStatus() GetUserFollowerResponse_GetUserFollowerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetUserFollowerResponse_OK
+}
+
+func (x *GetUserFollowerResponse) GetFollowersIds() []int64 {
+	if x != nil {
+		return x.FollowersIds
+	}
+	return nil
+}
+
+func (x *GetUserFollowerResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *GetUserFollowerResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetUserFollowingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cursor int64 `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetUserFollowingRequest) Reset() {
+	*x = GetUserFollowingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserFollowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserFollowingRequest) ProtoMessage() {}
+
+func (x *GetUserFollowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserFollowingRequest.ProtoReflect.Descriptor instead.
+func (*GetUserFollowingRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetUserFollowingRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserFollowingRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *GetUserFollowingRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetUserFollowingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        GetUserFollowingResponse_GetUserFollowingStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetUserFollowingResponse_GetUserFollowingStatus" json:"status,omitempty"`
+	FollowingsIds []int64                                         `protobuf:"varint,2,rep,packed,name=followingsIds,proto3" json:"followingsIds,omitempty"`
+	NextCursor    int64                                           `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	TotalCount    int64                                           `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+}
+
+func (x *GetUserFollowingResponse) Reset() {
+	*x = GetUserFollowingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserFollowingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserFollowingResponse) ProtoMessage() {}
+
+func (x *GetUserFollowingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserFollowingResponse.ProtoReflect.Descriptor instead.
+func (*GetUserFollowingResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetUserFollowingResponse) GetStatus() GetUserFollowingResponse_GetUserFollowingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetUserFollowingResponse_OK
+}
+
+func (x *GetUserFollowingResponse) GetFollowingsIds() []int64 {
+	if x != nil {
+		return x.FollowingsIds
+	}
+	return nil
+}
+
+func (x *GetUserFollowingResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *GetUserFollowingResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type FollowUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FollowingId int64 `protobuf:"varint,2,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+}
+
+func (x *FollowUserRequest) Reset() {
+	*x = FollowUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserRequest) ProtoMessage() {}
+
+func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
+func (*FollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *FollowUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FollowUserRequest) GetFollowingId() int64 {
+	if x != nil {
+		return x.FollowingId
+	}
+	return 0
+}
+
+type FollowUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status FollowUserResponse_FollowUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.FollowUserResponse_FollowUserStatus" json:"status,omitempty"`
+}
+
+func (x *FollowUserResponse) Reset() {
+	*x = FollowUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserResponse) ProtoMessage() {}
+
+func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserResponse.ProtoReflect.Descriptor instead.
+func (*FollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *FollowUserResponse) GetStatus() FollowUserResponse_FollowUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FollowUserResponse_OK
+}
+
+type UnfollowUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FollowingId int64 `protobuf:"varint,2,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+}
+
+func (x *UnfollowUserRequest) Reset() {
+	*x = UnfollowUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserRequest) ProtoMessage() {}
+
+func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UnfollowUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnfollowUserRequest) GetFollowingId() int64 {
+	if x != nil {
+		return x.FollowingId
+	}
+	return 0
+}
+
+type UnfollowUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status UnfollowUserResponse_UnfollowUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.UnfollowUserResponse_UnfollowUserStatus" json:"status,omitempty"`
+}
+
+func (x *UnfollowUserResponse) Reset() {
+	*x = UnfollowUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserResponse) ProtoMessage() {}
+
+func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UnfollowUserResponse) GetStatus() UnfollowUserResponse_UnfollowUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UnfollowUserResponse_OK
+}
+
+type GetFollowRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetFollowRequestsRequest) Reset() {
+	*x = GetFollowRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFollowRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowRequestsRequest) ProtoMessage() {}
+
+func (x *GetFollowRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowRequestsRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetFollowRequestsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetFollowRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetFollowRequestsResponse_GetFollowRequestsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetFollowRequestsResponse_GetFollowRequestsStatus" json:"status,omitempty"`
+	// Users waiting for approval, oldest requests first
+	FollowersIds []int64 `protobuf:"varint,2,rep,packed,name=followers_ids,json=followersIds,proto3" json:"followers_ids,omitempty"`
+}
+
+func (x *GetFollowRequestsResponse) Reset() {
+	*x = GetFollowRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFollowRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowRequestsResponse) ProtoMessage() {}
+
+func (x *GetFollowRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetFollowRequestsResponse) GetStatus() GetFollowRequestsResponse_GetFollowRequestsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetFollowRequestsResponse_OK
+}
+
+func (x *GetFollowRequestsResponse) GetFollowersIds() []int64 {
+	if x != nil {
+		return x.FollowersIds
+	}
+	return nil
+}
+
+type ApproveFollowRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FollowerId int64 `protobuf:"varint,2,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+}
+
+func (x *ApproveFollowRequestRequest) Reset() {
+	*x = ApproveFollowRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFollowRequestRequest) ProtoMessage() {}
+
+func (x *ApproveFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ApproveFollowRequestRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ApproveFollowRequestRequest) GetFollowerId() int64 {
+	if x != nil {
+		return x.FollowerId
+	}
+	return 0
+}
+
+type ApproveFollowRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status ApproveFollowRequestResponse_ApproveFollowRequestStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.ApproveFollowRequestResponse_ApproveFollowRequestStatus" json:"status,omitempty"`
+}
+
+func (x *ApproveFollowRequestResponse) Reset() {
+	*x = ApproveFollowRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFollowRequestResponse) ProtoMessage() {}
+
+func (x *ApproveFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ApproveFollowRequestResponse) GetStatus() ApproveFollowRequestResponse_ApproveFollowRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ApproveFollowRequestResponse_OK
+}
+
+type RejectFollowRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FollowerId int64 `protobuf:"varint,2,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+}
+
+func (x *RejectFollowRequestRequest) Reset() {
+	*x = RejectFollowRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFollowRequestRequest) ProtoMessage() {}
+
+func (x *RejectFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RejectFollowRequestRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RejectFollowRequestRequest) GetFollowerId() int64 {
+	if x != nil {
+		return x.FollowerId
+	}
+	return 0
+}
+
+type RejectFollowRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RejectFollowRequestResponse_RejectFollowRequestStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.RejectFollowRequestResponse_RejectFollowRequestStatus" json:"status,omitempty"`
+}
+
+func (x *RejectFollowRequestResponse) Reset() {
+	*x = RejectFollowRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFollowRequestResponse) ProtoMessage() {}
+
+func (x *RejectFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*RejectFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RejectFollowRequestResponse) GetStatus() RejectFollowRequestResponse_RejectFollowRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RejectFollowRequestResponse_OK
+}
+
+type GetRelationshipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetsIds []int64 `protobuf:"varint,2,rep,packed,name=targets_ids,json=targetsIds,proto3" json:"targets_ids,omitempty"`
+}
+
+func (x *GetRelationshipRequest) Reset() {
+	*x = GetRelationshipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipRequest) ProtoMessage() {}
+
+func (x *GetRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*GetRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetRelationshipRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetRelationshipRequest) GetTargetsIds() []int64 {
+	if x != nil {
+		return x.TargetsIds
+	}
+	return nil
+}
+
+type Relationship struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetId int64 `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// following is true if the user follows the target
+	Following bool `protobuf:"varint,2,opt,name=following,proto3" json:"following,omitempty"`
+	// followed_by is true if the target follows the user
+	FollowedBy bool `protobuf:"varint,3,opt,name=followed_by,json=followedBy,proto3" json:"followed_by,omitempty"`
+	// blocked is true if the user blocked the target
+	Blocked bool `protobuf:"varint,4,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	// requested is true if the user sent a pending follow request to the target
+	Requested bool `protobuf:"varint,5,opt,name=requested,proto3" json:"requested,omitempty"`
+	// mutual_count is the number of other users followed by both of them
+	MutualCount int64 `protobuf:"varint,6,opt,name=mutual_count,json=mutualCount,proto3" json:"mutual_count,omitempty"`
+}
+
+func (x *Relationship) Reset() {
+	*x = Relationship{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Relationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relationship) ProtoMessage() {}
+
+func (x *Relationship) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Relationship) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *Relationship) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+func (x *Relationship) GetFollowedBy() bool {
+	if x != nil {
+		return x.FollowedBy
+	}
+	return false
+}
+
+func (x *Relationship) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *Relationship) GetRequested() bool {
+	if x != nil {
+		return x.Requested
+	}
+	return false
+}
+
+func (x *Relationship) GetMutualCount() int64 {
+	if x != nil {
+		return x.MutualCount
+	}
+	return 0
+}
+
+type GetRelationshipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        GetRelationshipResponse_GetRelationshipStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetRelationshipResponse_GetRelationshipStatus" json:"status,omitempty"`
+	Relationships []*Relationship                               `protobuf:"bytes,2,rep,name=relationships,proto3" json:"relationships,omitempty"`
+}
+
+func (x *GetRelationshipResponse) Reset() {
+	*x = GetRelationshipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipResponse) ProtoMessage() {}
+
+func (x *GetRelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipResponse.ProtoReflect.Descriptor instead.
+func (*GetRelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetRelationshipResponse) GetStatus() GetRelationshipResponse_GetRelationshipStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetRelationshipResponse_OK
+}
+
+func (x *GetRelationshipResponse) GetRelationships() []*Relationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+type GetFollowSuggestionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit  int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetFollowSuggestionsRequest) Reset() {
+	*x = GetFollowSuggestionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFollowSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowSuggestionsRequest) ProtoMessage() {}
+
+func (x *GetFollowSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetFollowSuggestionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetFollowSuggestionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetFollowSuggestionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetFollowSuggestionsResponse_GetFollowSuggestionsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetFollowSuggestionsResponse_GetFollowSuggestionsStatus" json:"status,omitempty"`
+	// Best suggestions first
+	UsersIds []int64 `protobuf:"varint,2,rep,packed,name=users_ids,json=usersIds,proto3" json:"users_ids,omitempty"`
+}
+
+func (x *GetFollowSuggestionsResponse) Reset() {
+	*x = GetFollowSuggestionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFollowSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowSuggestionsResponse) ProtoMessage() {}
+
+func (x *GetFollowSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetFollowSuggestionsResponse) GetStatus() GetFollowSuggestionsResponse_GetFollowSuggestionsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetFollowSuggestionsResponse_OK
+}
+
+func (x *GetFollowSuggestionsResponse) GetUsersIds() []int64 {
+	if x != nil {
+		return x.UsersIds
+	}
+	return nil
+}
+
+type AudienceListInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AudienceListId int64                `protobuf:"varint,1,opt,name=audience_list_id,json=audienceListId,proto3" json:"audience_list_id,omitempty"`
+	Name           string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MembersCount   int64                `protobuf:"varint,3,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	CreatedAt      *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AudienceListInfo) Reset() {
+	*x = AudienceListInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AudienceListInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudienceListInfo) ProtoMessage() {}
+
+func (x *AudienceListInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudienceListInfo.ProtoReflect.Descriptor instead.
+func (*AudienceListInfo) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AudienceListInfo) GetAudienceListId() int64 {
+	if x != nil {
+		return x.AudienceListId
+	}
+	return 0
+}
+
+func (x *AudienceListInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AudienceListInfo) GetMembersCount() int64 {
+	if x != nil {
+		return x.MembersCount
+	}
+	return 0
+}
+
+func (x *AudienceListInfo) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateAudienceListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateAudienceListRequest) Reset() {
+	*x = CreateAudienceListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAudienceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAudienceListRequest) ProtoMessage() {}
+
+func (x *CreateAudienceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAudienceListRequest.ProtoReflect.Descriptor instead.
+func (*CreateAudienceListRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateAudienceListRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateAudienceListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateAudienceListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status         CreateAudienceListResponse_CreateAudienceListStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.CreateAudienceListResponse_CreateAudienceListStatus" json:"status,omitempty"`
+	AudienceListId int64                                               `protobuf:"varint,2,opt,name=audience_list_id,json=audienceListId,proto3" json:"audience_list_id,omitempty"`
+}
+
+func (x *CreateAudienceListResponse) Reset() {
+	*x = CreateAudienceListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAudienceListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAudienceListResponse) ProtoMessage() {}
+
+func (x *CreateAudienceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAudienceListResponse.ProtoReflect.Descriptor instead.
+func (*CreateAudienceListResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateAudienceListResponse) GetStatus() CreateAudienceListResponse_CreateAudienceListStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CreateAudienceListResponse_OK
+}
+
+func (x *CreateAudienceListResponse) GetAudienceListId() int64 {
+	if x != nil {
+		return x.AudienceListId
+	}
+	return 0
+}
+
+type DeleteAudienceListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AudienceListId int64 `protobuf:"varint,2,opt,name=audience_list_id,json=audienceListId,proto3" json:"audience_list_id,omitempty"`
+}
+
+func (x *DeleteAudienceListRequest) Reset() {
+	*x = DeleteAudienceListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAudienceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAudienceListRequest) ProtoMessage() {}
+
+func (x *DeleteAudienceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAudienceListRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAudienceListRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeleteAudienceListRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteAudienceListRequest) GetAudienceListId() int64 {
+	if x != nil {
+		return x.AudienceListId
+	}
+	return 0
+}
+
+type DeleteAudienceListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status DeleteAudienceListResponse_DeleteAudienceListStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.DeleteAudienceListResponse_DeleteAudienceListStatus" json:"status,omitempty"`
+}
+
+func (x *DeleteAudienceListResponse) Reset() {
+	*x = DeleteAudienceListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAudienceListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAudienceListResponse) ProtoMessage() {}
+
+func (x *DeleteAudienceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAudienceListResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAudienceListResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteAudienceListResponse) GetStatus() DeleteAudienceListResponse_DeleteAudienceListStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeleteAudienceListResponse_OK
+}
+
+type GetAudienceListsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetAudienceListsRequest) Reset() {
+	*x = GetAudienceListsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAudienceListsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAudienceListsRequest) ProtoMessage() {}
+
+func (x *GetAudienceListsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAudienceListsRequest.ProtoReflect.Descriptor instead.
+func (*GetAudienceListsRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetAudienceListsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetAudienceListsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        GetAudienceListsResponse_GetAudienceListsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetAudienceListsResponse_GetAudienceListsStatus" json:"status,omitempty"`
+	AudienceLists []*AudienceListInfo                             `protobuf:"bytes,2,rep,name=audience_lists,json=audienceLists,proto3" json:"audience_lists,omitempty"`
+}
+
+func (x *GetAudienceListsResponse) Reset() {
+	*x = GetAudienceListsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAudienceListsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAudienceListsResponse) ProtoMessage() {}
+
+func (x *GetAudienceListsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAudienceListsResponse.ProtoReflect.Descriptor instead.
+func (*GetAudienceListsResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetAudienceListsResponse) GetStatus() GetAudienceListsResponse_GetAudienceListsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetAudienceListsResponse_OK
+}
+
+func (x *GetAudienceListsResponse) GetAudienceLists() []*AudienceListInfo {
+	if x != nil {
+		return x.AudienceLists
+	}
+	return nil
+}
+
+type GetAudienceListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AudienceListId int64 `protobuf:"varint,2,opt,name=audience_list_id,json=audienceListId,proto3" json:"audience_list_id,omitempty"`
+}
+
+func (x *GetAudienceListRequest) Reset() {
+	*x = GetAudienceListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAudienceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAudienceListRequest) ProtoMessage() {}
+
+func (x *GetAudienceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAudienceListRequest.ProtoReflect.Descriptor instead.
+func (*GetAudienceListRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetAudienceListRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetAudienceListRequest) GetAudienceListId() int64 {
+	if x != nil {
+		return x.AudienceListId
+	}
+	return 0
+}
+
+type GetAudienceListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status       GetAudienceListResponse_GetAudienceListStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetAudienceListResponse_GetAudienceListStatus" json:"status,omitempty"`
+	AudienceList *AudienceListInfo                             `protobuf:"bytes,2,opt,name=audience_list,json=audienceList,proto3" json:"audience_list,omitempty"`
+	MembersIds   []int64                                       `protobuf:"varint,3,rep,packed,name=members_ids,json=membersIds,proto3" json:"members_ids,omitempty"`
+}
+
+func (x *GetAudienceListResponse) Reset() {
+	*x = GetAudienceListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAudienceListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAudienceListResponse) ProtoMessage() {}
+
+func (x *GetAudienceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAudienceListResponse.ProtoReflect.Descriptor instead.
+func (*GetAudienceListResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetAudienceListResponse) GetStatus() GetAudienceListResponse_GetAudienceListStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetAudienceListResponse_OK
+}
+
+func (x *GetAudienceListResponse) GetAudienceList() *AudienceListInfo {
+	if x != nil {
+		return x.AudienceList
+	}
+	return nil
+}
+
+func (x *GetAudienceListResponse) GetMembersIds() []int64 {
+	if x != nil {
+		return x.MembersIds
+	}
+	return nil
+}
+
+type AddAudienceListMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AudienceListId int64 `protobuf:"varint,2,opt,name=audience_list_id,json=audienceListId,proto3" json:"audience_list_id,omitempty"`
+	MemberId       int64 `protobuf:"varint,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+}
+
+func (x *AddAudienceListMemberRequest) Reset() {
+	*x = AddAudienceListMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAudienceListMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAudienceListMemberRequest) ProtoMessage() {}
+
+func (x *AddAudienceListMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAudienceListMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddAudienceListMemberRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AddAudienceListMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddAudienceListMemberRequest) GetAudienceListId() int64 {
+	if x != nil {
+		return x.AudienceListId
+	}
+	return 0
+}
+
+func (x *AddAudienceListMemberRequest) GetMemberId() int64 {
+	if x != nil {
+		return x.MemberId
+	}
+	return 0
+}
+
+type AddAudienceListMemberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status AddAudienceListMemberResponse_AddAudienceListMemberStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.AddAudienceListMemberResponse_AddAudienceListMemberStatus" json:"status,omitempty"`
+}
+
+func (x *AddAudienceListMemberResponse) Reset() {
+	*x = AddAudienceListMemberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAudienceListMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAudienceListMemberResponse) ProtoMessage() {}
+
+func (x *AddAudienceListMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAudienceListMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddAudienceListMemberResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AddAudienceListMemberResponse) GetStatus() AddAudienceListMemberResponse_AddAudienceListMemberStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AddAudienceListMemberResponse_OK
+}
+
+type RemoveAudienceListMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AudienceListId int64 `protobuf:"varint,2,opt,name=audience_list_id,json=audienceListId,proto3" json:"audience_list_id,omitempty"`
+	MemberId       int64 `protobuf:"varint,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+}
+
+func (x *RemoveAudienceListMemberRequest) Reset() {
+	*x = RemoveAudienceListMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAudienceListMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAudienceListMemberRequest) ProtoMessage() {}
+
+func (x *RemoveAudienceListMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAudienceListMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAudienceListMemberRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RemoveAudienceListMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveAudienceListMemberRequest) GetAudienceListId() int64 {
+	if x != nil {
+		return x.AudienceListId
+	}
+	return 0
+}
+
+func (x *RemoveAudienceListMemberRequest) GetMemberId() int64 {
+	if x != nil {
+		return x.MemberId
+	}
+	return 0
+}
+
+type RemoveAudienceListMemberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus" json:"status,omitempty"`
+}
+
+func (x *RemoveAudienceListMemberResponse) Reset() {
+	*x = RemoveAudienceListMemberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAudienceListMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAudienceListMemberResponse) ProtoMessage() {}
+
+func (x *RemoveAudienceListMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAudienceListMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAudienceListMemberResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RemoveAudienceListMemberResponse) GetStatus() RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RemoveAudienceListMemberResponse_OK
+}
+
+type BlockUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockedUserId int64 `protobuf:"varint,2,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"`
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *BlockUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BlockUserRequest) GetBlockedUserId() int64 {
+	if x != nil {
+		return x.BlockedUserId
+	}
+	return 0
+}
+
+type BlockUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status BlockUserResponse_BlockUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.BlockUserResponse_BlockUserStatus" json:"status,omitempty"`
+}
+
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResponse) ProtoMessage() {}
+
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *BlockUserResponse) GetStatus() BlockUserResponse_BlockUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BlockUserResponse_OK
+}
+
+type UnblockUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockedUserId int64 `protobuf:"varint,2,opt,name=blocked_user_id,json=blockedUserId,proto3" json:"blocked_user_id,omitempty"`
+}
+
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserRequest) ProtoMessage() {}
+
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UnblockUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnblockUserRequest) GetBlockedUserId() int64 {
+	if x != nil {
+		return x.BlockedUserId
+	}
+	return 0
+}
+
+type UnblockUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status UnblockUserResponse_UnblockUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.UnblockUserResponse_UnblockUserStatus" json:"status,omitempty"`
+}
+
+func (x *UnblockUserResponse) Reset() {
+	*x = UnblockUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserResponse) ProtoMessage() {}
+
+func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UnblockUserResponse) GetStatus() UnblockUserResponse_UnblockUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UnblockUserResponse_OK
+}
+
+type MuteUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MutedUserId int64 `protobuf:"varint,2,opt,name=muted_user_id,json=mutedUserId,proto3" json:"muted_user_id,omitempty"`
+}
+
+func (x *MuteUserRequest) Reset() {
+	*x = MuteUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteUserRequest) ProtoMessage() {}
+
+func (x *MuteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteUserRequest.ProtoReflect.Descriptor instead.
+func (*MuteUserRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *MuteUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MuteUserRequest) GetMutedUserId() int64 {
+	if x != nil {
+		return x.MutedUserId
+	}
+	return 0
+}
+
+type MuteUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status MuteUserResponse_MuteUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.MuteUserResponse_MuteUserStatus" json:"status,omitempty"`
+}
+
+func (x *MuteUserResponse) Reset() {
+	*x = MuteUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteUserResponse) ProtoMessage() {}
+
+func (x *MuteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteUserResponse.ProtoReflect.Descriptor instead.
+func (*MuteUserResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *MuteUserResponse) GetStatus() MuteUserResponse_MuteUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MuteUserResponse_OK
+}
+
+type UnmuteUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MutedUserId int64 `protobuf:"varint,2,opt,name=muted_user_id,json=mutedUserId,proto3" json:"muted_user_id,omitempty"`
+}
+
+func (x *UnmuteUserRequest) Reset() {
+	*x = UnmuteUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnmuteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteUserRequest) ProtoMessage() {}
+
+func (x *UnmuteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteUserRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteUserRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UnmuteUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnmuteUserRequest) GetMutedUserId() int64 {
+	if x != nil {
+		return x.MutedUserId
+	}
+	return 0
+}
+
+type UnmuteUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status UnmuteUserResponse_UnmuteUserStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.UnmuteUserResponse_UnmuteUserStatus" json:"status,omitempty"`
+}
+
+func (x *UnmuteUserResponse) Reset() {
+	*x = UnmuteUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnmuteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteUserResponse) ProtoMessage() {}
+
+func (x *UnmuteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteUserResponse.ProtoReflect.Descriptor instead.
+func (*UnmuteUserResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UnmuteUserResponse) GetStatus() UnmuteUserResponse_UnmuteUserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UnmuteUserResponse_OK
+}
+
+type GetUserPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Optional, posts of private accounts are only readable by their approved followers
+	ViewerId int64 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+}
+
+func (x *GetUserPostsRequest) Reset() {
+	*x = GetUserPostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPostsRequest) ProtoMessage() {}
+
+func (x *GetUserPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPostsRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetUserPostsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserPostsRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type GetUserPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status         GetUserPostsResponse_GetUserPostsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetUserPostsResponse_GetUserPostsStatus" json:"status,omitempty"`
+	PostsIds       []int64                                 `protobuf:"varint,2,rep,packed,name=posts_ids,json=postsIds,proto3" json:"posts_ids,omitempty"`
+	PinnedPostsIds []int64                                 `protobuf:"varint,3,rep,packed,name=pinned_posts_ids,json=pinnedPostsIds,proto3" json:"pinned_posts_ids,omitempty"`
+}
+
+func (x *GetUserPostsResponse) Reset() {
+	*x = GetUserPostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPostsResponse) ProtoMessage() {}
+
+func (x *GetUserPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserPostsResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetUserPostsResponse) GetStatus() GetUserPostsResponse_GetUserPostsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetUserPostsResponse_OK
+}
+
+func (x *GetUserPostsResponse) GetPostsIds() []int64 {
+	if x != nil {
+		return x.PostsIds
+	}
+	return nil
+}
+
+func (x *GetUserPostsResponse) GetPinnedPostsIds() []int64 {
+	if x != nil {
+		return x.PinnedPostsIds
+	}
+	return nil
+}
+
+type CreatePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentText      string   `protobuf:"bytes,2,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	ContentImagePath []string `protobuf:"bytes,3,rep,name=content_image_path,json=contentImagePath,proto3" json:"content_image_path,omitempty"`
+	Visible          bool     `protobuf:"varint,4,opt,name=visible,proto3" json:"visible,omitempty"`
+	Poll             *NewPoll `protobuf:"bytes,5,opt,name=poll,proto3" json:"poll,omitempty"`
+	// Restricts the post to the members of an audience list of the user
+	AudienceListId *int64 `protobuf:"varint,6,opt,name=audience_list_id,json=audienceListId,proto3,oneof" json:"audience_list_id,omitempty"`
+}
+
+func (x *CreatePostRequest) Reset() {
+	*x = CreatePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostRequest) ProtoMessage() {}
+
+func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
+func (*CreatePostRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CreatePostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreatePostRequest) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetContentImagePath() []string {
+	if x != nil {
+		return x.ContentImagePath
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetVisible() bool {
+	if x != nil {
+		return x.Visible
+	}
+	return false
+}
+
+func (x *CreatePostRequest) GetPoll() *NewPoll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetAudienceListId() int64 {
+	if x != nil && x.AudienceListId != nil {
+		return *x.AudienceListId
+	}
+	return 0
+}
+
+type NewPoll struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options        []string             `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	MultipleChoice bool                 `protobuf:"varint,2,opt,name=multiple_choice,json=multipleChoice,proto3" json:"multiple_choice,omitempty"`
+	ClosesAt       *timestamp.Timestamp `protobuf:"bytes,3,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+}
+
+func (x *NewPoll) Reset() {
+	*x = NewPoll{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewPoll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewPoll) ProtoMessage() {}
+
+func (x *NewPoll) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewPoll.ProtoReflect.Descriptor instead.
+func (*NewPoll) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *NewPoll) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *NewPoll) GetMultipleChoice() bool {
+	if x != nil {
+		return x.MultipleChoice
+	}
+	return false
+}
+
+func (x *NewPoll) GetClosesAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+type CreatePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status CreatePostResponse_CreatePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.CreatePostResponse_CreatePostStatus" json:"status,omitempty"`
+	PostId int64                               `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *CreatePostResponse) Reset() {
+	*x = CreatePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostResponse) ProtoMessage() {}
+
+func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
+func (*CreatePostResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CreatePostResponse) GetStatus() CreatePostResponse_CreatePostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CreatePostResponse_OK
+}
+
+func (x *CreatePostResponse) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type GetPostDetailInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Optional, posts of users blocked by or blocking the viewer are not found
+	ViewerId int64 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+}
+
+func (x *GetPostDetailInfoRequest) Reset() {
+	*x = GetPostDetailInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostDetailInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostDetailInfoRequest) ProtoMessage() {}
+
+func (x *GetPostDetailInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostDetailInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetPostDetailInfoRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetPostDetailInfoRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetPostDetailInfoRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type GetPostDetailInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetPostDetailInfoResponse_GetPostDetailInfoStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetPostDetailInfoResponse_GetPostDetailInfoStatus" json:"status,omitempty"`
+	Post   *PostDetailInfo                                   `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *GetPostDetailInfoResponse) Reset() {
+	*x = GetPostDetailInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostDetailInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostDetailInfoResponse) ProtoMessage() {}
+
+func (x *GetPostDetailInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostDetailInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetPostDetailInfoResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetPostDetailInfoResponse) GetStatus() GetPostDetailInfoResponse_GetPostDetailInfoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetPostDetailInfoResponse_OK
+}
+
+func (x *GetPostDetailInfoResponse) GetPost() *PostDetailInfo {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type EditPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId           int64   `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ContentText      *string `protobuf:"bytes,3,opt,name=content_text,json=contentText,proto3,oneof" json:"content_text,omitempty"`
+	ContentImagePath *string `protobuf:"bytes,4,opt,name=content_image_path,json=contentImagePath,proto3,oneof" json:"content_image_path,omitempty"`
+	Visible          *bool   `protobuf:"varint,5,opt,name=visible,proto3,oneof" json:"visible,omitempty"`
+}
+
+func (x *EditPostRequest) Reset() {
+	*x = EditPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPostRequest) ProtoMessage() {}
+
+func (x *EditPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPostRequest.ProtoReflect.Descriptor instead.
+func (*EditPostRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *EditPostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EditPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *EditPostRequest) GetContentText() string {
+	if x != nil && x.ContentText != nil {
+		return *x.ContentText
+	}
+	return ""
+}
+
+func (x *EditPostRequest) GetContentImagePath() string {
+	if x != nil && x.ContentImagePath != nil {
+		return *x.ContentImagePath
+	}
+	return ""
+}
+
+func (x *EditPostRequest) GetVisible() bool {
+	if x != nil && x.Visible != nil {
+		return *x.Visible
+	}
+	return false
+}
+
+type EditPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status EditPostResponse_EditPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.EditPostResponse_EditPostStatus" json:"status,omitempty"`
+}
+
+func (x *EditPostResponse) Reset() {
+	*x = EditPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPostResponse) ProtoMessage() {}
+
+func (x *EditPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPostResponse.ProtoReflect.Descriptor instead.
+func (*EditPostResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *EditPostResponse) GetStatus() EditPostResponse_EditPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EditPostResponse_OK
+}
+
+type DeletePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DeletePostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeletePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type DeletePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status DeletePostResponse_DeletePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.DeletePostResponse_DeletePostStatus" json:"status,omitempty"`
+}
+
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResponse) ProtoMessage() {}
+
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DeletePostResponse) GetStatus() DeletePostResponse_DeletePostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeletePostResponse_OK
+}
+
+type CommentPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId      int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ContentText string `protobuf:"bytes,3,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+}
+
+func (x *CommentPostRequest) Reset() {
+	*x = CommentPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentPostRequest) ProtoMessage() {}
+
+func (x *CommentPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentPostRequest.ProtoReflect.Descriptor instead.
+func (*CommentPostRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CommentPostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CommentPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *CommentPostRequest) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+type CommentPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    CommentPostResponse_CommentPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.CommentPostResponse_CommentPostStatus" json:"status,omitempty"`
+	CommentId int64                                 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *CommentPostResponse) Reset() {
+	*x = CommentPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentPostResponse) ProtoMessage() {}
+
+func (x *CommentPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentPostResponse.ProtoReflect.Descriptor instead.
+func (*CommentPostResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CommentPostResponse) GetStatus() CommentPostResponse_CommentPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommentPostResponse_OK
+}
+
+func (x *CommentPostResponse) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type LikePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *LikePostRequest) Reset() {
+	*x = LikePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostRequest) ProtoMessage() {}
+
+func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
+func (*LikePostRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *LikePostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LikePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type LikePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status LikePostResponse_LikePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.LikePostResponse_LikePostStatus" json:"status,omitempty"`
+}
+
+func (x *LikePostResponse) Reset() {
+	*x = LikePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostResponse) ProtoMessage() {}
+
+func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostResponse.ProtoReflect.Descriptor instead.
+func (*LikePostResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *LikePostResponse) GetStatus() LikePostResponse_LikePostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LikePostResponse_OK
+}
+
+type PinPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *PinPostRequest) Reset() {
+	*x = PinPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPostRequest) ProtoMessage() {}
+
+func (x *PinPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPostRequest.ProtoReflect.Descriptor instead.
+func (*PinPostRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *PinPostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PinPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type PinPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status PinPostResponse_PinPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.PinPostResponse_PinPostStatus" json:"status,omitempty"`
+}
+
+func (x *PinPostResponse) Reset() {
+	*x = PinPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPostResponse) ProtoMessage() {}
+
+func (x *PinPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPostResponse.ProtoReflect.Descriptor instead.
+func (*PinPostResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *PinPostResponse) GetStatus() PinPostResponse_PinPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PinPostResponse_OK
+}
+
+type UnpinPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *UnpinPostRequest) Reset() {
+	*x = UnpinPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinPostRequest) ProtoMessage() {}
+
+func (x *UnpinPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinPostRequest.ProtoReflect.Descriptor instead.
+func (*UnpinPostRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UnpinPostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnpinPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type UnpinPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status UnpinPostResponse_UnpinPostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.UnpinPostResponse_UnpinPostStatus" json:"status,omitempty"`
+}
+
+func (x *UnpinPostResponse) Reset() {
+	*x = UnpinPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinPostResponse) ProtoMessage() {}
+
+func (x *UnpinPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinPostResponse.ProtoReflect.Descriptor instead.
+func (*UnpinPostResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UnpinPostResponse) GetStatus() UnpinPostResponse_UnpinPostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UnpinPostResponse_OK
+}
+
+type VotePollRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     int64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId     int64   `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	OptionsIds []int64 `protobuf:"varint,3,rep,packed,name=options_ids,json=optionsIds,proto3" json:"options_ids,omitempty"`
+}
+
+func (x *VotePollRequest) Reset() {
+	*x = VotePollRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VotePollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollRequest) ProtoMessage() {}
+
+func (x *VotePollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollRequest.ProtoReflect.Descriptor instead.
+func (*VotePollRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *VotePollRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VotePollRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *VotePollRequest) GetOptionsIds() []int64 {
+	if x != nil {
+		return x.OptionsIds
+	}
+	return nil
+}
+
+type VotePollResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status VotePollResponse_VotePollStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.VotePollResponse_VotePollStatus" json:"status,omitempty"`
+}
+
+func (x *VotePollResponse) Reset() {
+	*x = VotePollResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VotePollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollResponse) ProtoMessage() {}
+
+func (x *VotePollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollResponse.ProtoReflect.Descriptor instead.
+func (*VotePollResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *VotePollResponse) GetStatus() VotePollResponse_VotePollStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VotePollResponse_OK
+}
+
+type GetPollResultsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetPollResultsRequest) Reset() {
+	*x = GetPollResultsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPollResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPollResultsRequest) ProtoMessage() {}
+
+func (x *GetPollResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPollResultsRequest.ProtoReflect.Descriptor instead.
+func (*GetPollResultsRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetPollResultsRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetPollResultsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetPollResultsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status          GetPollResultsResponse_GetPollResultsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetPollResultsResponse_GetPollResultsStatus" json:"status,omitempty"`
+	Poll            *Poll                                       `protobuf:"bytes,2,opt,name=poll,proto3" json:"poll,omitempty"`
+	VotedOptionsIds []int64                                     `protobuf:"varint,3,rep,packed,name=voted_options_ids,json=votedOptionsIds,proto3" json:"voted_options_ids,omitempty"`
+}
+
+func (x *GetPollResultsResponse) Reset() {
+	*x = GetPollResultsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPollResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPollResultsResponse) ProtoMessage() {}
+
+func (x *GetPollResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPollResultsResponse.ProtoReflect.Descriptor instead.
+func (*GetPollResultsResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetPollResultsResponse) GetStatus() GetPollResultsResponse_GetPollResultsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetPollResultsResponse_OK
+}
+
+func (x *GetPollResultsResponse) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *GetPollResultsResponse) GetVotedOptionsIds() []int64 {
+	if x != nil {
+		return x.VotedOptionsIds
+	}
+	return nil
+}
+
+type SavePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *SavePostRequest) Reset() {
+	*x = SavePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SavePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePostRequest) ProtoMessage() {}
+
+func (x *SavePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePostRequest.ProtoReflect.Descriptor instead.
+func (*SavePostRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SavePostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SavePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type SavePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status SavePostResponse_SavePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.SavePostResponse_SavePostStatus" json:"status,omitempty"`
+}
+
+func (x *SavePostResponse) Reset() {
+	*x = SavePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SavePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePostResponse) ProtoMessage() {}
+
+func (x *SavePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePostResponse.ProtoReflect.Descriptor instead.
+func (*SavePostResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *SavePostResponse) GetStatus() SavePostResponse_SavePostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SavePostResponse_OK
+}
+
+type UnsavePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *UnsavePostRequest) Reset() {
+	*x = UnsavePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsavePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsavePostRequest) ProtoMessage() {}
+
+func (x *UnsavePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsavePostRequest.ProtoReflect.Descriptor instead.
+func (*UnsavePostRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *UnsavePostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnsavePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type UnsavePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status UnsavePostResponse_UnsavePostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.UnsavePostResponse_UnsavePostStatus" json:"status,omitempty"`
+}
+
+func (x *UnsavePostResponse) Reset() {
+	*x = UnsavePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsavePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsavePostResponse) ProtoMessage() {}
+
+func (x *UnsavePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsavePostResponse.ProtoReflect.Descriptor instead.
+func (*UnsavePostResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *UnsavePostResponse) GetStatus() UnsavePostResponse_UnsavePostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UnsavePostResponse_OK
+}
+
+type ListSavedPostsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cursor int64 `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListSavedPostsRequest) Reset() {
+	*x = ListSavedPostsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSavedPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedPostsRequest) ProtoMessage() {}
+
+func (x *ListSavedPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedPostsRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListSavedPostsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListSavedPostsRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ListSavedPostsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSavedPostsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     ListSavedPostsResponse_ListSavedPostsStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.ListSavedPostsResponse_ListSavedPostsStatus" json:"status,omitempty"`
+	PostsIds   []int64                                     `protobuf:"varint,2,rep,packed,name=posts_ids,json=postsIds,proto3" json:"posts_ids,omitempty"`
+	NextCursor int64                                       `protobuf:"varint,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListSavedPostsResponse) Reset() {
+	*x = ListSavedPostsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSavedPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedPostsResponse) ProtoMessage() {}
+
+func (x *ListSavedPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedPostsResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListSavedPostsResponse) GetStatus() ListSavedPostsResponse_ListSavedPostsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ListSavedPostsResponse_OK
+}
+
+func (x *ListSavedPostsResponse) GetPostsIds() []int64 {
+	if x != nil {
+		return x.PostsIds
+	}
+	return nil
+}
+
+func (x *ListSavedPostsResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+type CreateStoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentText      string `protobuf:"bytes,2,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	ContentImagePath string `protobuf:"bytes,3,opt,name=content_image_path,json=contentImagePath,proto3" json:"content_image_path,omitempty"`
+}
+
+func (x *CreateStoryRequest) Reset() {
+	*x = CreateStoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStoryRequest) ProtoMessage() {}
+
+func (x *CreateStoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateStoryRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *CreateStoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateStoryRequest) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *CreateStoryRequest) GetContentImagePath() string {
+	if x != nil {
+		return x.ContentImagePath
+	}
+	return ""
+}
+
+type CreateStoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  CreateStoryResponse_CreateStoryStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.CreateStoryResponse_CreateStoryStatus" json:"status,omitempty"`
+	StoryId int64                                 `protobuf:"varint,2,opt,name=story_id,json=storyId,proto3" json:"story_id,omitempty"`
+}
+
+func (x *CreateStoryResponse) Reset() {
+	*x = CreateStoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStoryResponse) ProtoMessage() {}
+
+func (x *CreateStoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateStoryResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *CreateStoryResponse) GetStatus() CreateStoryResponse_CreateStoryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CreateStoryResponse_OK
+}
+
+func (x *CreateStoryResponse) GetStoryId() int64 {
+	if x != nil {
+		return x.StoryId
+	}
+	return 0
+}
+
+type GetStoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StoryId int64 `protobuf:"varint,2,opt,name=story_id,json=storyId,proto3" json:"story_id,omitempty"`
+}
+
+func (x *GetStoryRequest) Reset() {
+	*x = GetStoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoryRequest) ProtoMessage() {}
+
+func (x *GetStoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoryRequest.ProtoReflect.Descriptor instead.
+func (*GetStoryRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetStoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetStoryRequest) GetStoryId() int64 {
+	if x != nil {
+		return x.StoryId
+	}
+	return 0
+}
+
+type GetStoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetStoryResponse_GetStoryStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetStoryResponse_GetStoryStatus" json:"status,omitempty"`
+	Story  *Story                          `protobuf:"bytes,2,opt,name=story,proto3" json:"story,omitempty"`
+}
+
+func (x *GetStoryResponse) Reset() {
+	*x = GetStoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoryResponse) ProtoMessage() {}
+
+func (x *GetStoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoryResponse.ProtoReflect.Descriptor instead.
+func (*GetStoryResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetStoryResponse) GetStatus() GetStoryResponse_GetStoryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetStoryResponse_OK
+}
+
+func (x *GetStoryResponse) GetStory() *Story {
+	if x != nil {
+		return x.Story
+	}
+	return nil
+}
+
+type DeleteStoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StoryId int64 `protobuf:"varint,2,opt,name=story_id,json=storyId,proto3" json:"story_id,omitempty"`
+}
+
+func (x *DeleteStoryRequest) Reset() {
+	*x = DeleteStoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteStoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStoryRequest) ProtoMessage() {}
+
+func (x *DeleteStoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStoryRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *DeleteStoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteStoryRequest) GetStoryId() int64 {
+	if x != nil {
+		return x.StoryId
+	}
+	return 0
+}
+
+type DeleteStoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status DeleteStoryResponse_DeleteStoryStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.DeleteStoryResponse_DeleteStoryStatus" json:"status,omitempty"`
+}
+
+func (x *DeleteStoryResponse) Reset() {
+	*x = DeleteStoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteStoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStoryResponse) ProtoMessage() {}
+
+func (x *DeleteStoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStoryResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *DeleteStoryResponse) GetStatus() DeleteStoryResponse_DeleteStoryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeleteStoryResponse_OK
+}
+
+type GetStoriesTrayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetStoriesTrayRequest) Reset() {
+	*x = GetStoriesTrayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStoriesTrayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoriesTrayRequest) ProtoMessage() {}
+
+func (x *GetStoriesTrayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoriesTrayRequest.ProtoReflect.Descriptor instead.
+func (*GetStoriesTrayRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetStoriesTrayRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetStoriesTrayResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status GetStoriesTrayResponse_GetStoriesTrayStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetStoriesTrayResponse_GetStoriesTrayStatus" json:"status,omitempty"`
+	Items  []*StoriesTrayItem                          `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *GetStoriesTrayResponse) Reset() {
+	*x = GetStoriesTrayResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStoriesTrayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoriesTrayResponse) ProtoMessage() {}
+
+func (x *GetStoriesTrayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoriesTrayResponse.ProtoReflect.Descriptor instead.
+func (*GetStoriesTrayResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetStoriesTrayResponse) GetStatus() GetStoriesTrayResponse_GetStoriesTrayStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetStoriesTrayResponse_OK
+}
+
+func (x *GetStoriesTrayResponse) GetItems() []*StoriesTrayItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetStoryViewersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StoryId int64 `protobuf:"varint,2,opt,name=story_id,json=storyId,proto3" json:"story_id,omitempty"`
+}
+
+func (x *GetStoryViewersRequest) Reset() {
+	*x = GetStoryViewersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStoryViewersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoryViewersRequest) ProtoMessage() {}
+
+func (x *GetStoryViewersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoryViewersRequest.ProtoReflect.Descriptor instead.
+func (*GetStoryViewersRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetStoryViewersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetStoryViewersRequest) GetStoryId() int64 {
+	if x != nil {
+		return x.StoryId
+	}
+	return 0
+}
+
+type GetStoryViewersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  GetStoryViewersResponse_GetStoryViewersStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetStoryViewersResponse_GetStoryViewersStatus" json:"status,omitempty"`
+	Viewers []*StoryViewer                                `protobuf:"bytes,2,rep,name=viewers,proto3" json:"viewers,omitempty"`
+}
+
+func (x *GetStoryViewersResponse) Reset() {
+	*x = GetStoryViewersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStoryViewersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoryViewersResponse) ProtoMessage() {}
+
+func (x *GetStoryViewersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoryViewersResponse.ProtoReflect.Descriptor instead.
+func (*GetStoryViewersResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetStoryViewersResponse) GetStatus() GetStoryViewersResponse_GetStoryViewersStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetStoryViewersResponse_OK
+}
+
+func (x *GetStoryViewersResponse) GetViewers() []*StoryViewer {
+	if x != nil {
+		return x.Viewers
+	}
+	return nil
+}
+
+type GetS3PresignedUrlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetS3PresignedUrlRequest) Reset() {
+	*x = GetS3PresignedUrlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetS3PresignedUrlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetS3PresignedUrlRequest) ProtoMessage() {}
+
+func (x *GetS3PresignedUrlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetS3PresignedUrlRequest.ProtoReflect.Descriptor instead.
+func (*GetS3PresignedUrlRequest) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GetS3PresignedUrlRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetS3PresignedUrlResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status         GetS3PresignedUrlResponse_GetS3PresignedUrlStatus `protobuf:"varint,1,opt,name=status,proto3,enum=authen_and_post.GetS3PresignedUrlResponse_GetS3PresignedUrlStatus" json:"status,omitempty"`
+	Url            string                                            `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	ExpirationTime *timestamp.Timestamp                              `protobuf:"bytes,3,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+}
+
+func (x *GetS3PresignedUrlResponse) Reset() {
+	*x = GetS3PresignedUrlResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetS3PresignedUrlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetS3PresignedUrlResponse) ProtoMessage() {}
+
+func (x *GetS3PresignedUrlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetS3PresignedUrlResponse.ProtoReflect.Descriptor instead.
+func (*GetS3PresignedUrlResponse) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetS3PresignedUrlResponse) GetStatus() GetS3PresignedUrlResponse_GetS3PresignedUrlStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetS3PresignedUrlResponse_OK
+}
+
+func (x *GetS3PresignedUrlResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GetS3PresignedUrlResponse) GetExpirationTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return nil
+}
+
+type PostDetailInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId           int64                `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId           int64                `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentText      string               `protobuf:"bytes,3,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	ContentImagePath []string             `protobuf:"bytes,4,rep,name=content_image_path,json=contentImagePath,proto3" json:"content_image_path,omitempty"`
+	Visible          bool                 `protobuf:"varint,5,opt,name=visible,proto3" json:"visible,omitempty"`
+	CreatedAt        *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Comments         []*Comment           `protobuf:"bytes,7,rep,name=comments,proto3" json:"comments,omitempty"`
+	LikedUsers       []*Like              `protobuf:"bytes,8,rep,name=liked_users,json=likedUsers,proto3" json:"liked_users,omitempty"`
+	Poll             *Poll                `protobuf:"bytes,9,opt,name=poll,proto3" json:"poll,omitempty"`
+	LinkPreview      *LinkPreview         `protobuf:"bytes,10,opt,name=link_preview,json=linkPreview,proto3" json:"link_preview,omitempty"`
+}
+
+func (x *PostDetailInfo) Reset() {
+	*x = PostDetailInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostDetailInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostDetailInfo) ProtoMessage() {}
+
+func (x *PostDetailInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostDetailInfo.ProtoReflect.Descriptor instead.
+func (*PostDetailInfo) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *PostDetailInfo) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *PostDetailInfo) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PostDetailInfo) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *PostDetailInfo) GetContentImagePath() []string {
+	if x != nil {
+		return x.ContentImagePath
+	}
+	return nil
+}
+
+func (x *PostDetailInfo) GetVisible() bool {
+	if x != nil {
+		return x.Visible
+	}
+	return false
+}
+
+func (x *PostDetailInfo) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PostDetailInfo) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *PostDetailInfo) GetLikedUsers() []*Like {
+	if x != nil {
+		return x.LikedUsers
+	}
+	return nil
+}
+
+func (x *PostDetailInfo) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *PostDetailInfo) GetLinkPreview() *LinkPreview {
+	if x != nil {
+		return x.LinkPreview
+	}
+	return nil
+}
+
+type Comment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId   int64  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	PostId      int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId      int64  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentText string `protobuf:"bytes,4,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *Comment) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *Comment) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *Comment) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Comment) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+type Like struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *Like) Reset() {
+	*x = Like{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Like) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Like) ProtoMessage() {}
+
+func (x *Like) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Like.ProtoReflect.Descriptor instead.
+func (*Like) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *Like) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *Like) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type Poll struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId         int64                `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	MultipleChoice bool                 `protobuf:"varint,2,opt,name=multiple_choice,json=multipleChoice,proto3" json:"multiple_choice,omitempty"`
+	ClosesAt       *timestamp.Timestamp `protobuf:"bytes,3,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+	Closed         bool                 `protobuf:"varint,4,opt,name=closed,proto3" json:"closed,omitempty"`
+	Options        []*PollOption        `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	TotalVoters    int64                `protobuf:"varint,6,opt,name=total_voters,json=totalVoters,proto3" json:"total_voters,omitempty"`
+}
+
+func (x *Poll) Reset() {
+	*x = Poll{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Poll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Poll) ProtoMessage() {}
+
+func (x *Poll) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Poll.ProtoReflect.Descriptor instead.
+func (*Poll) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *Poll) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *Poll) GetMultipleChoice() bool {
+	if x != nil {
+		return x.MultipleChoice
+	}
+	return false
+}
+
+func (x *Poll) GetClosesAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+func (x *Poll) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *Poll) GetOptions() []*PollOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Poll) GetTotalVoters() int64 {
+	if x != nil {
+		return x.TotalVoters
+	}
+	return 0
+}
+
+type PollOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OptionId    int64  `protobuf:"varint,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	ContentText string `protobuf:"bytes,2,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	Votes       int64  `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (x *PollOption) Reset() {
+	*x = PollOption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollOption) ProtoMessage() {}
+
+func (x *PollOption) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollOption.ProtoReflect.Descriptor instead.
+func (*PollOption) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *PollOption) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+func (x *PollOption) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *PollOption) GetVotes() int64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+type LinkPreview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url         string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl    string `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+}
+
+func (x *LinkPreview) Reset() {
+	*x = LinkPreview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkPreview) ProtoMessage() {}
+
+func (x *LinkPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkPreview.ProtoReflect.Descriptor instead.
+func (*LinkPreview) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *LinkPreview) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+type Story struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StoryId          int64                `protobuf:"varint,1,opt,name=story_id,json=storyId,proto3" json:"story_id,omitempty"`
+	UserId           int64                `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ContentText      string               `protobuf:"bytes,3,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	ContentImagePath string               `protobuf:"bytes,4,opt,name=content_image_path,json=contentImagePath,proto3" json:"content_image_path,omitempty"`
+	CreatedAt        *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt        *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *Story) Reset() {
+	*x = Story{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Story) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Story) ProtoMessage() {}
+
+func (x *Story) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Story.ProtoReflect.Descriptor instead.
+func (*Story) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *Story) GetStoryId() int64 {
+	if x != nil {
+		return x.StoryId
+	}
+	return 0
+}
+
+func (x *Story) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Story) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *Story) GetContentImagePath() string {
+	if x != nil {
+		return x.ContentImagePath
+	}
+	return ""
+}
+
+func (x *Story) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Story) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type StoriesTrayItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId          int64                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StoriesIds      []int64              `protobuf:"varint,2,rep,packed,name=stories_ids,json=storiesIds,proto3" json:"stories_ids,omitempty"`
+	LatestCreatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=latest_created_at,json=latestCreatedAt,proto3" json:"latest_created_at,omitempty"`
+	Seen            bool                 `protobuf:"varint,4,opt,name=seen,proto3" json:"seen,omitempty"`
+}
+
+func (x *StoriesTrayItem) Reset() {
+	*x = StoriesTrayItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoriesTrayItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoriesTrayItem) ProtoMessage() {}
+
+func (x *StoriesTrayItem) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoriesTrayItem.ProtoReflect.Descriptor instead.
+func (*StoriesTrayItem) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *StoriesTrayItem) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StoriesTrayItem) GetStoriesIds() []int64 {
+	if x != nil {
+		return x.StoriesIds
+	}
+	return nil
+}
+
+func (x *StoriesTrayItem) GetLatestCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LatestCreatedAt
+	}
+	return nil
+}
+
+func (x *StoriesTrayItem) GetSeen() bool {
+	if x != nil {
+		return x.Seen
+	}
+	return false
+}
+
+type StoryViewer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64                `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ViewedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=viewed_at,json=viewedAt,proto3" json:"viewed_at,omitempty"`
+}
+
+func (x *StoryViewer) Reset() {
+	*x = StoryViewer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authen_and_post_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoryViewer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoryViewer) ProtoMessage() {}
+
+func (x *StoryViewer) ProtoReflect() protoreflect.Message {
+	mi := &file_authen_and_post_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoryViewer.ProtoReflect.Descriptor instead.
+func (*StoryViewer) Descriptor() ([]byte, []int) {
+	return file_authen_and_post_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *StoryViewer) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StoryViewer) GetViewedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ViewedAt
+	}
+	return nil
+}
+
+var File_authen_and_post_proto protoreflect.FileDescriptor
+
+var file_authen_and_post_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x01, 0x0a, 0x1e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xf9, 0x03, 0x0a, 0x1f, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x4e, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x33, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x4e, 0x0a, 0x15, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x90, 0x01, 0x0a, 0x1d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a,
+	0x0e, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10,
+	0x02, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4c, 0x4f, 0x43,
+	0x4b, 0x45, 0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x57, 0x4f, 0x5f, 0x46, 0x41, 0x43,
+	0x54, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x12,
+	0x0a, 0x0e, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x4f, 0x54, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x10, 0x05, 0x22, 0x6b, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x73, 0x65,
+	0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22,
+	0xc0, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x43, 0x0a,
+	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53, 0x45,
+	0x52, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x11, 0x0a, 0x0d, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x22, 0x95, 0x06, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x28, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x43, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x03, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74,
+	0x68, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52,
+	0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x09, 0x69,
+	0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a,
+	0x03, 0x62, 0x69, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x03, 0x62, 0x69,
+	0x6f, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0a, 0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x6e, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0b, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6e, 0x6f, 0x75,
+	0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x56, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x56, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04,
+	0x5f, 0x62, 0x69, 0x6f, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x70, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x6e, 0x73, 0x22, 0xc0, 0x02, 0x0a, 0x10, 0x45,
+	0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x48, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x30, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x52, 0x0a, 0x18, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x6e, 0x65, 0x78, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x74, 0x22, 0x8d, 0x01,
+	0x0a, 0x0e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
+	0x54, 0x57, 0x4f, 0x5f, 0x46, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49,
+	0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x4f,
+	0x54, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53, 0x45,
+	0x52, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12,
+	0x1c, 0x0a, 0x18, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x4f, 0x4f, 0x4e, 0x10, 0x05, 0x22, 0x6d, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x22, 0xe3, 0x01, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x42, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x01, 0x22, 0x98, 0x05, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65,
+	0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x27, 0x0a,
+	0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f,
+	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x6e, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x6e, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xe9, 0x04,
+	0x0a, 0x11, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x48, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x56, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x56, 0x0a,
+	0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x56, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72,
+	0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x56, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x48, 0x02, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x03, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x07, 0x77,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x04, 0x52, 0x07, 0x77,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x6e, 0x6f, 0x75, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e,
+	0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x05, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x6e, 0x6f, 0x75, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x22, 0x34, 0x0a, 0x0a, 0x56, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x42, 0x4c, 0x49,
+	0x43, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x53,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x4d, 0x45, 0x10, 0x02, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x5f,
+	0x62, 0x69, 0x6f, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x70, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x6e, 0x73, 0x22, 0x37, 0x0a, 0x1c, 0x53, 0x65, 0x6e,
+	0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0xd4, 0x01, 0x0a, 0x1d, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x4a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4f, 0x0a, 0x1b, 0x53, 0x65, 0x6e, 0x64,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x56,
+	0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x02, 0x22, 0x2a, 0x0a, 0x12, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xab, 0x01, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x44, 0x0a,
+	0x11, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x14, 0x0a,
+	0x10, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x02, 0x22, 0x33, 0x0a, 0x1b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xba, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x48, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x38, 0x0a, 0x1a, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x56, 0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65,
+	0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xd2, 0x01,
+	0x0a, 0x1c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x48,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x1a, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12,
+	0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e,
+	0x10, 0x01, 0x22, 0x31, 0x0a, 0x16, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xfe, 0x01, 0x0a, 0x17, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x56, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x3e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x55, 0x72, 0x69, 0x22, 0x48, 0x0a, 0x15,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x4e, 0x41,
+	0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0x4c, 0x0a, 0x16, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x74, 0x70,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x74, 0x70,
+	0x43, 0x6f, 0x64, 0x65, 0x22, 0xf4, 0x01, 0x0a, 0x17, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54,
+	0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x56, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x3e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22,
+	0x5a, 0x0a, 0x15, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00,
+	0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4e, 0x52, 0x4f, 0x4c, 0x4c, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x4e,
+	0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x52, 0x4f, 0x4e, 0x47,
+	0x5f, 0x4f, 0x54, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x03, 0x22, 0x4d, 0x0a, 0x17, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x18, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x40, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x45, 0x0a, 0x16, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x4b, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x4f, 0x54,
+	0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x02, 0x22, 0x69, 0x0a, 0x16, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x70, 0x22, 0xa3, 0x03, 0x0a, 0x17, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x77,
+	0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x56, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x3e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0c,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x4e, 0x0a, 0x15, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6c, 0x0a, 0x15, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01,
+	0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x4f, 0x54, 0x50, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x04, 0x22, 0x54, 0x0a, 0x1e, 0x52, 0x65, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0xfe, 0x01, 0x0a, 0x1f, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x4e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x22, 0x4c, 0x0a, 0x1d, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4e,
+	0x4f, 0x54, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e,
+	0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x4f, 0x54, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x02,
+	0x22, 0x6c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xbb,
+	0x02, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x4e, 0x0a, 0x15, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x50, 0x41,
+	0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x57, 0x4f, 0x5f,
+	0x46, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x4f, 0x54, 0x50, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x22, 0x34, 0x0a, 0x19,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0xc5, 0x01, 0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x73, 0x65,
+	0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x44, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x49, 0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x54, 0x5f, 0x53,
+	0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0xb9, 0x03, 0x0a, 0x0e, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x45, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72,
+	0x6c, 0x12, 0x51, 0x0a, 0x17, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75, 0x72,
+	0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22,
+	0x35, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0x33, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xfc, 0x01, 0x0a, 0x19,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x42, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x4a,
+	0x0a, 0x17, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x22, 0x2f, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xe6, 0x01, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x22, 0x40, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x02, 0x22, 0x5d, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0xad, 0x01, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x22, 0x1b, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
+	0x00, 0x22, 0x5f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x8c, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3e,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x73, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x33, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x01, 0x22, 0x60, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x92, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x58, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x40, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x64, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x34, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
+	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x4f, 0x0a, 0x11, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0xdf, 0x01, 0x0a, 0x12, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x34, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7b,
+	0x0a, 0x10, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x14,
+	0x0a, 0x10, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f,
+	0x57, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x05, 0x22, 0x51, 0x0a, 0x13, 0x55,
+	0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0xac,
+	0x01, 0x0a, 0x14, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55,
+	0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x42, 0x0a, 0x12, 0x55, 0x6e, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x22, 0x33, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0xd3, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x42, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x49, 0x64,
+	0x73, 0x22, 0x35, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
+	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x57, 0x0a, 0x1b, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0xd1, 0x01, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x60, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x48, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x4f, 0x0a, 0x1a, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x15,
+	0x0a, 0x11, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x02, 0x22, 0x56, 0x0a, 0x1a, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x64, 0x22, 0xcd, 0x01,
+	0x0a, 0x1b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x46, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4e, 0x0a,
+	0x19, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x22, 0x52, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x49, 0x64,
+	0x73, 0x22, 0xc5, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68,
+	0x69, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x75, 0x74, 0x75, 0x61, 0x6c,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x75,
+	0x74, 0x75, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x81, 0x02, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x43, 0x0a,
+	0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x68, 0x69, 0x70, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x73, 0x22, 0x49, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x68, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x4f, 0x4f, 0x5f, 0x4d,
+	0x41, 0x4e, 0x59, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x53, 0x10, 0x02, 0x22, 0x4c, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xd7, 0x01, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x48, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x73, 0x49, 0x64, 0x73, 0x22, 0x38, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0xb0, 0x01, 0x0a, 0x10, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75,
+	0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x48, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0xf0, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x64,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x44, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x28, 0x0a, 0x10, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x75, 0x64, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x18, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x01, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49,
+	0x53, 0x54, 0x53, 0x10, 0x02, 0x22, 0x5e, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x61,
+	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x44, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x64,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x4a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x49,
+	0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x22, 0x32,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0xf4, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x58, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x40, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x48, 0x0a, 0x0e, 0x61, 0x75, 0x64,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x73, 0x22, 0x34, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a,
+	0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x5b, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10,
+	0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x22, 0xa3, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x3e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x46, 0x0a, 0x0d, 0x61, 0x75,
+	0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x49, 0x64, 0x73, 0x22, 0x47, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
+	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x49, 0x53, 0x54,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x22, 0x7e, 0x0a, 0x1c,
+	0x41, 0x64, 0x64, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x22, 0xe6, 0x01, 0x0a,
+	0x1d, 0x41, 0x64, 0x64, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x4a,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x41, 0x64, 0x64, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41,
+	0x64, 0x64, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x61, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a,
+	0x0e, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x02, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x4d, 0x45, 0x4d,
+	0x42, 0x45, 0x52, 0x10, 0x03, 0x22, 0x81, 0x01, 0x0a, 0x1f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x75,
+	0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x64, 0x22, 0xee, 0x01, 0x0a, 0x20, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x50,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x60, 0x0a, 0x1e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f,
+	0x54, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x03, 0x22, 0x53, 0x0a, 0x10, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0xb4, 0x01, 0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x53, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01,
+	0x12, 0x13, 0x0a, 0x0f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x42, 0x4c, 0x4f, 0x43,
+	0x4b, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c,
+	0x4f, 0x57, 0x45, 0x44, 0x10, 0x03, 0x22, 0x55, 0x0a, 0x12, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa7, 0x01,
+	0x0a, 0x13, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x40, 0x0a, 0x11, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x4c,
+	0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x22, 0x4e, 0x0a, 0x0f, 0x4d, 0x75, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x75, 0x74, 0x65,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x10, 0x4d, 0x75, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4d,
+	0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x50, 0x0a, 0x0e, 0x4d, 0x75, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f,
+	0x4d, 0x55, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41,
+	0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x03, 0x22, 0x50, 0x0a, 0x11, 0x55, 0x6e, 0x6d, 0x75,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d,
+	0x75, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa1, 0x01, 0x0a, 0x12, 0x55,
+	0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x34, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x3d, 0x0a, 0x10, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12,
+	0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x4d, 0x55, 0x54, 0x45, 0x44, 0x10, 0x02, 0x22, 0x4b,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x22, 0xf6, 0x01, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x73,
+	0x49, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0e, 0x70,
+	0x69, 0x6e, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x49, 0x64, 0x73, 0x22, 0x45, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55,
+	0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12,
+	0x13, 0x0a, 0x0f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55,
+	0x4e, 0x54, 0x10, 0x02, 0x22, 0x89, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x2c,
+	0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4e,
+	0x65, 0x77, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x12, 0x2d, 0x0a, 0x10,
+	0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f,
+	0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x22, 0x85, 0x01, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x65, 0x5f, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12,
+	0x37, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x22, 0xf2, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x34, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x75, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x50, 0x4f, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4d, 0x41, 0x49,
+	0x4c, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x1b, 0x0a, 0x17, 0x41, 0x55, 0x44, 0x49, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x4c, 0x49, 0x53,
+	0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x22, 0x50, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0xe3, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x42, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x04, 0x70, 0x6f, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x35,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0xf1, 0x01, 0x0a, 0x0f, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50,
+	0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62,
+	0x6c, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x10, 0x45, 0x64,
+	0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x51, 0x0a, 0x0e, 0x45, 0x64, 0x69, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c,
+	0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x22, 0x45, 0x0a, 0x11, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x22, 0xb7, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x53, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41,
+	0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x22, 0x69, 0x0a, 0x12,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x36, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x54,
+	0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50,
+	0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12,
+	0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57,
+	0x45, 0x44, 0x10, 0x03, 0x22, 0x43, 0x0a, 0x0f, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xc2, 0x01, 0x0a, 0x10, 0x4c, 0x69,
+	0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x64, 0x0a, 0x0e, 0x4c, 0x69, 0x6b, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x4c,
+	0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0f, 0x0a,
+	0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x04, 0x22, 0x42,
+	0x0a, 0x0e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x22, 0xd6, 0x01, 0x0a, 0x0f, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7b,
+	0x0a, 0x0d, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e,
+	0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03,
+	0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x50, 0x49, 0x4e, 0x4e,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x49, 0x4e, 0x5f, 0x4c, 0x49, 0x4d, 0x49,
+	0x54, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0x05, 0x22, 0x44, 0x0a, 0x10, 0x55,
+	0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x22, 0xb2, 0x01, 0x0a, 0x11, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x70, 0x69,
+	0x6e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x51, 0x0a, 0x0f, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x54, 0x5f, 0x50, 0x49,
+	0x4e, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x22, 0x64, 0x0a, 0x0f, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64, 0x73, 0x22, 0xd7, 0x01, 0x0a,
+	0x10, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x30, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x79, 0x0a, 0x0e, 0x56,
+	0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a,
+	0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x4c, 0x4c, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a,
+	0x0b, 0x50, 0x4f, 0x4c, 0x4c, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x13,
+	0x0a, 0x0f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e,
+	0x53, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x56,
+	0x4f, 0x54, 0x45, 0x44, 0x10, 0x05, 0x22, 0x49, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c,
+	0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0xf9, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x29, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x12, 0x2a, 0x0a,
+	0x11, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0f, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64, 0x73, 0x22, 0x32, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x4c,
+	0x4c, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x43, 0x0a,
+	0x0f, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x10, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x53, 0x0a, 0x0e, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50,
+	0x4f, 0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12,
+	0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x53,
+	0x41, 0x56, 0x45, 0x44, 0x10, 0x03, 0x22, 0x45, 0x0a, 0x11, 0x55, 0x6e, 0x73, 0x61, 0x76, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0xa1, 0x01,
+	0x0a, 0x12, 0x55, 0x6e, 0x73, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x73, 0x61, 0x76, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x3d, 0x0a, 0x10, 0x55, 0x6e, 0x73, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x41, 0x56, 0x45, 0x44, 0x10,
+	0x02, 0x22, 0x5e, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0xe0, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50,
+	0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50,
+	0x6f, 0x73, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x49, 0x64, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0x32, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x01, 0x22, 0x7e, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x22, 0xc4, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02,
+	0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x02, 0x22, 0x45, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x49, 0x64, 0x22, 0xcd, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22,
+	0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x4f,
+	0x52, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12,
+	0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x02, 0x22, 0x48, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x22, 0xbc, 0x01, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x55, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74,
+	0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10,
+	0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f,
+	0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x03, 0x22, 0x30, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x54, 0x72, 0x61, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xda, 0x01,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x54, 0x72, 0x61, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x54, 0x72, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x54, 0x72, 0x61, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x54, 0x72, 0x61, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x32, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x54, 0x72, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06,
+	0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x4c, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x22, 0x84, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x3e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x56,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x07,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x52, 0x07, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x73, 0x22, 0x59, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79,
+	0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a,
+	0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x0f,
+	0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x03, 0x22,
+	0x33, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x33, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x8f, 0x02, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x33, 0x50, 0x72,
+	0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x42, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x33, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x33, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x12, 0x43, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x33, 0x50, 0x72,
+	0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x41, 0x49, 0x4c,
+	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x22, 0xc2, 0x03, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x74, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2c,
+	0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x6c, 0x69, 0x6b, 0x65, 0x64,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c,
+	0x69, 0x6b, 0x65, 0x52, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x29, 0x0a, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6c, 0x6c, 0x12, 0x3f, 0x0a, 0x0c, 0x6c, 0x69,
+	0x6e, 0x6b, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x0b,
+	0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x7d, 0x0a, 0x07, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x22, 0x38, 0x0a, 0x04, 0x4c, 0x69,
+	0x6b, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0xf3, 0x01, 0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x65, 0x5f, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12,
+	0x37, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x12, 0x35, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x22, 0x62, 0x0a, 0x0a, 0x50, 0x6f,
+	0x6c, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x74,
+	0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x55, 0x72, 0x6c, 0x22, 0x82, 0x02, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x0f, 0x53, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x54, 0x72, 0x61, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73,
+	0x65, 0x65, 0x6e, 0x22, 0x5f, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x64, 0x41, 0x74, 0x32, 0x96, 0x2d, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x17,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x15, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5a, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x23, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x14,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0f, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54,
+	0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x66, 0x0a, 0x0f, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77,
+	0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x10, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x28,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54,
+	0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7e, 0x0a,
+	0x17, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a,
+	0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x29, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12,
+	0x28, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x5d, 0x0a, 0x0c, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x24, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x24,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a,
+	0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x6c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a,
+	0x14, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x13, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x27, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x75, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x28, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x15,
+	0x41, 0x64, 0x64, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x75, 0x64, 0x69, 0x65,
+	0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x81, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x75, 0x64, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x75,
+	0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x08, 0x4d, 0x75,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4d, 0x75, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a,
+	0x0a, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e,
+	0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x6c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a,
+	0x08, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x64,
+	0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x57, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x22,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x08, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53,
+	0x33, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x29, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x33, 0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x33,
+	0x50, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73,
+	0x74, 0x12, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x09, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50,
+	0x6f, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x08,
+	0x56, 0x6f, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x50,
+	0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x56, 0x6f, 0x74,
+	0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x63, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x08, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x73, 0x74, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0a, 0x55, 0x6e, 0x73, 0x61, 0x76,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x61, 0x76, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x55, 0x6e, 0x73, 0x61,
+	0x76, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x63, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73,
+	0x74, 0x73, 0x12, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x51, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x63, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x54, 0x72,
+	0x61, 0x79, 0x12, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x54,
+	0x72, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x54, 0x72, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70,
+	0x6f, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x4a, 0x5a,
+	0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x78, 0x75,
+	0x61, 0x6e, 0x71, 0x75, 0x61, 0x6e, 0x67, 0x2f, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x2d, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x62, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_authen_and_post_proto_rawDescOnce sync.Once
+	file_authen_and_post_proto_rawDescData = file_authen_and_post_proto_rawDesc
+)
+
+func file_authen_and_post_proto_rawDescGZIP() []byte {
+	file_authen_and_post_proto_rawDescOnce.Do(func() {
+		file_authen_and_post_proto_rawDescData = protoimpl.X.CompressGZIP(file_authen_and_post_proto_rawDescData)
+	})
+	return file_authen_and_post_proto_rawDescData
+}
+
+var file_authen_and_post_proto_enumTypes = make([]protoimpl.EnumInfo, 59)
+var file_authen_and_post_proto_msgTypes = make([]protoimpl.MessageInfo, 130)
+var file_authen_and_post_proto_goTypes = []interface{}{
+	(CheckUserAuthenticationResponse_CheckUserAuthenticationStatus)(0),   // 0: authen_and_post.CheckUserAuthenticationResponse.CheckUserAuthenticationStatus
+	(CreateUserResponse_CreateUserStatus)(0),                             // 1: authen_and_post.CreateUserResponse.CreateUserStatus
+	(EditUserResponse_EditUserStatus)(0),                                 // 2: authen_and_post.EditUserResponse.EditUserStatus
+	(GetUserDetailInfoResponse_GetUserDetailInfoStatus)(0),               // 3: authen_and_post.GetUserDetailInfoResponse.GetUserDetailInfoStatus
+	(ProfileVisibility_Visibility)(0),                                    // 4: authen_and_post.ProfileVisibility.Visibility
+	(SendVerificationEmailResponse_SendVerificationEmailStatus)(0),       // 5: authen_and_post.SendVerificationEmailResponse.SendVerificationEmailStatus
+	(VerifyEmailResponse_VerifyEmailStatus)(0),                           // 6: authen_and_post.VerifyEmailResponse.VerifyEmailStatus
+	(RequestPasswordResetResponse_RequestPasswordResetStatus)(0),         // 7: authen_and_post.RequestPasswordResetResponse.RequestPasswordResetStatus
+	(ConfirmPasswordResetResponse_ConfirmPasswordResetStatus)(0),         // 8: authen_and_post.ConfirmPasswordResetResponse.ConfirmPasswordResetStatus
+	(EnrollTwoFactorResponse_EnrollTwoFactorStatus)(0),                   // 9: authen_and_post.EnrollTwoFactorResponse.EnrollTwoFactorStatus
+	(EnableTwoFactorResponse_EnableTwoFactorStatus)(0),                   // 10: authen_and_post.EnableTwoFactorResponse.EnableTwoFactorStatus
+	(DisableTwoFactorResponse_DisableTwoFactorStatus)(0),                 // 11: authen_and_post.DisableTwoFactorResponse.DisableTwoFactorStatus
+	(VerifyTwoFactorResponse_VerifyTwoFactorStatus)(0),                   // 12: authen_and_post.VerifyTwoFactorResponse.VerifyTwoFactorStatus
+	(RegenerateRecoveryCodesResponse_RegenerateRecoveryCodesStatus)(0),   // 13: authen_and_post.RegenerateRecoveryCodesResponse.RegenerateRecoveryCodesStatus
+	(DeleteUserResponse_DeleteUserStatus)(0),                             // 14: authen_and_post.DeleteUserResponse.DeleteUserStatus
+	(CancelUserDeletionResponse_CancelUserDeletionStatus)(0),             // 15: authen_and_post.CancelUserDeletionResponse.CancelUserDeletionStatus
+	(DataExportInfo_DataExportState)(0),                                  // 16: authen_and_post.DataExportInfo.DataExportState
+	(RequestDataExportResponse_RequestDataExportStatus)(0),               // 17: authen_and_post.RequestDataExportResponse.RequestDataExportStatus
+	(GetDataExportResponse_GetDataExportStatus)(0),                       // 18: authen_and_post.GetDataExportResponse.GetDataExportStatus
+	(SearchUsersResponse_SearchUsersStatus)(0),                           // 19: authen_and_post.SearchUsersResponse.SearchUsersStatus
+	(GetUserFollowerResponse_GetUserFollowerStatus)(0),                   // 20: authen_and_post.GetUserFollowerResponse.GetUserFollowerStatus
+	(GetUserFollowingResponse_GetUserFollowingStatus)(0),                 // 21: authen_and_post.GetUserFollowingResponse.GetUserFollowingStatus
+	(FollowUserResponse_FollowUserStatus)(0),                             // 22: authen_and_post.FollowUserResponse.FollowUserStatus
+	(UnfollowUserResponse_UnfollowUserStatus)(0),                         // 23: authen_and_post.UnfollowUserResponse.UnfollowUserStatus
+	(GetFollowRequestsResponse_GetFollowRequestsStatus)(0),               // 24: authen_and_post.GetFollowRequestsResponse.GetFollowRequestsStatus
+	(ApproveFollowRequestResponse_ApproveFollowRequestStatus)(0),         // 25: authen_and_post.ApproveFollowRequestResponse.ApproveFollowRequestStatus
+	(RejectFollowRequestResponse_RejectFollowRequestStatus)(0),           // 26: authen_and_post.RejectFollowRequestResponse.RejectFollowRequestStatus
+	(GetRelationshipResponse_GetRelationshipStatus)(0),                   // 27: authen_and_post.GetRelationshipResponse.GetRelationshipStatus
+	(GetFollowSuggestionsResponse_GetFollowSuggestionsStatus)(0),         // 28: authen_and_post.GetFollowSuggestionsResponse.GetFollowSuggestionsStatus
+	(CreateAudienceListResponse_CreateAudienceListStatus)(0),             // 29: authen_and_post.CreateAudienceListResponse.CreateAudienceListStatus
+	(DeleteAudienceListResponse_DeleteAudienceListStatus)(0),             // 30: authen_and_post.DeleteAudienceListResponse.DeleteAudienceListStatus
+	(GetAudienceListsResponse_GetAudienceListsStatus)(0),                 // 31: authen_and_post.GetAudienceListsResponse.GetAudienceListsStatus
+	(GetAudienceListResponse_GetAudienceListStatus)(0),                   // 32: authen_and_post.GetAudienceListResponse.GetAudienceListStatus
+	(AddAudienceListMemberResponse_AddAudienceListMemberStatus)(0),       // 33: authen_and_post.AddAudienceListMemberResponse.AddAudienceListMemberStatus
+	(RemoveAudienceListMemberResponse_RemoveAudienceListMemberStatus)(0), // 34: authen_and_post.RemoveAudienceListMemberResponse.RemoveAudienceListMemberStatus
+	(BlockUserResponse_BlockUserStatus)(0),                               // 35: authen_and_post.BlockUserResponse.BlockUserStatus
+	(UnblockUserResponse_UnblockUserStatus)(0),                           // 36: authen_and_post.UnblockUserResponse.UnblockUserStatus
+	(MuteUserResponse_MuteUserStatus)(0),                                 // 37: authen_and_post.MuteUserResponse.MuteUserStatus
+	(UnmuteUserResponse_UnmuteUserStatus)(0),                             // 38: authen_and_post.UnmuteUserResponse.UnmuteUserStatus
+	(GetUserPostsResponse_GetUserPostsStatus)(0),                         // 39: authen_and_post.GetUserPostsResponse.GetUserPostsStatus
+	(CreatePostResponse_CreatePostStatus)(0),                             // 40: authen_and_post.CreatePostResponse.CreatePostStatus
+	(GetPostDetailInfoResponse_GetPostDetailInfoStatus)(0),               // 41: authen_and_post.GetPostDetailInfoResponse.GetPostDetailInfoStatus
+	(EditPostResponse_EditPostStatus)(0),                                 // 42: authen_and_post.EditPostResponse.EditPostStatus
+	(DeletePostResponse_DeletePostStatus)(0),                             // 43: authen_and_post.DeletePostResponse.DeletePostStatus
+	(CommentPostResponse_CommentPostStatus)(0),                           // 44: authen_and_post.CommentPostResponse.CommentPostStatus
+	(LikePostResponse_LikePostStatus)(0),                                 // 45: authen_and_post.LikePostResponse.LikePostStatus
+	(PinPostResponse_PinPostStatus)(0),                                   // 46: authen_and_post.PinPostResponse.PinPostStatus
+	(UnpinPostResponse_UnpinPostStatus)(0),                               // 47: authen_and_post.UnpinPostResponse.UnpinPostStatus
+	(VotePollResponse_VotePollStatus)(0),                                 // 48: authen_and_post.VotePollResponse.VotePollStatus
+	(GetPollResultsResponse_GetPollResultsStatus)(0),                     // 49: authen_and_post.GetPollResultsResponse.GetPollResultsStatus
+	(SavePostResponse_SavePostStatus)(0),                                 // 50: authen_and_post.SavePostResponse.SavePostStatus
+	(UnsavePostResponse_UnsavePostStatus)(0),                             // 51: authen_and_post.UnsavePostResponse.UnsavePostStatus
+	(ListSavedPostsResponse_ListSavedPostsStatus)(0),                     // 52: authen_and_post.ListSavedPostsResponse.ListSavedPostsStatus
+	(CreateStoryResponse_CreateStoryStatus)(0),                           // 53: authen_and_post.CreateStoryResponse.CreateStoryStatus
+	(GetStoryResponse_GetStoryStatus)(0),                                 // 54: authen_and_post.GetStoryResponse.GetStoryStatus
+	(DeleteStoryResponse_DeleteStoryStatus)(0),                           // 55: authen_and_post.DeleteStoryResponse.DeleteStoryStatus
+	(GetStoriesTrayResponse_GetStoriesTrayStatus)(0),                     // 56: authen_and_post.GetStoriesTrayResponse.GetStoriesTrayStatus
+	(GetStoryViewersResponse_GetStoryViewersStatus)(0),                   // 57: authen_and_post.GetStoryViewersResponse.GetStoryViewersStatus
+	(GetS3PresignedUrlResponse_GetS3PresignedUrlStatus)(0),               // 58: authen_and_post.GetS3PresignedUrlResponse.GetS3PresignedUrlStatus
+	(*CheckUserAuthenticationRequest)(nil),                               // 59: authen_and_post.CheckUserAuthenticationRequest
+	(*CheckUserAuthenticationResponse)(nil),                              // 60: authen_and_post.CheckUserAuthenticationResponse
+	(*CreateUserRequest)(nil),                                            // 61: authen_and_post.CreateUserRequest
+	(*CreateUserResponse)(nil),                                           // 62: authen_and_post.CreateUserResponse
+	(*EditUserRequest)(nil),                                              // 63: authen_and_post.EditUserRequest
+	(*EditUserResponse)(nil),                                             // 64: authen_and_post.EditUserResponse
+	(*GetUserDetailInfoRequest)(nil),                                     // 65: authen_and_post.GetUserDetailInfoRequest
+	(*GetUserDetailInfoResponse)(nil),                                    // 66: authen_and_post.GetUserDetailInfoResponse
+	(*UserDetailInfo)(nil),                                               // 67: authen_and_post.UserDetailInfo
+	(*ProfileVisibility)(nil),                                            // 68: authen_and_post.ProfileVisibility
+	(*SendVerificationEmailRequest)(nil),                                 // 69: authen_and_post.SendVerificationEmailRequest
+	(*SendVerificationEmailResponse)(nil),                                // 70: authen_and_post.SendVerificationEmailResponse
+	(*VerifyEmailRequest)(nil),                                           // 71: authen_and_post.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),                                          // 72: authen_and_post.VerifyEmailResponse
+	(*RequestPasswordResetRequest)(nil),                                  // 73: authen_and_post.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),                                 // 74: authen_and_post.RequestPasswordResetResponse
+	(*ConfirmPasswordResetRequest)(nil),                                  // 75: authen_and_post.ConfirmPasswordResetRequest
+	(*ConfirmPasswordResetResponse)(nil),                                 // 76: authen_and_post.ConfirmPasswordResetResponse
+	(*EnrollTwoFactorRequest)(nil),                                       // 77: authen_and_post.EnrollTwoFactorRequest
+	(*EnrollTwoFactorResponse)(nil),                                      // 78: authen_and_post.EnrollTwoFactorResponse
+	(*EnableTwoFactorRequest)(nil),                                       // 79: authen_and_post.EnableTwoFactorRequest
+	(*EnableTwoFactorResponse)(nil),                                      // 80: authen_and_post.EnableTwoFactorResponse
+	(*DisableTwoFactorRequest)(nil),                                      // 81: authen_and_post.DisableTwoFactorRequest
+	(*DisableTwoFactorResponse)(nil),                                     // 82: authen_and_post.DisableTwoFactorResponse
+	(*VerifyTwoFactorRequest)(nil),                                       // 83: authen_and_post.VerifyTwoFactorRequest
+	(*VerifyTwoFactorResponse)(nil),                                      // 84: authen_and_post.VerifyTwoFactorResponse
+	(*RegenerateRecoveryCodesRequest)(nil),                               // 85: authen_and_post.RegenerateRecoveryCodesRequest
+	(*RegenerateRecoveryCodesResponse)(nil),                              // 86: authen_and_post.RegenerateRecoveryCodesResponse
+	(*DeleteUserRequest)(nil),                                            // 87: authen_and_post.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                                           // 88: authen_and_post.DeleteUserResponse
+	(*CancelUserDeletionRequest)(nil),                                    // 89: authen_and_post.CancelUserDeletionRequest
+	(*CancelUserDeletionResponse)(nil),                                   // 90: authen_and_post.CancelUserDeletionResponse
+	(*DataExportInfo)(nil),                                               // 91: authen_and_post.DataExportInfo
+	(*RequestDataExportRequest)(nil),                                     // 92: authen_and_post.RequestDataExportRequest
+	(*RequestDataExportResponse)(nil),                                    // 93: authen_and_post.RequestDataExportResponse
+	(*GetDataExportRequest)(nil),                                         // 94: authen_and_post.GetDataExportRequest
+	(*GetDataExportResponse)(nil),                                        // 95: authen_and_post.GetDataExportResponse
+	(*SearchUsersRequest)(nil),                                           // 96: authen_and_post.SearchUsersRequest
+	(*UserSearchResult)(nil),                                             // 97: authen_and_post.UserSearchResult
+	(*SearchUsersResponse)(nil),                                          // 98: authen_and_post.SearchUsersResponse
+	(*GetUserFollowerRequest)(nil),                                       // 99: authen_and_post.GetUserFollowerRequest
+	(*GetUserFollowerResponse)(nil),                                      // 100: authen_and_post.GetUserFollowerResponse
+	(*GetUserFollowingRequest)(nil),                                      // 101: authen_and_post.GetUserFollowingRequest
+	(*GetUserFollowingResponse)(nil),                                     // 102: authen_and_post.GetUserFollowingResponse
+	(*FollowUserRequest)(nil),                                            // 103: authen_and_post.FollowUserRequest
+	(*FollowUserResponse)(nil),                                           // 104: authen_and_post.FollowUserResponse
+	(*UnfollowUserRequest)(nil),                                          // 105: authen_and_post.UnfollowUserRequest
+	(*UnfollowUserResponse)(nil),                                         // 106: authen_and_post.UnfollowUserResponse
+	(*GetFollowRequestsRequest)(nil),                                     // 107: authen_and_post.GetFollowRequestsRequest
+	(*GetFollowRequestsResponse)(nil),                                    // 108: authen_and_post.GetFollowRequestsResponse
+	(*ApproveFollowRequestRequest)(nil),                                  // 109: authen_and_post.ApproveFollowRequestRequest
+	(*ApproveFollowRequestResponse)(nil),                                 // 110: authen_and_post.ApproveFollowRequestResponse
+	(*RejectFollowRequestRequest)(nil),                                   // 111: authen_and_post.RejectFollowRequestRequest
+	(*RejectFollowRequestResponse)(nil),                                  // 112: authen_and_post.RejectFollowRequestResponse
+	(*GetRelationshipRequest)(nil),                                       // 113: authen_and_post.GetRelationshipRequest
+	(*Relationship)(nil),                                                 // 114: authen_and_post.Relationship
+	(*GetRelationshipResponse)(nil),                                      // 115: authen_and_post.GetRelationshipResponse
+	(*GetFollowSuggestionsRequest)(nil),                                  // 116: authen_and_post.GetFollowSuggestionsRequest
+	(*GetFollowSuggestionsResponse)(nil),                                 // 117: authen_and_post.GetFollowSuggestionsResponse
+	(*AudienceListInfo)(nil),                                             // 118: authen_and_post.AudienceListInfo
+	(*CreateAudienceListRequest)(nil),                                    // 119: authen_and_post.CreateAudienceListRequest
+	(*CreateAudienceListResponse)(nil),                                   // 120: authen_and_post.CreateAudienceListResponse
+	(*DeleteAudienceListRequest)(nil),                                    // 121: authen_and_post.DeleteAudienceListRequest
+	(*DeleteAudienceListResponse)(nil),                                   // 122: authen_and_post.DeleteAudienceListResponse
+	(*GetAudienceListsRequest)(nil),                                      // 123: authen_and_post.GetAudienceListsRequest
+	(*GetAudienceListsResponse)(nil),                                     // 124: authen_and_post.GetAudienceListsResponse
+	(*GetAudienceListRequest)(nil),                                       // 125: authen_and_post.GetAudienceListRequest
+	(*GetAudienceListResponse)(nil),                                      // 126: authen_and_post.GetAudienceListResponse
+	(*AddAudienceListMemberRequest)(nil),                                 // 127: authen_and_post.AddAudienceListMemberRequest
+	(*AddAudienceListMemberResponse)(nil),                                // 128: authen_and_post.AddAudienceListMemberResponse
+	(*RemoveAudienceListMemberRequest)(nil),                              // 129: authen_and_post.RemoveAudienceListMemberRequest
+	(*RemoveAudienceListMemberResponse)(nil),                             // 130: authen_and_post.RemoveAudienceListMemberResponse
+	(*BlockUserRequest)(nil),                                             // 131: authen_and_post.BlockUserRequest
+	(*BlockUserResponse)(nil),                                            // 132: authen_and_post.BlockUserResponse
+	(*UnblockUserRequest)(nil),                                           // 133: authen_and_post.UnblockUserRequest
+	(*UnblockUserResponse)(nil),                                          // 134: authen_and_post.UnblockUserResponse
+	(*MuteUserRequest)(nil),                                              // 135: authen_and_post.MuteUserRequest
+	(*MuteUserResponse)(nil),                                             // 136: authen_and_post.MuteUserResponse
+	(*UnmuteUserRequest)(nil),                                            // 137: authen_and_post.UnmuteUserRequest
+	(*UnmuteUserResponse)(nil),                                           // 138: authen_and_post.UnmuteUserResponse
+	(*GetUserPostsRequest)(nil),                                          // 139: authen_and_post.GetUserPostsRequest
+	(*GetUserPostsResponse)(nil),                                         // 140: authen_and_post.GetUserPostsResponse
+	(*CreatePostRequest)(nil),                                            // 141: authen_and_post.CreatePostRequest
+	(*NewPoll)(nil),                                                      // 142: authen_and_post.NewPoll
+	(*CreatePostResponse)(nil),                                           // 143: authen_and_post.CreatePostResponse
+	(*GetPostDetailInfoRequest)(nil),                                     // 144: authen_and_post.GetPostDetailInfoRequest
+	(*GetPostDetailInfoResponse)(nil),                                    // 145: authen_and_post.GetPostDetailInfoResponse
+	(*EditPostRequest)(nil),                                              // 146: authen_and_post.EditPostRequest
+	(*EditPostResponse)(nil),                                             // 147: authen_and_post.EditPostResponse
+	(*DeletePostRequest)(nil),                                            // 148: authen_and_post.DeletePostRequest
+	(*DeletePostResponse)(nil),                                           // 149: authen_and_post.DeletePostResponse
+	(*CommentPostRequest)(nil),                                           // 150: authen_and_post.CommentPostRequest
+	(*CommentPostResponse)(nil),                                          // 151: authen_and_post.CommentPostResponse
+	(*LikePostRequest)(nil),                                              // 152: authen_and_post.LikePostRequest
+	(*LikePostResponse)(nil),                                             // 153: authen_and_post.LikePostResponse
+	(*PinPostRequest)(nil),                                               // 154: authen_and_post.PinPostRequest
+	(*PinPostResponse)(nil),                                              // 155: authen_and_post.PinPostResponse
+	(*UnpinPostRequest)(nil),                                             // 156: authen_and_post.UnpinPostRequest
+	(*UnpinPostResponse)(nil),                                            // 157: authen_and_post.UnpinPostResponse
+	(*VotePollRequest)(nil),                                              // 158: authen_and_post.VotePollRequest
+	(*VotePollResponse)(nil),                                             // 159: authen_and_post.VotePollResponse
+	(*GetPollResultsRequest)(nil),                                        // 160: authen_and_post.GetPollResultsRequest
+	(*GetPollResultsResponse)(nil),                                       // 161: authen_and_post.GetPollResultsResponse
+	(*SavePostRequest)(nil),                                              // 162: authen_and_post.SavePostRequest
+	(*SavePostResponse)(nil),                                             // 163: authen_and_post.SavePostResponse
+	(*UnsavePostRequest)(nil),                                            // 164: authen_and_post.UnsavePostRequest
+	(*UnsavePostResponse)(nil),                                           // 165: authen_and_post.UnsavePostResponse
+	(*ListSavedPostsRequest)(nil),                                        // 166: authen_and_post.ListSavedPostsRequest
+	(*ListSavedPostsResponse)(nil),                                       // 167: authen_and_post.ListSavedPostsResponse
+	(*CreateStoryRequest)(nil),                                           // 168: authen_and_post.CreateStoryRequest
+	(*CreateStoryResponse)(nil),                                          // 169: authen_and_post.CreateStoryResponse
+	(*GetStoryRequest)(nil),                                              // 170: authen_and_post.GetStoryRequest
+	(*GetStoryResponse)(nil),                                             // 171: authen_and_post.GetStoryResponse
+	(*DeleteStoryRequest)(nil),                                           // 172: authen_and_post.DeleteStoryRequest
+	(*DeleteStoryResponse)(nil),                                          // 173: authen_and_post.DeleteStoryResponse
+	(*GetStoriesTrayRequest)(nil),                                        // 174: authen_and_post.GetStoriesTrayRequest
+	(*GetStoriesTrayResponse)(nil),                                       // 175: authen_and_post.GetStoriesTrayResponse
+	(*GetStoryViewersRequest)(nil),                                       // 176: authen_and_post.GetStoryViewersRequest
+	(*GetStoryViewersResponse)(nil),                                      // 177: authen_and_post.GetStoryViewersResponse
+	(*GetS3PresignedUrlRequest)(nil),                                     // 178: authen_and_post.GetS3PresignedUrlRequest
+	(*GetS3PresignedUrlResponse)(nil),                                    // 179: authen_and_post.GetS3PresignedUrlResponse
+	(*PostDetailInfo)(nil),                                               // 180: authen_and_post.PostDetailInfo
+	(*Comment)(nil),                                                      // 181: authen_and_post.Comment
+	(*Like)(nil),                                                         // 182: authen_and_post.Like
+	(*Poll)(nil),                                                         // 183: authen_and_post.Poll
+	(*PollOption)(nil),                                                   // 184: authen_and_post.PollOption
+	(*LinkPreview)(nil),                                                  // 185: authen_and_post.LinkPreview
+	(*Story)(nil),                                                        // 186: authen_and_post.Story
+	(*StoriesTrayItem)(nil),                                              // 187: authen_and_post.StoriesTrayItem
+	(*StoryViewer)(nil),                                                  // 188: authen_and_post.StoryViewer
+	(*timestamp.Timestamp)(nil),                                          // 189: google.protobuf.Timestamp
+}
+var file_authen_and_post_proto_depIdxs = []int32{
+	0,   // 0: authen_and_post.CheckUserAuthenticationResponse.status:type_name -> authen_and_post.CheckUserAuthenticationResponse.CheckUserAuthenticationStatus
+	67,  // 1: authen_and_post.CheckUserAuthenticationResponse.user:type_name -> authen_and_post.UserDetailInfo
+	189, // 2: authen_and_post.CheckUserAuthenticationResponse.locked_until:type_name -> google.protobuf.Timestamp
+	189, // 3: authen_and_post.CheckUserAuthenticationResponse.deletion_scheduled_at:type_name -> google.protobuf.Timestamp
+	1,   // 4: authen_and_post.CreateUserResponse.status:type_name -> authen_and_post.CreateUserResponse.CreateUserStatus
+	189, // 5: authen_and_post.EditUserRequest.date_of_birth:type_name -> google.protobuf.Timestamp
+	68,  // 6: authen_and_post.EditUserRequest.profile_visibility:type_name -> authen_and_post.ProfileVisibility
+	2,   // 7: authen_and_post.EditUserResponse.status:type_name -> authen_and_post.EditUserResponse.EditUserStatus
+	189, // 8: authen_and_post.EditUserResponse.next_user_name_change_at:type_name -> google.protobuf.Timestamp
+	3,   // 9: authen_and_post.GetUserDetailInfoResponse.status:type_name -> authen_and_post.GetUserDetailInfoResponse.GetUserDetailInfoStatus
+	67,  // 10: authen_and_post.GetUserDetailInfoResponse.user:type_name -> authen_and_post.UserDetailInfo
+	189, // 11: authen_and_post.UserDetailInfo.date_of_birth:type_name -> google.protobuf.Timestamp
+	68,  // 12: authen_and_post.UserDetailInfo.profile_visibility:type_name -> authen_and_post.ProfileVisibility
+	4,   // 13: authen_and_post.ProfileVisibility.email:type_name -> authen_and_post.ProfileVisibility.Visibility
+	4,   // 14: authen_and_post.ProfileVisibility.date_of_birth:type_name -> authen_and_post.ProfileVisibility.Visibility
+	4,   // 15: authen_and_post.ProfileVisibility.bio:type_name -> authen_and_post.ProfileVisibility.Visibility
+	4,   // 16: authen_and_post.ProfileVisibility.location:type_name -> authen_and_post.ProfileVisibility.Visibility
+	4,   // 17: authen_and_post.ProfileVisibility.website:type_name -> authen_and_post.ProfileVisibility.Visibility
+	4,   // 18: authen_and_post.ProfileVisibility.pronouns:type_name -> authen_and_post.ProfileVisibility.Visibility
+	5,   // 19: authen_and_post.SendVerificationEmailResponse.status:type_name -> authen_and_post.SendVerificationEmailResponse.SendVerificationEmailStatus
+	6,   // 20: authen_and_post.VerifyEmailResponse.status:type_name -> authen_and_post.VerifyEmailResponse.VerifyEmailStatus
+	7,   // 21: authen_and_post.RequestPasswordResetResponse.status:type_name -> authen_and_post.RequestPasswordResetResponse.RequestPasswordResetStatus
+	8,   // 22: authen_and_post.ConfirmPasswordResetResponse.status:type_name -> authen_and_post.ConfirmPasswordResetResponse.ConfirmPasswordResetStatus
+	9,   // 23: authen_and_post.EnrollTwoFactorResponse.status:type_name -> authen_and_post.EnrollTwoFactorResponse.EnrollTwoFactorStatus
+	10,  // 24: authen_and_post.EnableTwoFactorResponse.status:type_name -> authen_and_post.EnableTwoFactorResponse.EnableTwoFactorStatus
+	11,  // 25: authen_and_post.DisableTwoFactorResponse.status:type_name -> authen_and_post.DisableTwoFactorResponse.DisableTwoFactorStatus
+	12,  // 26: authen_and_post.VerifyTwoFactorResponse.status:type_name -> authen_and_post.VerifyTwoFactorResponse.VerifyTwoFactorStatus
+	67,  // 27: authen_and_post.VerifyTwoFactorResponse.user:type_name -> authen_and_post.UserDetailInfo
+	189, // 28: authen_and_post.VerifyTwoFactorResponse.locked_until:type_name -> google.protobuf.Timestamp
+	189, // 29: authen_and_post.VerifyTwoFactorResponse.deletion_scheduled_at:type_name -> google.protobuf.Timestamp
+	13,  // 30: authen_and_post.RegenerateRecoveryCodesResponse.status:type_name -> authen_and_post.RegenerateRecoveryCodesResponse.RegenerateRecoveryCodesStatus
+	14,  // 31: authen_and_post.DeleteUserResponse.status:type_name -> authen_and_post.DeleteUserResponse.DeleteUserStatus
+	189, // 32: authen_and_post.DeleteUserResponse.deletion_scheduled_at:type_name -> google.protobuf.Timestamp
+	15,  // 33: authen_and_post.CancelUserDeletionResponse.status:type_name -> authen_and_post.CancelUserDeletionResponse.CancelUserDeletionStatus
+	16,  // 34: authen_and_post.DataExportInfo.state:type_name -> authen_and_post.DataExportInfo.DataExportState
+	189, // 35: authen_and_post.DataExportInfo.created_at:type_name -> google.protobuf.Timestamp
+	189, // 36: authen_and_post.DataExportInfo.completed_at:type_name -> google.protobuf.Timestamp
+	189, // 37: authen_and_post.DataExportInfo.download_url_expires_at:type_name -> google.protobuf.Timestamp
+	189, // 38: authen_and_post.DataExportInfo.expires_at:type_name -> google.protobuf.Timestamp
+	17,  // 39: authen_and_post.RequestDataExportResponse.status:type_name -> authen_and_post.RequestDataExportResponse.RequestDataExportStatus
+	91,  // 40: authen_and_post.RequestDataExportResponse.export:type_name -> authen_and_post.DataExportInfo
+	18,  // 41: authen_and_post.GetDataExportResponse.status:type_name -> authen_and_post.GetDataExportResponse.GetDataExportStatus
+	91,  // 42: authen_and_post.GetDataExportResponse.export:type_name -> authen_and_post.DataExportInfo
+	19,  // 43: authen_and_post.SearchUsersResponse.status:type_name -> authen_and_post.SearchUsersResponse.SearchUsersStatus
+	97,  // 44: authen_and_post.SearchUsersResponse.users:type_name -> authen_and_post.UserSearchResult
+	20,  // 45: authen_and_post.GetUserFollowerResponse.status:type_name -> authen_and_post.GetUserFollowerResponse.GetUserFollowerStatus
+	21,  // 46: authen_and_post.GetUserFollowingResponse.status:type_name -> authen_and_post.GetUserFollowingResponse.GetUserFollowingStatus
+	22,  // 47: authen_and_post.FollowUserResponse.status:type_name -> authen_and_post.FollowUserResponse.FollowUserStatus
+	23,  // 48: authen_and_post.UnfollowUserResponse.status:type_name -> authen_and_post.UnfollowUserResponse.UnfollowUserStatus
+	24,  // 49: authen_and_post.GetFollowRequestsResponse.status:type_name -> authen_and_post.GetFollowRequestsResponse.GetFollowRequestsStatus
+	25,  // 50: authen_and_post.ApproveFollowRequestResponse.status:type_name -> authen_and_post.ApproveFollowRequestResponse.ApproveFollowRequestStatus
+	26,  // 51: authen_and_post.RejectFollowRequestResponse.status:type_name -> authen_and_post.RejectFollowRequestResponse.RejectFollowRequestStatus
+	27,  // 52: authen_and_post.GetRelationshipResponse.status:type_name -> authen_and_post.GetRelationshipResponse.GetRelationshipStatus
+	114, // 53: authen_and_post.GetRelationshipResponse.relationships:type_name -> authen_and_post.Relationship
+	28,  // 54: authen_and_post.GetFollowSuggestionsResponse.status:type_name -> authen_and_post.GetFollowSuggestionsResponse.GetFollowSuggestionsStatus
+	189, // 55: authen_and_post.AudienceListInfo.created_at:type_name -> google.protobuf.Timestamp
+	29,  // 56: authen_and_post.CreateAudienceListResponse.status:type_name -> authen_and_post.CreateAudienceListResponse.CreateAudienceListStatus
+	30,  // 57: authen_and_post.DeleteAudienceListResponse.status:type_name -> authen_and_post.DeleteAudienceListResponse.DeleteAudienceListStatus
+	31,  // 58: authen_and_post.GetAudienceListsResponse.status:type_name -> authen_and_post.GetAudienceListsResponse.GetAudienceListsStatus
+	118, // 59: authen_and_post.GetAudienceListsResponse.audience_lists:type_name -> authen_and_post.AudienceListInfo
+	32,  // 60: authen_and_post.GetAudienceListResponse.status:type_name -> authen_and_post.GetAudienceListResponse.GetAudienceListStatus
+	118, // 61: authen_and_post.GetAudienceListResponse.audience_list:type_name -> authen_and_post.AudienceListInfo
+	33,  // 62: authen_and_post.AddAudienceListMemberResponse.status:type_name -> authen_and_post.AddAudienceListMemberResponse.AddAudienceListMemberStatus
+	34,  // 63: authen_and_post.RemoveAudienceListMemberResponse.status:type_name -> authen_and_post.RemoveAudienceListMemberResponse.RemoveAudienceListMemberStatus
+	35,  // 64: authen_and_post.BlockUserResponse.status:type_name -> authen_and_post.BlockUserResponse.BlockUserStatus
+	36,  // 65: authen_and_post.UnblockUserResponse.status:type_name -> authen_and_post.UnblockUserResponse.UnblockUserStatus
+	37,  // 66: authen_and_post.MuteUserResponse.status:type_name -> authen_and_post.MuteUserResponse.MuteUserStatus
+	38,  // 67: authen_and_post.UnmuteUserResponse.status:type_name -> authen_and_post.UnmuteUserResponse.UnmuteUserStatus
+	39,  // 68: authen_and_post.GetUserPostsResponse.status:type_name -> authen_and_post.GetUserPostsResponse.GetUserPostsStatus
+	142, // 69: authen_and_post.CreatePostRequest.poll:type_name -> authen_and_post.NewPoll
+	189, // 70: authen_and_post.NewPoll.closes_at:type_name -> google.protobuf.Timestamp
+	40,  // 71: authen_and_post.CreatePostResponse.status:type_name -> authen_and_post.CreatePostResponse.CreatePostStatus
+	41,  // 72: authen_and_post.GetPostDetailInfoResponse.status:type_name -> authen_and_post.GetPostDetailInfoResponse.GetPostDetailInfoStatus
+	180, // 73: authen_and_post.GetPostDetailInfoResponse.post:type_name -> authen_and_post.PostDetailInfo
+	42,  // 74: authen_and_post.EditPostResponse.status:type_name -> authen_and_post.EditPostResponse.EditPostStatus
+	43,  // 75: authen_and_post.DeletePostResponse.status:type_name -> authen_and_post.DeletePostResponse.DeletePostStatus
+	44,  // 76: authen_and_post.CommentPostResponse.status:type_name -> authen_and_post.CommentPostResponse.CommentPostStatus
+	45,  // 77: authen_and_post.LikePostResponse.status:type_name -> authen_and_post.LikePostResponse.LikePostStatus
+	46,  // 78: authen_and_post.PinPostResponse.status:type_name -> authen_and_post.PinPostResponse.PinPostStatus
+	47,  // 79: authen_and_post.UnpinPostResponse.status:type_name -> authen_and_post.UnpinPostResponse.UnpinPostStatus
+	48,  // 80: authen_and_post.VotePollResponse.status:type_name -> authen_and_post.VotePollResponse.VotePollStatus
+	49,  // 81: authen_and_post.GetPollResultsResponse.status:type_name -> authen_and_post.GetPollResultsResponse.GetPollResultsStatus
+	183, // 82: authen_and_post.GetPollResultsResponse.poll:type_name -> authen_and_post.Poll
+	50,  // 83: authen_and_post.SavePostResponse.status:type_name -> authen_and_post.SavePostResponse.SavePostStatus
+	51,  // 84: authen_and_post.UnsavePostResponse.status:type_name -> authen_and_post.UnsavePostResponse.UnsavePostStatus
+	52,  // 85: authen_and_post.ListSavedPostsResponse.status:type_name -> authen_and_post.ListSavedPostsResponse.ListSavedPostsStatus
+	53,  // 86: authen_and_post.CreateStoryResponse.status:type_name -> authen_and_post.CreateStoryResponse.CreateStoryStatus
+	54,  // 87: authen_and_post.GetStoryResponse.status:type_name -> authen_and_post.GetStoryResponse.GetStoryStatus
+	186, // 88: authen_and_post.GetStoryResponse.story:type_name -> authen_and_post.Story
+	55,  // 89: authen_and_post.DeleteStoryResponse.status:type_name -> authen_and_post.DeleteStoryResponse.DeleteStoryStatus
+	56,  // 90: authen_and_post.GetStoriesTrayResponse.status:type_name -> authen_and_post.GetStoriesTrayResponse.GetStoriesTrayStatus
+	187, // 91: authen_and_post.GetStoriesTrayResponse.items:type_name -> authen_and_post.StoriesTrayItem
+	57,  // 92: authen_and_post.GetStoryViewersResponse.status:type_name -> authen_and_post.GetStoryViewersResponse.GetStoryViewersStatus
+	188, // 93: authen_and_post.GetStoryViewersResponse.viewers:type_name -> authen_and_post.StoryViewer
+	58,  // 94: authen_and_post.GetS3PresignedUrlResponse.status:type_name -> authen_and_post.GetS3PresignedUrlResponse.GetS3PresignedUrlStatus
+	189, // 95: authen_and_post.GetS3PresignedUrlResponse.expiration_time:type_name -> google.protobuf.Timestamp
+	189, // 96: authen_and_post.PostDetailInfo.created_at:type_name -> google.protobuf.Timestamp
+	181, // 97: authen_and_post.PostDetailInfo.comments:type_name -> authen_and_post.Comment
+	182, // 98: authen_and_post.PostDetailInfo.liked_users:type_name -> authen_and_post.Like
+	183, // 99: authen_and_post.PostDetailInfo.poll:type_name -> authen_and_post.Poll
+	185, // 100: authen_and_post.PostDetailInfo.link_preview:type_name -> authen_and_post.LinkPreview
+	189, // 101: authen_and_post.Poll.closes_at:type_name -> google.protobuf.Timestamp
+	184, // 102: authen_and_post.Poll.options:type_name -> authen_and_post.PollOption
+	189, // 103: authen_and_post.Story.created_at:type_name -> google.protobuf.Timestamp
+	189, // 104: authen_and_post.Story.expires_at:type_name -> google.protobuf.Timestamp
+	189, // 105: authen_and_post.StoriesTrayItem.latest_created_at:type_name -> google.protobuf.Timestamp
+	189, // 106: authen_and_post.StoryViewer.viewed_at:type_name -> google.protobuf.Timestamp
+	59,  // 107: authen_and_post.AuthenticateAndPost.CheckUserAuthentication:input_type -> authen_and_post.CheckUserAuthenticationRequest
+	61,  // 108: authen_and_post.AuthenticateAndPost.CreateUser:input_type -> authen_and_post.CreateUserRequest
+	63,  // 109: authen_and_post.AuthenticateAndPost.EditUser:input_type -> authen_and_post.EditUserRequest
+	65,  // 110: authen_and_post.AuthenticateAndPost.GetUserDetailInfo:input_type -> authen_and_post.GetUserDetailInfoRequest
+	69,  // 111: authen_and_post.AuthenticateAndPost.SendVerificationEmail:input_type -> authen_and_post.SendVerificationEmailRequest
+	71,  // 112: authen_and_post.AuthenticateAndPost.VerifyEmail:input_type -> authen_and_post.VerifyEmailRequest
+	73,  // 113: authen_and_post.AuthenticateAndPost.RequestPasswordReset:input_type -> authen_and_post.RequestPasswordResetRequest
+	75,  // 114: authen_and_post.AuthenticateAndPost.ConfirmPasswordReset:input_type -> authen_and_post.ConfirmPasswordResetRequest
+	77,  // 115: authen_and_post.AuthenticateAndPost.EnrollTwoFactor:input_type -> authen_and_post.EnrollTwoFactorRequest
+	79,  // 116: authen_and_post.AuthenticateAndPost.EnableTwoFactor:input_type -> authen_and_post.EnableTwoFactorRequest
+	81,  // 117: authen_and_post.AuthenticateAndPost.DisableTwoFactor:input_type -> authen_and_post.DisableTwoFactorRequest
+	83,  // 118: authen_and_post.AuthenticateAndPost.VerifyTwoFactor:input_type -> authen_and_post.VerifyTwoFactorRequest
+	85,  // 119: authen_and_post.AuthenticateAndPost.RegenerateRecoveryCodes:input_type -> authen_and_post.RegenerateRecoveryCodesRequest
+	87,  // 120: authen_and_post.AuthenticateAndPost.DeleteUser:input_type -> authen_and_post.DeleteUserRequest
+	89,  // 121: authen_and_post.AuthenticateAndPost.CancelUserDeletion:input_type -> authen_and_post.CancelUserDeletionRequest
+	92,  // 122: authen_and_post.AuthenticateAndPost.RequestDataExport:input_type -> authen_and_post.RequestDataExportRequest
+	94,  // 123: authen_and_post.AuthenticateAndPost.GetDataExport:input_type -> authen_and_post.GetDataExportRequest
+	96,  // 124: authen_and_post.AuthenticateAndPost.SearchUsers:input_type -> authen_and_post.SearchUsersRequest
+	99,  // 125: authen_and_post.AuthenticateAndPost.GetUserFollower:input_type -> authen_and_post.GetUserFollowerRequest
+	101, // 126: authen_and_post.AuthenticateAndPost.GetUserFollowing:input_type -> authen_and_post.GetUserFollowingRequest
+	103, // 127: authen_and_post.AuthenticateAndPost.FollowUser:input_type -> authen_and_post.FollowUserRequest
+	105, // 128: authen_and_post.AuthenticateAndPost.UnfollowUser:input_type -> authen_and_post.UnfollowUserRequest
+	139, // 129: authen_and_post.AuthenticateAndPost.GetUserPosts:input_type -> authen_and_post.GetUserPostsRequest
+	131, // 130: authen_and_post.AuthenticateAndPost.BlockUser:input_type -> authen_and_post.BlockUserRequest
+	133, // 131: authen_and_post.AuthenticateAndPost.UnblockUser:input_type -> authen_and_post.UnblockUserRequest
+	107, // 132: authen_and_post.AuthenticateAndPost.GetFollowRequests:input_type -> authen_and_post.GetFollowRequestsRequest
+	109, // 133: authen_and_post.AuthenticateAndPost.ApproveFollowRequest:input_type -> authen_and_post.ApproveFollowRequestRequest
+	111, // 134: authen_and_post.AuthenticateAndPost.RejectFollowRequest:input_type -> authen_and_post.RejectFollowRequestRequest
+	113, // 135: authen_and_post.AuthenticateAndPost.GetRelationship:input_type -> authen_and_post.GetRelationshipRequest
+	116, // 136: authen_and_post.AuthenticateAndPost.GetFollowSuggestions:input_type -> authen_and_post.GetFollowSuggestionsRequest
+	119, // 137: authen_and_post.AuthenticateAndPost.CreateAudienceList:input_type -> authen_and_post.CreateAudienceListRequest
+	121, // 138: authen_and_post.AuthenticateAndPost.DeleteAudienceList:input_type -> authen_and_post.DeleteAudienceListRequest
+	123, // 139: authen_and_post.AuthenticateAndPost.GetAudienceLists:input_type -> authen_and_post.GetAudienceListsRequest
+	125, // 140: authen_and_post.AuthenticateAndPost.GetAudienceList:input_type -> authen_and_post.GetAudienceListRequest
+	127, // 141: authen_and_post.AuthenticateAndPost.AddAudienceListMember:input_type -> authen_and_post.AddAudienceListMemberRequest
+	129, // 142: authen_and_post.AuthenticateAndPost.RemoveAudienceListMember:input_type -> authen_and_post.RemoveAudienceListMemberRequest
+	135, // 143: authen_and_post.AuthenticateAndPost.MuteUser:input_type -> authen_and_post.MuteUserRequest
+	137, // 144: authen_and_post.AuthenticateAndPost.UnmuteUser:input_type -> authen_and_post.UnmuteUserRequest
+	141, // 145: authen_and_post.AuthenticateAndPost.CreatePost:input_type -> authen_and_post.CreatePostRequest
+	144, // 146: authen_and_post.AuthenticateAndPost.GetPostDetailInfo:input_type -> authen_and_post.GetPostDetailInfoRequest
+	146, // 147: authen_and_post.AuthenticateAndPost.EditPost:input_type -> authen_and_post.EditPostRequest
+	148, // 148: authen_and_post.AuthenticateAndPost.DeletePost:input_type -> authen_and_post.DeletePostRequest
+	150, // 149: authen_and_post.AuthenticateAndPost.CommentPost:input_type -> authen_and_post.CommentPostRequest
+	152, // 150: authen_and_post.AuthenticateAndPost.LikePost:input_type -> authen_and_post.LikePostRequest
+	178, // 151: authen_and_post.AuthenticateAndPost.GetS3PresignedUrl:input_type -> authen_and_post.GetS3PresignedUrlRequest
+	154, // 152: authen_and_post.AuthenticateAndPost.PinPost:input_type -> authen_and_post.PinPostRequest
+	156, // 153: authen_and_post.AuthenticateAndPost.UnpinPost:input_type -> authen_and_post.UnpinPostRequest
+	158, // 154: authen_and_post.AuthenticateAndPost.VotePoll:input_type -> authen_and_post.VotePollRequest
+	160, // 155: authen_and_post.AuthenticateAndPost.GetPollResults:input_type -> authen_and_post.GetPollResultsRequest
+	162, // 156: authen_and_post.AuthenticateAndPost.SavePost:input_type -> authen_and_post.SavePostRequest
+	164, // 157: authen_and_post.AuthenticateAndPost.UnsavePost:input_type -> authen_and_post.UnsavePostRequest
+	166, // 158: authen_and_post.AuthenticateAndPost.ListSavedPosts:input_type -> authen_and_post.ListSavedPostsRequest
+	168, // 159: authen_and_post.AuthenticateAndPost.CreateStory:input_type -> authen_and_post.CreateStoryRequest
+	170, // 160: authen_and_post.AuthenticateAndPost.GetStory:input_type -> authen_and_post.GetStoryRequest
+	172, // 161: authen_and_post.AuthenticateAndPost.DeleteStory:input_type -> authen_and_post.DeleteStoryRequest
+	174, // 162: authen_and_post.AuthenticateAndPost.GetStoriesTray:input_type -> authen_and_post.GetStoriesTrayRequest
+	176, // 163: authen_and_post.AuthenticateAndPost.GetStoryViewers:input_type -> authen_and_post.GetStoryViewersRequest
+	60,  // 164: authen_and_post.AuthenticateAndPost.CheckUserAuthentication:output_type -> authen_and_post.CheckUserAuthenticationResponse
+	62,  // 165: authen_and_post.AuthenticateAndPost.CreateUser:output_type -> authen_and_post.CreateUserResponse
+	64,  // 166: authen_and_post.AuthenticateAndPost.EditUser:output_type -> authen_and_post.EditUserResponse
+	66,  // 167: authen_and_post.AuthenticateAndPost.GetUserDetailInfo:output_type -> authen_and_post.GetUserDetailInfoResponse
+	70,  // 168: authen_and_post.AuthenticateAndPost.SendVerificationEmail:output_type -> authen_and_post.SendVerificationEmailResponse
+	72,  // 169: authen_and_post.AuthenticateAndPost.VerifyEmail:output_type -> authen_and_post.VerifyEmailResponse
+	74,  // 170: authen_and_post.AuthenticateAndPost.RequestPasswordReset:output_type -> authen_and_post.RequestPasswordResetResponse
+	76,  // 171: authen_and_post.AuthenticateAndPost.ConfirmPasswordReset:output_type -> authen_and_post.ConfirmPasswordResetResponse
+	78,  // 172: authen_and_post.AuthenticateAndPost.EnrollTwoFactor:output_type -> authen_and_post.EnrollTwoFactorResponse
+	80,  // 173: authen_and_post.AuthenticateAndPost.EnableTwoFactor:output_type -> authen_and_post.EnableTwoFactorResponse
+	82,  // 174: authen_and_post.AuthenticateAndPost.DisableTwoFactor:output_type -> authen_and_post.DisableTwoFactorResponse
+	84,  // 175: authen_and_post.AuthenticateAndPost.VerifyTwoFactor:output_type -> authen_and_post.VerifyTwoFactorResponse
+	86,  // 176: authen_and_post.AuthenticateAndPost.RegenerateRecoveryCodes:output_type -> authen_and_post.RegenerateRecoveryCodesResponse
+	88,  // 177: authen_and_post.AuthenticateAndPost.DeleteUser:output_type -> authen_and_post.DeleteUserResponse
+	90,  // 178: authen_and_post.AuthenticateAndPost.CancelUserDeletion:output_type -> authen_and_post.CancelUserDeletionResponse
+	93,  // 179: authen_and_post.AuthenticateAndPost.RequestDataExport:output_type -> authen_and_post.RequestDataExportResponse
+	95,  // 180: authen_and_post.AuthenticateAndPost.GetDataExport:output_type -> authen_and_post.GetDataExportResponse
+	98,  // 181: authen_and_post.AuthenticateAndPost.SearchUsers:output_type -> authen_and_post.SearchUsersResponse
+	100, // 182: authen_and_post.AuthenticateAndPost.GetUserFollower:output_type -> authen_and_post.GetUserFollowerResponse
+	102, // 183: authen_and_post.AuthenticateAndPost.GetUserFollowing:output_type -> authen_and_post.GetUserFollowingResponse
+	104, // 184: authen_and_post.AuthenticateAndPost.FollowUser:output_type -> authen_and_post.FollowUserResponse
+	106, // 185: authen_and_post.AuthenticateAndPost.UnfollowUser:output_type -> authen_and_post.UnfollowUserResponse
+	140, // 186: authen_and_post.AuthenticateAndPost.GetUserPosts:output_type -> authen_and_post.GetUserPostsResponse
+	132, // 187: authen_and_post.AuthenticateAndPost.BlockUser:output_type -> authen_and_post.BlockUserResponse
+	134, // 188: authen_and_post.AuthenticateAndPost.UnblockUser:output_type -> authen_and_post.UnblockUserResponse
+	108, // 189: authen_and_post.AuthenticateAndPost.GetFollowRequests:output_type -> authen_and_post.GetFollowRequestsResponse
+	110, // 190: authen_and_post.AuthenticateAndPost.ApproveFollowRequest:output_type -> authen_and_post.ApproveFollowRequestResponse
+	112, // 191: authen_and_post.AuthenticateAndPost.RejectFollowRequest:output_type -> authen_and_post.RejectFollowRequestResponse
+	115, // 192: authen_and_post.AuthenticateAndPost.GetRelationship:output_type -> authen_and_post.GetRelationshipResponse
+	117, // 193: authen_and_post.AuthenticateAndPost.GetFollowSuggestions:output_type -> authen_and_post.GetFollowSuggestionsResponse
+	120, // 194: authen_and_post.AuthenticateAndPost.CreateAudienceList:output_type -> authen_and_post.CreateAudienceListResponse
+	122, // 195: authen_and_post.AuthenticateAndPost.DeleteAudienceList:output_type -> authen_and_post.DeleteAudienceListResponse
+	124, // 196: authen_and_post.AuthenticateAndPost.GetAudienceLists:output_type -> authen_and_post.GetAudienceListsResponse
+	126, // 197: authen_and_post.AuthenticateAndPost.GetAudienceList:output_type -> authen_and_post.GetAudienceListResponse
+	128, // 198: authen_and_post.AuthenticateAndPost.AddAudienceListMember:output_type -> authen_and_post.AddAudienceListMemberResponse
+	130, // 199: authen_and_post.AuthenticateAndPost.RemoveAudienceListMember:output_type -> authen_and_post.RemoveAudienceListMemberResponse
+	136, // 200: authen_and_post.AuthenticateAndPost.MuteUser:output_type -> authen_and_post.MuteUserResponse
+	138, // 201: authen_and_post.AuthenticateAndPost.UnmuteUser:output_type -> authen_and_post.UnmuteUserResponse
+	143, // 202: authen_and_post.AuthenticateAndPost.CreatePost:output_type -> authen_and_post.CreatePostResponse
+	145, // 203: authen_and_post.AuthenticateAndPost.GetPostDetailInfo:output_type -> authen_and_post.GetPostDetailInfoResponse
+	147, // 204: authen_and_post.AuthenticateAndPost.EditPost:output_type -> authen_and_post.EditPostResponse
+	149, // 205: authen_and_post.AuthenticateAndPost.DeletePost:output_type -> authen_and_post.DeletePostResponse
+	151, // 206: authen_and_post.AuthenticateAndPost.CommentPost:output_type -> authen_and_post.CommentPostResponse
+	153, // 207: authen_and_post.AuthenticateAndPost.LikePost:output_type -> authen_and_post.LikePostResponse
+	179, // 208: authen_and_post.AuthenticateAndPost.GetS3PresignedUrl:output_type -> authen_and_post.GetS3PresignedUrlResponse
+	155, // 209: authen_and_post.AuthenticateAndPost.PinPost:output_type -> authen_and_post.PinPostResponse
+	157, // 210: authen_and_post.AuthenticateAndPost.UnpinPost:output_type -> authen_and_post.UnpinPostResponse
+	159, // 211: authen_and_post.AuthenticateAndPost.VotePoll:output_type -> authen_and_post.VotePollResponse
+	161, // 212: authen_and_post.AuthenticateAndPost.GetPollResults:output_type -> authen_and_post.GetPollResultsResponse
+	163, // 213: authen_and_post.AuthenticateAndPost.SavePost:output_type -> authen_and_post.SavePostResponse
+	165, // 214: authen_and_post.AuthenticateAndPost.UnsavePost:output_type -> authen_and_post.UnsavePostResponse
+	167, // 215: authen_and_post.AuthenticateAndPost.ListSavedPosts:output_type -> authen_and_post.ListSavedPostsResponse
+	169, // 216: authen_and_post.AuthenticateAndPost.CreateStory:output_type -> authen_and_post.CreateStoryResponse
+	171, // 217: authen_and_post.AuthenticateAndPost.GetStory:output_type -> authen_and_post.GetStoryResponse
+	173, // 218: authen_and_post.AuthenticateAndPost.DeleteStory:output_type -> authen_and_post.DeleteStoryResponse
+	175, // 219: authen_and_post.AuthenticateAndPost.GetStoriesTray:output_type -> authen_and_post.GetStoriesTrayResponse
+	177, // 220: authen_and_post.AuthenticateAndPost.GetStoryViewers:output_type -> authen_and_post.GetStoryViewersResponse
+	164, // [164:221] is the sub-list for method output_type
+	107, // [107:164] is the sub-list for method input_type
+	107, // [107:107] is the sub-list for extension type_name
+	107, // [107:107] is the sub-list for extension extendee
+	0,   // [0:107] is the sub-list for field type_name
+}
+
+func init() { file_authen_and_post_proto_init() }
+func file_authen_and_post_proto_init() {
+	if File_authen_and_post_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_authen_and_post_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckUserAuthenticationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckUserAuthenticationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserDetailInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserDetailInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserDetailInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileVisibility); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendVerificationEmailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendVerificationEmailResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyEmailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyEmailResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPasswordResetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPasswordResetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmPasswordResetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmPasswordResetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollTwoFactorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollTwoFactorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableTwoFactorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableTwoFactorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableTwoFactorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableTwoFactorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyTwoFactorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyTwoFactorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegenerateRecoveryCodesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegenerateRecoveryCodesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelUserDeletionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelUserDeletionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataExportInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestDataExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestDataExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserFollowerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserFollowerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserFollowingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserFollowingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfollowUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfollowUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFollowRequestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFollowRequestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveFollowRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveFollowRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectFollowRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectFollowRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRelationshipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Relationship); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRelationshipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFollowSuggestionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFollowSuggestionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AudienceListInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAudienceListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAudienceListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAudienceListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAudienceListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAudienceListsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAudienceListsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAudienceListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAudienceListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAudienceListMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAudienceListMemberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAudienceListMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAudienceListMemberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuteUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuteUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3506,8 +13914,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckUserAuthenticationResponse); i {
+		file_authen_and_post_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnmuteUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3518,8 +13926,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserRequest); i {
+		file_authen_and_post_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnmuteUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3530,8 +13938,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserResponse); i {
+		file_authen_and_post_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserPostsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3542,8 +13950,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditUserRequest); i {
+		file_authen_and_post_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserPostsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3554,8 +13962,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditUserResponse); i {
+		file_authen_and_post_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3566,8 +13974,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserDetailInfoRequest); i {
+		file_authen_and_post_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewPoll); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3578,8 +13986,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserDetailInfoResponse); i {
+		file_authen_and_post_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3590,8 +13998,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserDetailInfo); i {
+		file_authen_and_post_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostDetailInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3602,8 +14010,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserFollowerRequest); i {
+		file_authen_and_post_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostDetailInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3614,8 +14022,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserFollowerResponse); i {
+		file_authen_and_post_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditPostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3626,8 +14034,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserFollowingRequest); i {
+		file_authen_and_post_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditPostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3638,8 +14046,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserFollowingResponse); i {
+		file_authen_and_post_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3650,8 +14058,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FollowUserRequest); i {
+		file_authen_and_post_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3662,8 +14070,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FollowUserResponse); i {
+		file_authen_and_post_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentPostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3674,8 +14082,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnfollowUserRequest); i {
+		file_authen_and_post_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentPostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3686,8 +14094,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnfollowUserResponse); i {
+		file_authen_and_post_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikePostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3698,8 +14106,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserPostsRequest); i {
+		file_authen_and_post_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikePostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3710,8 +14118,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserPostsResponse); i {
+		file_authen_and_post_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinPostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3722,8 +14130,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePostRequest); i {
+		file_authen_and_post_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinPostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3734,8 +14142,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePostResponse); i {
+		file_authen_and_post_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinPostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3746,8 +14154,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPostDetailInfoRequest); i {
+		file_authen_and_post_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinPostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3758,8 +14166,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPostDetailInfoResponse); i {
+		file_authen_and_post_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotePollRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3770,8 +14178,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditPostRequest); i {
+		file_authen_and_post_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotePollResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3782,8 +14190,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditPostResponse); i {
+		file_authen_and_post_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPollResultsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3794,8 +14202,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePostRequest); i {
+		file_authen_and_post_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPollResultsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3806,8 +14214,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePostResponse); i {
+		file_authen_and_post_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavePostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3818,8 +14226,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommentPostRequest); i {
+		file_authen_and_post_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavePostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3830,8 +14238,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommentPostResponse); i {
+		file_authen_and_post_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsavePostRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3842,8 +14250,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikePostRequest); i {
+		file_authen_and_post_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsavePostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3854,8 +14262,8 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LikePostResponse); i {
+		file_authen_and_post_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSavedPostsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3866,7 +14274,139 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_authen_and_post_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSavedPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStoriesTrayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStoriesTrayResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStoryViewersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStoryViewersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetS3PresignedUrlRequest); i {
 			case 0:
 				return &v.state
@@ -3878,7 +14418,7 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_authen_and_post_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetS3PresignedUrlResponse); i {
 			case 0:
 				return &v.state
@@ -3890,7 +14430,7 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_authen_and_post_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PostDetailInfo); i {
 			case 0:
 				return &v.state
@@ -3902,7 +14442,7 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_authen_and_post_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Comment); i {
 			case 0:
 				return &v.state
@@ -3914,7 +14454,7 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
-		file_authen_and_post_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_authen_and_post_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Like); i {
 			case 0:
 				return &v.state
@@ -3926,16 +14466,90 @@ func file_authen_and_post_proto_init() {
 				return nil
 			}
 		}
+		file_authen_and_post_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Poll); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollOption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkPreview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Story); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoriesTrayItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authen_and_post_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoryViewer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_authen_and_post_proto_msgTypes[4].OneofWrappers = []interface{}{}
-	file_authen_and_post_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_authen_and_post_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_authen_and_post_proto_msgTypes[82].OneofWrappers = []interface{}{}
+	file_authen_and_post_proto_msgTypes[87].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_authen_and_post_proto_rawDesc,
-			NumEnums:      16,
-			NumMessages:   36,
+			NumEnums:      59,
+			NumMessages:   130,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,22 +19,63 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AuthenticateAndPost_CheckUserAuthentication_FullMethodName = "/authen_and_post.AuthenticateAndPost/CheckUserAuthentication"
-	AuthenticateAndPost_CreateUser_FullMethodName              = "/authen_and_post.AuthenticateAndPost/CreateUser"
-	AuthenticateAndPost_EditUser_FullMethodName                = "/authen_and_post.AuthenticateAndPost/EditUser"
-	AuthenticateAndPost_GetUserDetailInfo_FullMethodName       = "/authen_and_post.AuthenticateAndPost/GetUserDetailInfo"
-	AuthenticateAndPost_GetUserFollower_FullMethodName         = "/authen_and_post.AuthenticateAndPost/GetUserFollower"
-	AuthenticateAndPost_GetUserFollowing_FullMethodName        = "/authen_and_post.AuthenticateAndPost/GetUserFollowing"
-	AuthenticateAndPost_FollowUser_FullMethodName              = "/authen_and_post.AuthenticateAndPost/FollowUser"
-	AuthenticateAndPost_UnfollowUser_FullMethodName            = "/authen_and_post.AuthenticateAndPost/UnfollowUser"
-	AuthenticateAndPost_GetUserPosts_FullMethodName            = "/authen_and_post.AuthenticateAndPost/GetUserPosts"
-	AuthenticateAndPost_CreatePost_FullMethodName              = "/authen_and_post.AuthenticateAndPost/CreatePost"
-	AuthenticateAndPost_GetPostDetailInfo_FullMethodName       = "/authen_and_post.AuthenticateAndPost/GetPostDetailInfo"
-	AuthenticateAndPost_EditPost_FullMethodName                = "/authen_and_post.AuthenticateAndPost/EditPost"
-	AuthenticateAndPost_DeletePost_FullMethodName              = "/authen_and_post.AuthenticateAndPost/DeletePost"
-	AuthenticateAndPost_CommentPost_FullMethodName             = "/authen_and_post.AuthenticateAndPost/CommentPost"
-	AuthenticateAndPost_LikePost_FullMethodName                = "/authen_and_post.AuthenticateAndPost/LikePost"
-	AuthenticateAndPost_GetS3PresignedUrl_FullMethodName       = "/authen_and_post.AuthenticateAndPost/GetS3PresignedUrl"
+	AuthenticateAndPost_CheckUserAuthentication_FullMethodName  = "/authen_and_post.AuthenticateAndPost/CheckUserAuthentication"
+	AuthenticateAndPost_CreateUser_FullMethodName               = "/authen_and_post.AuthenticateAndPost/CreateUser"
+	AuthenticateAndPost_EditUser_FullMethodName                 = "/authen_and_post.AuthenticateAndPost/EditUser"
+	AuthenticateAndPost_GetUserDetailInfo_FullMethodName        = "/authen_and_post.AuthenticateAndPost/GetUserDetailInfo"
+	AuthenticateAndPost_SendVerificationEmail_FullMethodName    = "/authen_and_post.AuthenticateAndPost/SendVerificationEmail"
+	AuthenticateAndPost_VerifyEmail_FullMethodName              = "/authen_and_post.AuthenticateAndPost/VerifyEmail"
+	AuthenticateAndPost_RequestPasswordReset_FullMethodName     = "/authen_and_post.AuthenticateAndPost/RequestPasswordReset"
+	AuthenticateAndPost_ConfirmPasswordReset_FullMethodName     = "/authen_and_post.AuthenticateAndPost/ConfirmPasswordReset"
+	AuthenticateAndPost_EnrollTwoFactor_FullMethodName          = "/authen_and_post.AuthenticateAndPost/EnrollTwoFactor"
+	AuthenticateAndPost_EnableTwoFactor_FullMethodName          = "/authen_and_post.AuthenticateAndPost/EnableTwoFactor"
+	AuthenticateAndPost_DisableTwoFactor_FullMethodName         = "/authen_and_post.AuthenticateAndPost/DisableTwoFactor"
+	AuthenticateAndPost_VerifyTwoFactor_FullMethodName          = "/authen_and_post.AuthenticateAndPost/VerifyTwoFactor"
+	AuthenticateAndPost_RegenerateRecoveryCodes_FullMethodName  = "/authen_and_post.AuthenticateAndPost/RegenerateRecoveryCodes"
+	AuthenticateAndPost_DeleteUser_FullMethodName               = "/authen_and_post.AuthenticateAndPost/DeleteUser"
+	AuthenticateAndPost_CancelUserDeletion_FullMethodName       = "/authen_and_post.AuthenticateAndPost/CancelUserDeletion"
+	AuthenticateAndPost_RequestDataExport_FullMethodName        = "/authen_and_post.AuthenticateAndPost/RequestDataExport"
+	AuthenticateAndPost_GetDataExport_FullMethodName            = "/authen_and_post.AuthenticateAndPost/GetDataExport"
+	AuthenticateAndPost_SearchUsers_FullMethodName              = "/authen_and_post.AuthenticateAndPost/SearchUsers"
+	AuthenticateAndPost_GetUserFollower_FullMethodName          = "/authen_and_post.AuthenticateAndPost/GetUserFollower"
+	AuthenticateAndPost_GetUserFollowing_FullMethodName         = "/authen_and_post.AuthenticateAndPost/GetUserFollowing"
+	AuthenticateAndPost_FollowUser_FullMethodName               = "/authen_and_post.AuthenticateAndPost/FollowUser"
+	AuthenticateAndPost_UnfollowUser_FullMethodName             = "/authen_and_post.AuthenticateAndPost/UnfollowUser"
+	AuthenticateAndPost_GetUserPosts_FullMethodName             = "/authen_and_post.AuthenticateAndPost/GetUserPosts"
+	AuthenticateAndPost_BlockUser_FullMethodName                = "/authen_and_post.AuthenticateAndPost/BlockUser"
+	AuthenticateAndPost_UnblockUser_FullMethodName              = "/authen_and_post.AuthenticateAndPost/UnblockUser"
+	AuthenticateAndPost_GetFollowRequests_FullMethodName        = "/authen_and_post.AuthenticateAndPost/GetFollowRequests"
+	AuthenticateAndPost_ApproveFollowRequest_FullMethodName     = "/authen_and_post.AuthenticateAndPost/ApproveFollowRequest"
+	AuthenticateAndPost_RejectFollowRequest_FullMethodName      = "/authen_and_post.AuthenticateAndPost/RejectFollowRequest"
+	AuthenticateAndPost_GetRelationship_FullMethodName          = "/authen_and_post.AuthenticateAndPost/GetRelationship"
+	AuthenticateAndPost_GetFollowSuggestions_FullMethodName     = "/authen_and_post.AuthenticateAndPost/GetFollowSuggestions"
+	AuthenticateAndPost_CreateAudienceList_FullMethodName       = "/authen_and_post.AuthenticateAndPost/CreateAudienceList"
+	AuthenticateAndPost_DeleteAudienceList_FullMethodName       = "/authen_and_post.AuthenticateAndPost/DeleteAudienceList"
+	AuthenticateAndPost_GetAudienceLists_FullMethodName         = "/authen_and_post.AuthenticateAndPost/GetAudienceLists"
+	AuthenticateAndPost_GetAudienceList_FullMethodName          = "/authen_and_post.AuthenticateAndPost/GetAudienceList"
+	AuthenticateAndPost_AddAudienceListMember_FullMethodName    = "/authen_and_post.AuthenticateAndPost/AddAudienceListMember"
+	AuthenticateAndPost_RemoveAudienceListMember_FullMethodName = "/authen_and_post.AuthenticateAndPost/RemoveAudienceListMember"
+	AuthenticateAndPost_MuteUser_FullMethodName                 = "/authen_and_post.AuthenticateAndPost/MuteUser"
+	AuthenticateAndPost_UnmuteUser_FullMethodName               = "/authen_and_post.AuthenticateAndPost/UnmuteUser"
+	AuthenticateAndPost_CreatePost_FullMethodName               = "/authen_and_post.AuthenticateAndPost/CreatePost"
+	AuthenticateAndPost_GetPostDetailInfo_FullMethodName        = "/authen_and_post.AuthenticateAndPost/GetPostDetailInfo"
+	AuthenticateAndPost_EditPost_FullMethodName                 = "/authen_and_post.AuthenticateAndPost/EditPost"
+	AuthenticateAndPost_DeletePost_FullMethodName               = "/authen_and_post.AuthenticateAndPost/DeletePost"
+	AuthenticateAndPost_CommentPost_FullMethodName              = "/authen_and_post.AuthenticateAndPost/CommentPost"
+	AuthenticateAndPost_LikePost_FullMethodName                 = "/authen_and_post.AuthenticateAndPost/LikePost"
+	AuthenticateAndPost_GetS3PresignedUrl_FullMethodName        = "/authen_and_post.AuthenticateAndPost/GetS3PresignedUrl"
+	AuthenticateAndPost_PinPost_FullMethodName                  = "/authen_and_post.AuthenticateAndPost/PinPost"
+	AuthenticateAndPost_UnpinPost_FullMethodName                = "/authen_and_post.AuthenticateAndPost/UnpinPost"
+	AuthenticateAndPost_VotePoll_FullMethodName                 = "/authen_and_post.AuthenticateAndPost/VotePoll"
+	AuthenticateAndPost_GetPollResults_FullMethodName           = "/authen_and_post.AuthenticateAndPost/GetPollResults"
+	AuthenticateAndPost_SavePost_FullMethodName                 = "/authen_and_post.AuthenticateAndPost/SavePost"
+	AuthenticateAndPost_UnsavePost_FullMethodName               = "/authen_and_post.AuthenticateAndPost/UnsavePost"
+	AuthenticateAndPost_ListSavedPosts_FullMethodName           = "/authen_and_post.AuthenticateAndPost/ListSavedPosts"
+	AuthenticateAndPost_CreateStory_FullMethodName              = "/authen_and_post.AuthenticateAndPost/CreateStory"
+	AuthenticateAndPost_GetStory_FullMethodName                 = "/authen_and_post.AuthenticateAndPost/GetStory"
+	AuthenticateAndPost_DeleteStory_FullMethodName              = "/authen_and_post.AuthenticateAndPost/DeleteStory"
+	AuthenticateAndPost_GetStoriesTray_FullMethodName           = "/authen_and_post.AuthenticateAndPost/GetStoriesTray"
+	AuthenticateAndPost_GetStoryViewers_FullMethodName          = "/authen_and_post.AuthenticateAndPost/GetStoryViewers"
 )
 
 // AuthenticateAndPostClient is the client API for AuthenticateAndPost service.
@@ -46,12 +87,41 @@ type AuthenticateAndPostClient interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	EditUser(ctx context.Context, in *EditUserRequest, opts ...grpc.CallOption) (*EditUserResponse, error)
 	GetUserDetailInfo(ctx context.Context, in *GetUserDetailInfoRequest, opts ...grpc.CallOption) (*GetUserDetailInfoResponse, error)
+	SendVerificationEmail(ctx context.Context, in *SendVerificationEmailRequest, opts ...grpc.CallOption) (*SendVerificationEmailResponse, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)
+	ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*ConfirmPasswordResetResponse, error)
+	EnrollTwoFactor(ctx context.Context, in *EnrollTwoFactorRequest, opts ...grpc.CallOption) (*EnrollTwoFactorResponse, error)
+	EnableTwoFactor(ctx context.Context, in *EnableTwoFactorRequest, opts ...grpc.CallOption) (*EnableTwoFactorResponse, error)
+	DisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error)
+	VerifyTwoFactor(ctx context.Context, in *VerifyTwoFactorRequest, opts ...grpc.CallOption) (*VerifyTwoFactorResponse, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	CancelUserDeletion(ctx context.Context, in *CancelUserDeletionRequest, opts ...grpc.CallOption) (*CancelUserDeletionResponse, error)
+	RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error)
+	GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*GetDataExportResponse, error)
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
 	// Group: friends
 	GetUserFollower(ctx context.Context, in *GetUserFollowerRequest, opts ...grpc.CallOption) (*GetUserFollowerResponse, error)
 	GetUserFollowing(ctx context.Context, in *GetUserFollowingRequest, opts ...grpc.CallOption) (*GetUserFollowingResponse, error)
 	FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserResponse, error)
 	UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*UnfollowUserResponse, error)
 	GetUserPosts(ctx context.Context, in *GetUserPostsRequest, opts ...grpc.CallOption) (*GetUserPostsResponse, error)
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
+	GetFollowRequests(ctx context.Context, in *GetFollowRequestsRequest, opts ...grpc.CallOption) (*GetFollowRequestsResponse, error)
+	ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*ApproveFollowRequestResponse, error)
+	RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*RejectFollowRequestResponse, error)
+	GetRelationship(ctx context.Context, in *GetRelationshipRequest, opts ...grpc.CallOption) (*GetRelationshipResponse, error)
+	GetFollowSuggestions(ctx context.Context, in *GetFollowSuggestionsRequest, opts ...grpc.CallOption) (*GetFollowSuggestionsResponse, error)
+	CreateAudienceList(ctx context.Context, in *CreateAudienceListRequest, opts ...grpc.CallOption) (*CreateAudienceListResponse, error)
+	DeleteAudienceList(ctx context.Context, in *DeleteAudienceListRequest, opts ...grpc.CallOption) (*DeleteAudienceListResponse, error)
+	GetAudienceLists(ctx context.Context, in *GetAudienceListsRequest, opts ...grpc.CallOption) (*GetAudienceListsResponse, error)
+	GetAudienceList(ctx context.Context, in *GetAudienceListRequest, opts ...grpc.CallOption) (*GetAudienceListResponse, error)
+	AddAudienceListMember(ctx context.Context, in *AddAudienceListMemberRequest, opts ...grpc.CallOption) (*AddAudienceListMemberResponse, error)
+	RemoveAudienceListMember(ctx context.Context, in *RemoveAudienceListMemberRequest, opts ...grpc.CallOption) (*RemoveAudienceListMemberResponse, error)
+	MuteUser(ctx context.Context, in *MuteUserRequest, opts ...grpc.CallOption) (*MuteUserResponse, error)
+	UnmuteUser(ctx context.Context, in *UnmuteUserRequest, opts ...grpc.CallOption) (*UnmuteUserResponse, error)
 	// Group: posts
 	CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error)
 	GetPostDetailInfo(ctx context.Context, in *GetPostDetailInfoRequest, opts ...grpc.CallOption) (*GetPostDetailInfoResponse, error)
@@ -60,6 +130,19 @@ type AuthenticateAndPostClient interface {
 	CommentPost(ctx context.Context, in *CommentPostRequest, opts ...grpc.CallOption) (*CommentPostResponse, error)
 	LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error)
 	GetS3PresignedUrl(ctx context.Context, in *GetS3PresignedUrlRequest, opts ...grpc.CallOption) (*GetS3PresignedUrlResponse, error)
+	PinPost(ctx context.Context, in *PinPostRequest, opts ...grpc.CallOption) (*PinPostResponse, error)
+	UnpinPost(ctx context.Context, in *UnpinPostRequest, opts ...grpc.CallOption) (*UnpinPostResponse, error)
+	VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error)
+	GetPollResults(ctx context.Context, in *GetPollResultsRequest, opts ...grpc.CallOption) (*GetPollResultsResponse, error)
+	SavePost(ctx context.Context, in *SavePostRequest, opts ...grpc.CallOption) (*SavePostResponse, error)
+	UnsavePost(ctx context.Context, in *UnsavePostRequest, opts ...grpc.CallOption) (*UnsavePostResponse, error)
+	ListSavedPosts(ctx context.Context, in *ListSavedPostsRequest, opts ...grpc.CallOption) (*ListSavedPostsResponse, error)
+	// Group: stories
+	CreateStory(ctx context.Context, in *CreateStoryRequest, opts ...grpc.CallOption) (*CreateStoryResponse, error)
+	GetStory(ctx context.Context, in *GetStoryRequest, opts ...grpc.CallOption) (*GetStoryResponse, error)
+	DeleteStory(ctx context.Context, in *DeleteStoryRequest, opts ...grpc.CallOption) (*DeleteStoryResponse, error)
+	GetStoriesTray(ctx context.Context, in *GetStoriesTrayRequest, opts ...grpc.CallOption) (*GetStoriesTrayResponse, error)
+	GetStoryViewers(ctx context.Context, in *GetStoryViewersRequest, opts ...grpc.CallOption) (*GetStoryViewersResponse, error)
 }
 
 type authenticateAndPostClient struct {
@@ -106,6 +189,132 @@ func (c *authenticateAndPostClient) GetUserDetailInfo(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *authenticateAndPostClient) SendVerificationEmail(ctx context.Context, in *SendVerificationEmailRequest, opts ...grpc.CallOption) (*SendVerificationEmailResponse, error) {
+	out := new(SendVerificationEmailResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_SendVerificationEmail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
+	out := new(VerifyEmailResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_VerifyEmail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	out := new(RequestPasswordResetResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_RequestPasswordReset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*ConfirmPasswordResetResponse, error) {
+	out := new(ConfirmPasswordResetResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_ConfirmPasswordReset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) EnrollTwoFactor(ctx context.Context, in *EnrollTwoFactorRequest, opts ...grpc.CallOption) (*EnrollTwoFactorResponse, error) {
+	out := new(EnrollTwoFactorResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_EnrollTwoFactor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) EnableTwoFactor(ctx context.Context, in *EnableTwoFactorRequest, opts ...grpc.CallOption) (*EnableTwoFactorResponse, error) {
+	out := new(EnableTwoFactorResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_EnableTwoFactor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) DisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error) {
+	out := new(DisableTwoFactorResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_DisableTwoFactor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) VerifyTwoFactor(ctx context.Context, in *VerifyTwoFactorRequest, opts ...grpc.CallOption) (*VerifyTwoFactorResponse, error) {
+	out := new(VerifyTwoFactorResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_VerifyTwoFactor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResponse, error) {
+	out := new(RegenerateRecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_RegenerateRecoveryCodes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_DeleteUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) CancelUserDeletion(ctx context.Context, in *CancelUserDeletionRequest, opts ...grpc.CallOption) (*CancelUserDeletionResponse, error) {
+	out := new(CancelUserDeletionResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_CancelUserDeletion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error) {
+	out := new(RequestDataExportResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_RequestDataExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*GetDataExportResponse, error) {
+	out := new(GetDataExportResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_GetDataExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_SearchUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authenticateAndPostClient) GetUserFollower(ctx context.Context, in *GetUserFollowerRequest, opts ...grpc.CallOption) (*GetUserFollowerResponse, error) {
 	out := new(GetUserFollowerResponse)
 	err := c.cc.Invoke(ctx, AuthenticateAndPost_GetUserFollower_FullMethodName, in, out, opts...)
@@ -151,6 +360,141 @@ func (c *authenticateAndPostClient) GetUserPosts(ctx context.Context, in *GetUse
 	return out, nil
 }
 
+func (c *authenticateAndPostClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	out := new(BlockUserResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_BlockUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error) {
+	out := new(UnblockUserResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_UnblockUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetFollowRequests(ctx context.Context, in *GetFollowRequestsRequest, opts ...grpc.CallOption) (*GetFollowRequestsResponse, error) {
+	out := new(GetFollowRequestsResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_GetFollowRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*ApproveFollowRequestResponse, error) {
+	out := new(ApproveFollowRequestResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_ApproveFollowRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*RejectFollowRequestResponse, error) {
+	out := new(RejectFollowRequestResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_RejectFollowRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetRelationship(ctx context.Context, in *GetRelationshipRequest, opts ...grpc.CallOption) (*GetRelationshipResponse, error) {
+	out := new(GetRelationshipResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_GetRelationship_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetFollowSuggestions(ctx context.Context, in *GetFollowSuggestionsRequest, opts ...grpc.CallOption) (*GetFollowSuggestionsResponse, error) {
+	out := new(GetFollowSuggestionsResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_GetFollowSuggestions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) CreateAudienceList(ctx context.Context, in *CreateAudienceListRequest, opts ...grpc.CallOption) (*CreateAudienceListResponse, error) {
+	out := new(CreateAudienceListResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_CreateAudienceList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) DeleteAudienceList(ctx context.Context, in *DeleteAudienceListRequest, opts ...grpc.CallOption) (*DeleteAudienceListResponse, error) {
+	out := new(DeleteAudienceListResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_DeleteAudienceList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetAudienceLists(ctx context.Context, in *GetAudienceListsRequest, opts ...grpc.CallOption) (*GetAudienceListsResponse, error) {
+	out := new(GetAudienceListsResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_GetAudienceLists_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetAudienceList(ctx context.Context, in *GetAudienceListRequest, opts ...grpc.CallOption) (*GetAudienceListResponse, error) {
+	out := new(GetAudienceListResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_GetAudienceList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) AddAudienceListMember(ctx context.Context, in *AddAudienceListMemberRequest, opts ...grpc.CallOption) (*AddAudienceListMemberResponse, error) {
+	out := new(AddAudienceListMemberResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_AddAudienceListMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) RemoveAudienceListMember(ctx context.Context, in *RemoveAudienceListMemberRequest, opts ...grpc.CallOption) (*RemoveAudienceListMemberResponse, error) {
+	out := new(RemoveAudienceListMemberResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_RemoveAudienceListMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) MuteUser(ctx context.Context, in *MuteUserRequest, opts ...grpc.CallOption) (*MuteUserResponse, error) {
+	out := new(MuteUserResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_MuteUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) UnmuteUser(ctx context.Context, in *UnmuteUserRequest, opts ...grpc.CallOption) (*UnmuteUserResponse, error) {
+	out := new(UnmuteUserResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_UnmuteUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authenticateAndPostClient) CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error) {
 	out := new(CreatePostResponse)
 	err := c.cc.Invoke(ctx, AuthenticateAndPost_CreatePost_FullMethodName, in, out, opts...)
@@ -214,6 +558,114 @@ func (c *authenticateAndPostClient) GetS3PresignedUrl(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *authenticateAndPostClient) PinPost(ctx context.Context, in *PinPostRequest, opts ...grpc.CallOption) (*PinPostResponse, error) {
+	out := new(PinPostResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_PinPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) UnpinPost(ctx context.Context, in *UnpinPostRequest, opts ...grpc.CallOption) (*UnpinPostResponse, error) {
+	out := new(UnpinPostResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_UnpinPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error) {
+	out := new(VotePollResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_VotePoll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetPollResults(ctx context.Context, in *GetPollResultsRequest, opts ...grpc.CallOption) (*GetPollResultsResponse, error) {
+	out := new(GetPollResultsResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_GetPollResults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) SavePost(ctx context.Context, in *SavePostRequest, opts ...grpc.CallOption) (*SavePostResponse, error) {
+	out := new(SavePostResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_SavePost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) UnsavePost(ctx context.Context, in *UnsavePostRequest, opts ...grpc.CallOption) (*UnsavePostResponse, error) {
+	out := new(UnsavePostResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_UnsavePost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) ListSavedPosts(ctx context.Context, in *ListSavedPostsRequest, opts ...grpc.CallOption) (*ListSavedPostsResponse, error) {
+	out := new(ListSavedPostsResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_ListSavedPosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) CreateStory(ctx context.Context, in *CreateStoryRequest, opts ...grpc.CallOption) (*CreateStoryResponse, error) {
+	out := new(CreateStoryResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_CreateStory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetStory(ctx context.Context, in *GetStoryRequest, opts ...grpc.CallOption) (*GetStoryResponse, error) {
+	out := new(GetStoryResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_GetStory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) DeleteStory(ctx context.Context, in *DeleteStoryRequest, opts ...grpc.CallOption) (*DeleteStoryResponse, error) {
+	out := new(DeleteStoryResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_DeleteStory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetStoriesTray(ctx context.Context, in *GetStoriesTrayRequest, opts ...grpc.CallOption) (*GetStoriesTrayResponse, error) {
+	out := new(GetStoriesTrayResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_GetStoriesTray_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticateAndPostClient) GetStoryViewers(ctx context.Context, in *GetStoryViewersRequest, opts ...grpc.CallOption) (*GetStoryViewersResponse, error) {
+	out := new(GetStoryViewersResponse)
+	err := c.cc.Invoke(ctx, AuthenticateAndPost_GetStoryViewers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthenticateAndPostServer is the server API for AuthenticateAndPost service.
 // All implementations must embed UnimplementedAuthenticateAndPostServer
 // for forward compatibility
@@ -223,12 +675,41 @@ type AuthenticateAndPostServer interface {
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	EditUser(context.Context, *EditUserRequest) (*EditUserResponse, error)
 	GetUserDetailInfo(context.Context, *GetUserDetailInfoRequest) (*GetUserDetailInfoResponse, error)
+	SendVerificationEmail(context.Context, *SendVerificationEmailRequest) (*SendVerificationEmailResponse, error)
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*ConfirmPasswordResetResponse, error)
+	EnrollTwoFactor(context.Context, *EnrollTwoFactorRequest) (*EnrollTwoFactorResponse, error)
+	EnableTwoFactor(context.Context, *EnableTwoFactorRequest) (*EnableTwoFactorResponse, error)
+	DisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorResponse, error)
+	VerifyTwoFactor(context.Context, *VerifyTwoFactorRequest) (*VerifyTwoFactorResponse, error)
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*RegenerateRecoveryCodesResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	CancelUserDeletion(context.Context, *CancelUserDeletionRequest) (*CancelUserDeletionResponse, error)
+	RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error)
+	GetDataExport(context.Context, *GetDataExportRequest) (*GetDataExportResponse, error)
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
 	// Group: friends
 	GetUserFollower(context.Context, *GetUserFollowerRequest) (*GetUserFollowerResponse, error)
 	GetUserFollowing(context.Context, *GetUserFollowingRequest) (*GetUserFollowingResponse, error)
 	FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error)
 	UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error)
 	GetUserPosts(context.Context, *GetUserPostsRequest) (*GetUserPostsResponse, error)
+	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error)
+	GetFollowRequests(context.Context, *GetFollowRequestsRequest) (*GetFollowRequestsResponse, error)
+	ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*ApproveFollowRequestResponse, error)
+	RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*RejectFollowRequestResponse, error)
+	GetRelationship(context.Context, *GetRelationshipRequest) (*GetRelationshipResponse, error)
+	GetFollowSuggestions(context.Context, *GetFollowSuggestionsRequest) (*GetFollowSuggestionsResponse, error)
+	CreateAudienceList(context.Context, *CreateAudienceListRequest) (*CreateAudienceListResponse, error)
+	DeleteAudienceList(context.Context, *DeleteAudienceListRequest) (*DeleteAudienceListResponse, error)
+	GetAudienceLists(context.Context, *GetAudienceListsRequest) (*GetAudienceListsResponse, error)
+	GetAudienceList(context.Context, *GetAudienceListRequest) (*GetAudienceListResponse, error)
+	AddAudienceListMember(context.Context, *AddAudienceListMemberRequest) (*AddAudienceListMemberResponse, error)
+	RemoveAudienceListMember(context.Context, *RemoveAudienceListMemberRequest) (*RemoveAudienceListMemberResponse, error)
+	MuteUser(context.Context, *MuteUserRequest) (*MuteUserResponse, error)
+	UnmuteUser(context.Context, *UnmuteUserRequest) (*UnmuteUserResponse, error)
 	// Group: posts
 	CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
 	GetPostDetailInfo(context.Context, *GetPostDetailInfoRequest) (*GetPostDetailInfoResponse, error)
@@ -237,6 +718,19 @@ type AuthenticateAndPostServer interface {
 	CommentPost(context.Context, *CommentPostRequest) (*CommentPostResponse, error)
 	LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error)
 	GetS3PresignedUrl(context.Context, *GetS3PresignedUrlRequest) (*GetS3PresignedUrlResponse, error)
+	PinPost(context.Context, *PinPostRequest) (*PinPostResponse, error)
+	UnpinPost(context.Context, *UnpinPostRequest) (*UnpinPostResponse, error)
+	VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error)
+	GetPollResults(context.Context, *GetPollResultsRequest) (*GetPollResultsResponse, error)
+	SavePost(context.Context, *SavePostRequest) (*SavePostResponse, error)
+	UnsavePost(context.Context, *UnsavePostRequest) (*UnsavePostResponse, error)
+	ListSavedPosts(context.Context, *ListSavedPostsRequest) (*ListSavedPostsResponse, error)
+	// Group: stories
+	CreateStory(context.Context, *CreateStoryRequest) (*CreateStoryResponse, error)
+	GetStory(context.Context, *GetStoryRequest) (*GetStoryResponse, error)
+	DeleteStory(context.Context, *DeleteStoryRequest) (*DeleteStoryResponse, error)
+	GetStoriesTray(context.Context, *GetStoriesTrayRequest) (*GetStoriesTrayResponse, error)
+	GetStoryViewers(context.Context, *GetStoryViewersRequest) (*GetStoryViewersResponse, error)
 	mustEmbedUnimplementedAuthenticateAndPostServer()
 }
 
@@ -256,20 +750,107 @@ func (UnimplementedAuthenticateAndPostServer) EditUser(context.Context, *EditUse
 func (UnimplementedAuthenticateAndPostServer) GetUserDetailInfo(context.Context, *GetUserDetailInfoRequest) (*GetUserDetailInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserDetailInfo not implemented")
 }
-func (UnimplementedAuthenticateAndPostServer) GetUserFollower(context.Context, *GetUserFollowerRequest) (*GetUserFollowerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserFollower not implemented")
+func (UnimplementedAuthenticateAndPostServer) SendVerificationEmail(context.Context, *SendVerificationEmailRequest) (*SendVerificationEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendVerificationEmail not implemented")
 }
-func (UnimplementedAuthenticateAndPostServer) GetUserFollowing(context.Context, *GetUserFollowingRequest) (*GetUserFollowingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserFollowing not implemented")
+func (UnimplementedAuthenticateAndPostServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
 }
-func (UnimplementedAuthenticateAndPostServer) FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+func (UnimplementedAuthenticateAndPostServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
 }
-func (UnimplementedAuthenticateAndPostServer) UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+func (UnimplementedAuthenticateAndPostServer) ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*ConfirmPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPasswordReset not implemented")
 }
-func (UnimplementedAuthenticateAndPostServer) GetUserPosts(context.Context, *GetUserPostsRequest) (*GetUserPostsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserPosts not implemented")
+func (UnimplementedAuthenticateAndPostServer) EnrollTwoFactor(context.Context, *EnrollTwoFactorRequest) (*EnrollTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTwoFactor not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) EnableTwoFactor(context.Context, *EnableTwoFactorRequest) (*EnableTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableTwoFactor not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) DisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTwoFactor not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) VerifyTwoFactor(context.Context, *VerifyTwoFactorRequest) (*VerifyTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTwoFactor not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*RegenerateRecoveryCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) CancelUserDeletion(context.Context, *CancelUserDeletionRequest) (*CancelUserDeletionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUserDeletion not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDataExport not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetDataExport(context.Context, *GetDataExportRequest) (*GetDataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataExport not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetUserFollower(context.Context, *GetUserFollowerRequest) (*GetUserFollowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserFollower not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetUserFollowing(context.Context, *GetUserFollowingRequest) (*GetUserFollowingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserFollowing not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetUserPosts(context.Context, *GetUserPostsRequest) (*GetUserPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPosts not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetFollowRequests(context.Context, *GetFollowRequestsRequest) (*GetFollowRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowRequests not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*ApproveFollowRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFollowRequest not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*RejectFollowRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFollowRequest not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetRelationship(context.Context, *GetRelationshipRequest) (*GetRelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationship not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetFollowSuggestions(context.Context, *GetFollowSuggestionsRequest) (*GetFollowSuggestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowSuggestions not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) CreateAudienceList(context.Context, *CreateAudienceListRequest) (*CreateAudienceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAudienceList not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) DeleteAudienceList(context.Context, *DeleteAudienceListRequest) (*DeleteAudienceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAudienceList not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetAudienceLists(context.Context, *GetAudienceListsRequest) (*GetAudienceListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAudienceLists not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetAudienceList(context.Context, *GetAudienceListRequest) (*GetAudienceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAudienceList not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) AddAudienceListMember(context.Context, *AddAudienceListMemberRequest) (*AddAudienceListMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAudienceListMember not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) RemoveAudienceListMember(context.Context, *RemoveAudienceListMemberRequest) (*RemoveAudienceListMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAudienceListMember not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) MuteUser(context.Context, *MuteUserRequest) (*MuteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteUser not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) UnmuteUser(context.Context, *UnmuteUserRequest) (*UnmuteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteUser not implemented")
 }
 func (UnimplementedAuthenticateAndPostServer) CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
@@ -286,309 +867,1083 @@ func (UnimplementedAuthenticateAndPostServer) DeletePost(context.Context, *Delet
 func (UnimplementedAuthenticateAndPostServer) CommentPost(context.Context, *CommentPostRequest) (*CommentPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommentPost not implemented")
 }
-func (UnimplementedAuthenticateAndPostServer) LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LikePost not implemented")
+func (UnimplementedAuthenticateAndPostServer) LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikePost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetS3PresignedUrl(context.Context, *GetS3PresignedUrlRequest) (*GetS3PresignedUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetS3PresignedUrl not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) PinPost(context.Context, *PinPostRequest) (*PinPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinPost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) UnpinPost(context.Context, *UnpinPostRequest) (*UnpinPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinPost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotePoll not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetPollResults(context.Context, *GetPollResultsRequest) (*GetPollResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPollResults not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) SavePost(context.Context, *SavePostRequest) (*SavePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) UnsavePost(context.Context, *UnsavePostRequest) (*UnsavePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsavePost not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) ListSavedPosts(context.Context, *ListSavedPostsRequest) (*ListSavedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedPosts not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) CreateStory(context.Context, *CreateStoryRequest) (*CreateStoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStory not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetStory(context.Context, *GetStoryRequest) (*GetStoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStory not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) DeleteStory(context.Context, *DeleteStoryRequest) (*DeleteStoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStory not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetStoriesTray(context.Context, *GetStoriesTrayRequest) (*GetStoriesTrayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoriesTray not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) GetStoryViewers(context.Context, *GetStoryViewersRequest) (*GetStoryViewersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoryViewers not implemented")
+}
+func (UnimplementedAuthenticateAndPostServer) mustEmbedUnimplementedAuthenticateAndPostServer() {}
+
+// UnsafeAuthenticateAndPostServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthenticateAndPostServer will
+// result in compilation errors.
+type UnsafeAuthenticateAndPostServer interface {
+	mustEmbedUnimplementedAuthenticateAndPostServer()
+}
+
+func RegisterAuthenticateAndPostServer(s grpc.ServiceRegistrar, srv AuthenticateAndPostServer) {
+	s.RegisterService(&AuthenticateAndPost_ServiceDesc, srv)
+}
+
+func _AuthenticateAndPost_CheckUserAuthentication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckUserAuthenticationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).CheckUserAuthentication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_CheckUserAuthentication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).CheckUserAuthentication(ctx, req.(*CheckUserAuthenticationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_EditUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).EditUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_EditUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).EditUser(ctx, req.(*EditUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetUserDetailInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDetailInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetUserDetailInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_GetUserDetailInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetUserDetailInfo(ctx, req.(*GetUserDetailInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_SendVerificationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendVerificationEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).SendVerificationEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_SendVerificationEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).SendVerificationEmail(ctx, req.(*SendVerificationEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_ConfirmPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).ConfirmPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_ConfirmPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).ConfirmPasswordReset(ctx, req.(*ConfirmPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_EnrollTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).EnrollTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_EnrollTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).EnrollTwoFactor(ctx, req.(*EnrollTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_EnableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).EnableTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_EnableTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).EnableTwoFactor(ctx, req.(*EnableTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_DisableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).DisableTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_DisableTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).DisableTwoFactor(ctx, req.(*DisableTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_VerifyTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).VerifyTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_VerifyTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).VerifyTwoFactor(ctx, req.(*VerifyTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateRecoveryCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_CancelUserDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelUserDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).CancelUserDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_CancelUserDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).CancelUserDeletion(ctx, req.(*CancelUserDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_RequestDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).RequestDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_RequestDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).RequestDataExport(ctx, req.(*RequestDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_GetDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetDataExport(ctx, req.(*GetDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetUserFollower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserFollowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetUserFollower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_GetUserFollower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetUserFollower(ctx, req.(*GetUserFollowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetUserFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserFollowingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetUserFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_GetUserFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetUserFollowing(ctx, req.(*GetUserFollowingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).FollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_FollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).FollowUser(ctx, req.(*FollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).UnfollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_UnfollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).UnfollowUser(ctx, req.(*UnfollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetUserPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetUserPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_GetUserPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetUserPosts(ctx, req.(*GetUserPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).UnblockUser(ctx, req.(*UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetFollowRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_GetFollowRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetFollowRequests(ctx, req.(*GetFollowRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_ApproveFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).ApproveFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_ApproveFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).ApproveFollowRequest(ctx, req.(*ApproveFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_RejectFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).RejectFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_RejectFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).RejectFollowRequest(ctx, req.(*RejectFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_GetRelationship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetRelationship(ctx, req.(*GetRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetFollowSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetFollowSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_GetFollowSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetFollowSuggestions(ctx, req.(*GetFollowSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_CreateAudienceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAudienceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).CreateAudienceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_CreateAudienceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).CreateAudienceList(ctx, req.(*CreateAudienceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_DeleteAudienceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAudienceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).DeleteAudienceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_DeleteAudienceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).DeleteAudienceList(ctx, req.(*DeleteAudienceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetAudienceLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAudienceListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetAudienceLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_GetAudienceLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetAudienceLists(ctx, req.(*GetAudienceListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_GetAudienceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAudienceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetAudienceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_GetAudienceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetAudienceList(ctx, req.(*GetAudienceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_AddAudienceListMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAudienceListMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).AddAudienceListMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_AddAudienceListMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).AddAudienceListMember(ctx, req.(*AddAudienceListMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_RemoveAudienceListMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAudienceListMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).RemoveAudienceListMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_RemoveAudienceListMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).RemoveAudienceListMember(ctx, req.(*RemoveAudienceListMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_MuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).MuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_MuteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).MuteUser(ctx, req.(*MuteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticateAndPost_UnmuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).UnmuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_UnmuteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).UnmuteUser(ctx, req.(*UnmuteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAuthenticateAndPostServer) GetS3PresignedUrl(context.Context, *GetS3PresignedUrlRequest) (*GetS3PresignedUrlResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetS3PresignedUrl not implemented")
+
+func _AuthenticateAndPost_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).CreatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_CreatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).CreatePost(ctx, req.(*CreatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAuthenticateAndPostServer) mustEmbedUnimplementedAuthenticateAndPostServer() {}
 
-// UnsafeAuthenticateAndPostServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to AuthenticateAndPostServer will
-// result in compilation errors.
-type UnsafeAuthenticateAndPostServer interface {
-	mustEmbedUnimplementedAuthenticateAndPostServer()
+func _AuthenticateAndPost_GetPostDetailInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostDetailInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).GetPostDetailInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_GetPostDetailInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).GetPostDetailInfo(ctx, req.(*GetPostDetailInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAuthenticateAndPostServer(s grpc.ServiceRegistrar, srv AuthenticateAndPostServer) {
-	s.RegisterService(&AuthenticateAndPost_ServiceDesc, srv)
+func _AuthenticateAndPost_EditPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticateAndPostServer).EditPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticateAndPost_EditPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticateAndPostServer).EditPost(ctx, req.(*EditPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_CheckUserAuthentication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckUserAuthenticationRequest)
+func _AuthenticateAndPost_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).CheckUserAuthentication(ctx, in)
+		return srv.(AuthenticateAndPostServer).DeletePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthenticateAndPost_CheckUserAuthentication_FullMethodName,
+		FullMethod: AuthenticateAndPost_DeletePost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).CheckUserAuthentication(ctx, req.(*CheckUserAuthenticationRequest))
+		return srv.(AuthenticateAndPostServer).DeletePost(ctx, req.(*DeletePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _AuthenticateAndPost_CommentPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).CreateUser(ctx, in)
+		return srv.(AuthenticateAndPostServer).CommentPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthenticateAndPost_CreateUser_FullMethodName,
+		FullMethod: AuthenticateAndPost_CommentPost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(AuthenticateAndPostServer).CommentPost(ctx, req.(*CommentPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_EditUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EditUserRequest)
+func _AuthenticateAndPost_LikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).EditUser(ctx, in)
+		return srv.(AuthenticateAndPostServer).LikePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthenticateAndPost_EditUser_FullMethodName,
+		FullMethod: AuthenticateAndPost_LikePost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).EditUser(ctx, req.(*EditUserRequest))
+		return srv.(AuthenticateAndPostServer).LikePost(ctx, req.(*LikePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_GetUserDetailInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserDetailInfoRequest)
+func _AuthenticateAndPost_GetS3PresignedUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetS3PresignedUrlRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).GetUserDetailInfo(ctx, in)
+		return srv.(AuthenticateAndPostServer).GetS3PresignedUrl(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthenticateAndPost_GetUserDetailInfo_FullMethodName,
+		FullMethod: AuthenticateAndPost_GetS3PresignedUrl_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).GetUserDetailInfo(ctx, req.(*GetUserDetailInfoRequest))
+		return srv.(AuthenticateAndPostServer).GetS3PresignedUrl(ctx, req.(*GetS3PresignedUrlRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_GetUserFollower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserFollowerRequest)
+func _AuthenticateAndPost_PinPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).GetUserFollower(ctx, in)
+		return srv.(AuthenticateAndPostServer).PinPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthenticateAndPost_GetUserFollower_FullMethodName,
+		FullMethod: AuthenticateAndPost_PinPost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).GetUserFollower(ctx, req.(*GetUserFollowerRequest))
+		return srv.(AuthenticateAndPostServer).PinPost(ctx, req.(*PinPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_GetUserFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserFollowingRequest)
+func _AuthenticateAndPost_UnpinPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).GetUserFollowing(ctx, in)
+		return srv.(AuthenticateAndPostServer).UnpinPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthenticateAndPost_GetUserFollowing_FullMethodName,
+		FullMethod: AuthenticateAndPost_UnpinPost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).GetUserFollowing(ctx, req.(*GetUserFollowingRequest))
+		return srv.(AuthenticateAndPostServer).UnpinPost(ctx, req.(*UnpinPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FollowUserRequest)
+func _AuthenticateAndPost_VotePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotePollRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).FollowUser(ctx, in)
+		return srv.(AuthenticateAndPostServer).VotePoll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthenticateAndPost_FollowUser_FullMethodName,
+		FullMethod: AuthenticateAndPost_VotePoll_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).FollowUser(ctx, req.(*FollowUserRequest))
+		return srv.(AuthenticateAndPostServer).VotePoll(ctx, req.(*VotePollRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnfollowUserRequest)
+func _AuthenticateAndPost_GetPollResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPollResultsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).UnfollowUser(ctx, in)
+		return srv.(AuthenticateAndPostServer).GetPollResults(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthenticateAndPost_UnfollowUser_FullMethodName,
+		FullMethod: AuthenticateAndPost_GetPollResults_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).UnfollowUser(ctx, req.(*UnfollowUserRequest))
+		return srv.(AuthenticateAndPostServer).GetPollResults(ctx, req.(*GetPollResultsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_GetUserPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserPostsRequest)
+func _AuthenticateAndPost_SavePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).GetUserPosts(ctx, in)
+		return srv.(AuthenticateAndPostServer).SavePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthenticateAndPost_GetUserPosts_FullMethodName,
+		FullMethod: AuthenticateAndPost_SavePost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).GetUserPosts(ctx, req.(*GetUserPostsRequest))
+		return srv.(AuthenticateAndPostServer).SavePost(ctx, req.(*SavePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePostRequest)
+func _AuthenticateAndPost_UnsavePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsavePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).CreatePost(ctx, in)
+		return srv.(AuthenticateAndPostServer).UnsavePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthenticateAndPost_CreatePost_FullMethodName,
+		FullMethod: AuthenticateAndPost_UnsavePost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).CreatePost(ctx, req.(*CreatePostRequest))
+		return srv.(AuthenticateAndPostServer).UnsavePost(ctx, req.(*UnsavePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_GetPostDetailInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPostDetailInfoRequest)
+func _AuthenticateAndPost_ListSavedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedPostsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).GetPostDetailInfo(ctx, in)
+		return srv.(AuthenticateAndPostServer).ListSavedPosts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthenticateAndPost_GetPostDetailInfo_FullMethodName,
+		FullMethod: AuthenticateAndPost_ListSavedPosts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).GetPostDetailInfo(ctx, req.(*GetPostDetailInfoRequest))
+		return srv.(AuthenticateAndPostServer).ListSavedPosts(ctx, req.(*ListSavedPostsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_EditPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EditPostRequest)
+func _AuthenticateAndPost_CreateStory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).EditPost(ctx, in)
+		return srv.(AuthenticateAndPostServer).CreateStory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthenticateAndPost_EditPost_FullMethodName,
+		FullMethod: AuthenticateAndPost_CreateStory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).EditPost(ctx, req.(*EditPostRequest))
+		return srv.(AuthenticateAndPostServer).CreateStory(ctx, req.(*CreateStoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePostRequest)
+func _AuthenticateAndPost_GetStory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).DeletePost(ctx, in)
+		return srv.(AuthenticateAndPostServer).GetStory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthenticateAndPost_DeletePost_FullMethodName,
+		FullMethod: AuthenticateAndPost_GetStory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).DeletePost(ctx, req.(*DeletePostRequest))
+		return srv.(AuthenticateAndPostServer).GetStory(ctx, req.(*GetStoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_CommentPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CommentPostRequest)
+func _AuthenticateAndPost_DeleteStory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).CommentPost(ctx, in)
+		return srv.(AuthenticateAndPostServer).DeleteStory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthenticateAndPost_CommentPost_FullMethodName,
+		FullMethod: AuthenticateAndPost_DeleteStory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).CommentPost(ctx, req.(*CommentPostRequest))
+		return srv.(AuthenticateAndPostServer).DeleteStory(ctx, req.(*DeleteStoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_LikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LikePostRequest)
+func _AuthenticateAndPost_GetStoriesTray_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoriesTrayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).LikePost(ctx, in)
+		return srv.(AuthenticateAndPostServer).GetStoriesTray(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthenticateAndPost_LikePost_FullMethodName,
+		FullMethod: AuthenticateAndPost_GetStoriesTray_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).LikePost(ctx, req.(*LikePostRequest))
+		return srv.(AuthenticateAndPostServer).GetStoriesTray(ctx, req.(*GetStoriesTrayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AuthenticateAndPost_GetS3PresignedUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetS3PresignedUrlRequest)
+func _AuthenticateAndPost_GetStoryViewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoryViewersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthenticateAndPostServer).GetS3PresignedUrl(ctx, in)
+		return srv.(AuthenticateAndPostServer).GetStoryViewers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AuthenticateAndPost_GetS3PresignedUrl_FullMethodName,
+		FullMethod: AuthenticateAndPost_GetStoryViewers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthenticateAndPostServer).GetS3PresignedUrl(ctx, req.(*GetS3PresignedUrlRequest))
+		return srv.(AuthenticateAndPostServer).GetStoryViewers(ctx, req.(*GetStoryViewersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -616,6 +1971,62 @@ var AuthenticateAndPost_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserDetailInfo",
 			Handler:    _AuthenticateAndPost_GetUserDetailInfo_Handler,
 		},
+		{
+			MethodName: "SendVerificationEmail",
+			Handler:    _AuthenticateAndPost_SendVerificationEmail_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _AuthenticateAndPost_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _AuthenticateAndPost_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ConfirmPasswordReset",
+			Handler:    _AuthenticateAndPost_ConfirmPasswordReset_Handler,
+		},
+		{
+			MethodName: "EnrollTwoFactor",
+			Handler:    _AuthenticateAndPost_EnrollTwoFactor_Handler,
+		},
+		{
+			MethodName: "EnableTwoFactor",
+			Handler:    _AuthenticateAndPost_EnableTwoFactor_Handler,
+		},
+		{
+			MethodName: "DisableTwoFactor",
+			Handler:    _AuthenticateAndPost_DisableTwoFactor_Handler,
+		},
+		{
+			MethodName: "VerifyTwoFactor",
+			Handler:    _AuthenticateAndPost_VerifyTwoFactor_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _AuthenticateAndPost_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _AuthenticateAndPost_DeleteUser_Handler,
+		},
+		{
+			MethodName: "CancelUserDeletion",
+			Handler:    _AuthenticateAndPost_CancelUserDeletion_Handler,
+		},
+		{
+			MethodName: "RequestDataExport",
+			Handler:    _AuthenticateAndPost_RequestDataExport_Handler,
+		},
+		{
+			MethodName: "GetDataExport",
+			Handler:    _AuthenticateAndPost_GetDataExport_Handler,
+		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _AuthenticateAndPost_SearchUsers_Handler,
+		},
 		{
 			MethodName: "GetUserFollower",
 			Handler:    _AuthenticateAndPost_GetUserFollower_Handler,
@@ -636,6 +2047,66 @@ var AuthenticateAndPost_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserPosts",
 			Handler:    _AuthenticateAndPost_GetUserPosts_Handler,
 		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _AuthenticateAndPost_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _AuthenticateAndPost_UnblockUser_Handler,
+		},
+		{
+			MethodName: "GetFollowRequests",
+			Handler:    _AuthenticateAndPost_GetFollowRequests_Handler,
+		},
+		{
+			MethodName: "ApproveFollowRequest",
+			Handler:    _AuthenticateAndPost_ApproveFollowRequest_Handler,
+		},
+		{
+			MethodName: "RejectFollowRequest",
+			Handler:    _AuthenticateAndPost_RejectFollowRequest_Handler,
+		},
+		{
+			MethodName: "GetRelationship",
+			Handler:    _AuthenticateAndPost_GetRelationship_Handler,
+		},
+		{
+			MethodName: "GetFollowSuggestions",
+			Handler:    _AuthenticateAndPost_GetFollowSuggestions_Handler,
+		},
+		{
+			MethodName: "CreateAudienceList",
+			Handler:    _AuthenticateAndPost_CreateAudienceList_Handler,
+		},
+		{
+			MethodName: "DeleteAudienceList",
+			Handler:    _AuthenticateAndPost_DeleteAudienceList_Handler,
+		},
+		{
+			MethodName: "GetAudienceLists",
+			Handler:    _AuthenticateAndPost_GetAudienceLists_Handler,
+		},
+		{
+			MethodName: "GetAudienceList",
+			Handler:    _AuthenticateAndPost_GetAudienceList_Handler,
+		},
+		{
+			MethodName: "AddAudienceListMember",
+			Handler:    _AuthenticateAndPost_AddAudienceListMember_Handler,
+		},
+		{
+			MethodName: "RemoveAudienceListMember",
+			Handler:    _AuthenticateAndPost_RemoveAudienceListMember_Handler,
+		},
+		{
+			MethodName: "MuteUser",
+			Handler:    _AuthenticateAndPost_MuteUser_Handler,
+		},
+		{
+			MethodName: "UnmuteUser",
+			Handler:    _AuthenticateAndPost_UnmuteUser_Handler,
+		},
 		{
 			MethodName: "CreatePost",
 			Handler:    _AuthenticateAndPost_CreatePost_Handler,
@@ -664,6 +2135,54 @@ var AuthenticateAndPost_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetS3PresignedUrl",
 			Handler:    _AuthenticateAndPost_GetS3PresignedUrl_Handler,
 		},
+		{
+			MethodName: "PinPost",
+			Handler:    _AuthenticateAndPost_PinPost_Handler,
+		},
+		{
+			MethodName: "UnpinPost",
+			Handler:    _AuthenticateAndPost_UnpinPost_Handler,
+		},
+		{
+			MethodName: "VotePoll",
+			Handler:    _AuthenticateAndPost_VotePoll_Handler,
+		},
+		{
+			MethodName: "GetPollResults",
+			Handler:    _AuthenticateAndPost_GetPollResults_Handler,
+		},
+		{
+			MethodName: "SavePost",
+			Handler:    _AuthenticateAndPost_SavePost_Handler,
+		},
+		{
+			MethodName: "UnsavePost",
+			Handler:    _AuthenticateAndPost_UnsavePost_Handler,
+		},
+		{
+			MethodName: "ListSavedPosts",
+			Handler:    _AuthenticateAndPost_ListSavedPosts_Handler,
+		},
+		{
+			MethodName: "CreateStory",
+			Handler:    _AuthenticateAndPost_CreateStory_Handler,
+		},
+		{
+			MethodName: "GetStory",
+			Handler:    _AuthenticateAndPost_GetStory_Handler,
+		},
+		{
+			MethodName: "DeleteStory",
+			Handler:    _AuthenticateAndPost_DeleteStory_Handler,
+		},
+		{
+			MethodName: "GetStoriesTray",
+			Handler:    _AuthenticateAndPost_GetStoriesTray_Handler,
+		},
+		{
+			MethodName: "GetStoryViewers",
+			Handler:    _AuthenticateAndPost_GetStoryViewers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "authen_and_post.proto",
@@ -0,0 +1,3 @@
+USE engineerpro;
+
+DROP TABLE IF EXISTS `pinned_post`;
@@ -0,0 +1,12 @@
+-- Use the database
+USE engineerpro;
+
+-- Create the pinned_post table
+CREATE TABLE IF NOT EXISTS `pinned_post` (
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    user_id BIGINT NOT NULL,
+    post_id BIGINT NOT NULL,
+    PRIMARY KEY (user_id, post_id),
+    FOREIGN KEY (user_id) REFERENCES `user`(id),
+    FOREIGN KEY (post_id) REFERENCES `post`(id)
+);
@@ -0,0 +1,9 @@
+USE engineerpro;
+
+DROP TABLE IF EXISTS `poll_vote_option`;
+
+DROP TABLE IF EXISTS `poll_vote`;
+
+DROP TABLE IF EXISTS `poll_option`;
+
+DROP TABLE IF EXISTS `poll`;
@@ -0,0 +1,43 @@
+-- Use the database
+USE engineerpro;
+
+-- Create the poll table, a post has at most one poll
+CREATE TABLE IF NOT EXISTS `poll` (
+    post_id BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    multiple_choice BOOLEAN NOT NULL DEFAULT FALSE,
+    closes_at TIMESTAMP NULL,
+    PRIMARY KEY (post_id),
+    FOREIGN KEY (post_id) REFERENCES `post`(id)
+);
+
+-- Create the poll_option table
+CREATE TABLE IF NOT EXISTS `poll_option` (
+    id BIGINT AUTO_INCREMENT,
+    post_id BIGINT NOT NULL,
+    position INT NOT NULL,
+    content_text VARCHAR(200) NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE INDEX idx_poll_option_position (post_id, position),
+    FOREIGN KEY (post_id) REFERENCES `poll`(post_id)
+);
+
+-- Create the poll_vote table, one row per user and poll
+CREATE TABLE IF NOT EXISTS `poll_vote` (
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    post_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    PRIMARY KEY (post_id, user_id),
+    FOREIGN KEY (post_id) REFERENCES `poll`(post_id),
+    FOREIGN KEY (user_id) REFERENCES `user`(id)
+);
+
+-- Create the poll_vote_option table holding the options chosen in a vote
+CREATE TABLE IF NOT EXISTS `poll_vote_option` (
+    post_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    option_id BIGINT NOT NULL,
+    PRIMARY KEY (post_id, user_id, option_id),
+    FOREIGN KEY (post_id, user_id) REFERENCES `poll_vote`(post_id, user_id),
+    FOREIGN KEY (option_id) REFERENCES `poll_option`(id)
+);
@@ -0,0 +1,14 @@
+-- Use the database
+USE engineerpro;
+
+-- Create the bookmark table
+CREATE TABLE IF NOT EXISTS `bookmark` (
+    id BIGINT AUTO_INCREMENT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    user_id BIGINT NOT NULL,
+    post_id BIGINT NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE INDEX idx_bookmark_user_post (user_id, post_id),
+    FOREIGN KEY (user_id) REFERENCES `user`(id),
+    FOREIGN KEY (post_id) REFERENCES `post`(id)
+);
@@ -0,0 +1,3 @@
+USE engineerpro;
+
+DROP TABLE IF EXISTS `link_preview`;
@@ -0,0 +1,14 @@
+-- Use the database
+USE engineerpro;
+
+-- Create the link_preview table
+CREATE TABLE IF NOT EXISTS `link_preview` (
+    post_id BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    url VARCHAR(2000) NOT NULL,
+    title VARCHAR(300),
+    description VARCHAR(1000),
+    image_url VARCHAR(1000),
+    PRIMARY KEY (post_id),
+    FOREIGN KEY (post_id) REFERENCES `post`(id)
+);
@@ -0,0 +1,5 @@
+USE engineerpro;
+
+DROP TABLE IF EXISTS `story_view`;
+
+DROP TABLE IF EXISTS `story`;
@@ -0,0 +1,26 @@
+-- Use the database
+USE engineerpro;
+
+-- Create the story table, stories are deleted after they expire
+CREATE TABLE IF NOT EXISTS `story` (
+    id BIGINT AUTO_INCREMENT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    user_id BIGINT NOT NULL,
+    content_text VARCHAR(1000),
+    content_image_path VARCHAR(1000),
+    PRIMARY KEY (id),
+    INDEX idx_story_user_expires_at (user_id, expires_at),
+    INDEX idx_story_expires_at (expires_at),
+    FOREIGN KEY (user_id) REFERENCES `user`(id)
+);
+
+-- Create the story_view table, one row per viewer and story
+CREATE TABLE IF NOT EXISTS `story_view` (
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    story_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    PRIMARY KEY (story_id, user_id),
+    FOREIGN KEY (story_id) REFERENCES `story`(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES `user`(id)
+);
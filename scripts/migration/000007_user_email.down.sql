@@ -0,0 +1,5 @@
+USE engineerpro;
+
+ALTER TABLE `user`
+    DROP INDEX idx_user_email,
+    DROP COLUMN email_verified_at;
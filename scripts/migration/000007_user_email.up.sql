@@ -0,0 +1,17 @@
+-- Use the database
+USE engineerpro;
+
+-- Emails are compared lowercased and trimmed
+UPDATE `user` SET email = LOWER(TRIM(email));
+
+-- The oldest account keeps a duplicated email, the others and accounts without email get a unique
+-- placeholder address of the reserved .invalid domain and have to change it to receive emails
+UPDATE `user` u
+    JOIN (SELECT email, MIN(id) AS id FROM `user` GROUP BY email) kept ON kept.email = u.email
+SET u.email = CONCAT('user-', u.id, '@duplicate.invalid')
+WHERE u.id <> kept.id OR u.email = '';
+
+-- Emails are unique and verified by a link sent after signing up
+ALTER TABLE `user`
+    ADD COLUMN email_verified_at TIMESTAMP NULL AFTER email,
+    ADD UNIQUE INDEX idx_user_email (email);
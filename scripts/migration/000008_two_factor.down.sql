@@ -0,0 +1,5 @@
+USE engineerpro;
+
+DROP TABLE IF EXISTS `recovery_code`;
+
+DROP TABLE IF EXISTS `two_factor`;
@@ -0,0 +1,25 @@
+-- Use the database
+USE engineerpro;
+
+-- Create the two_factor table, two-factor authentication is enabled once enabled_at is set
+CREATE TABLE IF NOT EXISTS `two_factor` (
+    user_id BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    secret VARCHAR(100) NOT NULL,
+    enabled_at TIMESTAMP NULL,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (user_id),
+    FOREIGN KEY (user_id) REFERENCES `user`(id)
+);
+
+-- Create the recovery_code table, only sha256 of the codes are stored
+CREATE TABLE IF NOT EXISTS `recovery_code` (
+    id BIGINT AUTO_INCREMENT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    user_id BIGINT NOT NULL,
+    code_hash VARCHAR(64) NOT NULL,
+    used_at TIMESTAMP NULL,
+    PRIMARY KEY (id),
+    INDEX idx_recovery_code_user_id (user_id),
+    FOREIGN KEY (user_id) REFERENCES `user`(id)
+);
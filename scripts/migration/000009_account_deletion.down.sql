@@ -0,0 +1,5 @@
+USE engineerpro;
+
+ALTER TABLE `user`
+    DROP INDEX idx_user_deletion_scheduled_at,
+    DROP COLUMN deletion_scheduled_at;
@@ -0,0 +1,7 @@
+-- Use the database
+USE engineerpro;
+
+-- Accounts are purged once deletion_scheduled_at has passed
+ALTER TABLE `user`
+    ADD COLUMN deletion_scheduled_at TIMESTAMP NULL,
+    ADD INDEX idx_user_deletion_scheduled_at (deletion_scheduled_at);
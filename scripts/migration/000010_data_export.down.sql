@@ -0,0 +1,3 @@
+USE engineerpro;
+
+DROP TABLE IF EXISTS `data_export`;
@@ -0,0 +1,15 @@
+-- Use the database
+USE engineerpro;
+
+-- Create the data_export table, the latest personal data archive of each user
+CREATE TABLE IF NOT EXISTS `data_export` (
+    user_id BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    status VARCHAR(20) NOT NULL,
+    object_key VARCHAR(200),
+    completed_at TIMESTAMP NULL,
+    expires_at TIMESTAMP NULL,
+    PRIMARY KEY (user_id),
+    INDEX idx_data_export_status (status),
+    FOREIGN KEY (user_id) REFERENCES `user`(id)
+);
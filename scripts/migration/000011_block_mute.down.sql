@@ -0,0 +1,5 @@
+USE engineerpro;
+
+DROP TABLE IF EXISTS `mute`;
+
+DROP TABLE IF EXISTS `block`;
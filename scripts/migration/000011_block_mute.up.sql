@@ -0,0 +1,23 @@
+-- Use the database
+USE engineerpro;
+
+-- Create the block table, blocks work both ways
+CREATE TABLE IF NOT EXISTS `block` (
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    user_id BIGINT NOT NULL,
+    blocked_user_id BIGINT NOT NULL,
+    PRIMARY KEY (user_id, blocked_user_id),
+    INDEX idx_block_blocked_user_id (blocked_user_id),
+    FOREIGN KEY (user_id) REFERENCES `user`(id),
+    FOREIGN KEY (blocked_user_id) REFERENCES `user`(id)
+);
+
+-- Create the mute table
+CREATE TABLE IF NOT EXISTS `mute` (
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    user_id BIGINT NOT NULL,
+    muted_user_id BIGINT NOT NULL,
+    PRIMARY KEY (user_id, muted_user_id),
+    FOREIGN KEY (user_id) REFERENCES `user`(id),
+    FOREIGN KEY (muted_user_id) REFERENCES `user`(id)
+);
@@ -0,0 +1,5 @@
+USE engineerpro;
+
+DROP TABLE IF EXISTS `follow_request`;
+
+ALTER TABLE `user` DROP COLUMN is_private;
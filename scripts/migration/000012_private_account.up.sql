@@ -0,0 +1,15 @@
+-- Use the database
+USE engineerpro;
+
+ALTER TABLE `user` ADD COLUMN is_private BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Create the follow_request table, pending requests to follow private accounts
+CREATE TABLE IF NOT EXISTS `follow_request` (
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    user_id BIGINT NOT NULL,
+    follower_id BIGINT NOT NULL,
+    PRIMARY KEY (user_id, follower_id),
+    INDEX idx_follow_request_follower_id (follower_id),
+    FOREIGN KEY (user_id) REFERENCES `user`(id),
+    FOREIGN KEY (follower_id) REFERENCES `user`(id)
+);
@@ -0,0 +1,6 @@
+USE engineerpro;
+
+ALTER TABLE `user`
+    DROP COLUMN followers_count,
+    DROP COLUMN followings_count,
+    DROP COLUMN posts_count;
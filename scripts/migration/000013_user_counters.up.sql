@@ -0,0 +1,14 @@
+-- Use the database
+USE engineerpro;
+
+-- Counters are kept up to date by the service instead of counting full lists
+ALTER TABLE `user`
+    ADD COLUMN followers_count BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN followings_count BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN posts_count BIGINT NOT NULL DEFAULT 0;
+
+-- Users follow themselves so that their posts are in their newsfeed, this edge is not counted
+UPDATE `user` SET
+    followers_count = (SELECT COUNT(*) FROM following WHERE following.user_id = `user`.id AND following.follower_id != `user`.id),
+    followings_count = (SELECT COUNT(*) FROM following WHERE following.follower_id = `user`.id AND following.user_id != `user`.id),
+    posts_count = (SELECT COUNT(*) FROM post WHERE post.user_id = `user`.id AND post.deleted_at IS NULL);
@@ -0,0 +1,9 @@
+USE engineerpro;
+
+ALTER TABLE `post`
+    DROP FOREIGN KEY fk_post_audience_list_id,
+    DROP COLUMN audience_list_id;
+
+DROP TABLE IF EXISTS `audience_list_member`;
+
+DROP TABLE IF EXISTS `audience_list`;
@@ -0,0 +1,30 @@
+-- Use the database
+USE engineerpro;
+
+-- Create the audience_list table, named lists of users a post can be shared with
+CREATE TABLE IF NOT EXISTS `audience_list` (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    user_id BIGINT NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    INDEX idx_audience_list_user_id (user_id),
+    FOREIGN KEY (user_id) REFERENCES `user`(id)
+);
+
+-- Create the audience_list_member table
+CREATE TABLE IF NOT EXISTS `audience_list_member` (
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    audience_list_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    PRIMARY KEY (audience_list_id, user_id),
+    INDEX idx_audience_list_member_user_id (user_id),
+    FOREIGN KEY (audience_list_id) REFERENCES `audience_list`(id),
+    FOREIGN KEY (user_id) REFERENCES `user`(id)
+);
+
+-- Posts with an audience list are only visible to its members
+ALTER TABLE `post`
+    ADD COLUMN audience_list_id BIGINT NULL,
+    ADD CONSTRAINT fk_post_audience_list_id FOREIGN KEY (audience_list_id) REFERENCES `audience_list`(id);
@@ -0,0 +1,9 @@
+USE engineerpro;
+
+DROP TABLE IF EXISTS `user_name_history`;
+
+ALTER TABLE `user`
+    DROP COLUMN bio,
+    DROP COLUMN location,
+    DROP COLUMN website,
+    DROP COLUMN pronouns;
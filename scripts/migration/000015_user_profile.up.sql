@@ -0,0 +1,20 @@
+-- Use the database
+USE engineerpro;
+
+-- Profile fields shown on the profile page
+ALTER TABLE `user`
+    ADD COLUMN bio VARCHAR(500) NOT NULL DEFAULT '',
+    ADD COLUMN location VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN website VARCHAR(500) NOT NULL DEFAULT '',
+    ADD COLUMN pronouns VARCHAR(50) NOT NULL DEFAULT '';
+
+-- Create the user_name_history table, previous user names redirect to their user for a grace period
+CREATE TABLE IF NOT EXISTS `user_name_history` (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    user_id BIGINT NOT NULL,
+    user_name VARCHAR(50) NOT NULL,
+    INDEX idx_user_name_history_user_id (user_id),
+    INDEX idx_user_name_history_user_name (user_name),
+    FOREIGN KEY (user_id) REFERENCES `user`(id)
+);
@@ -0,0 +1,9 @@
+USE engineerpro;
+
+ALTER TABLE `user`
+    DROP COLUMN email_visibility,
+    DROP COLUMN date_of_birth_visibility,
+    DROP COLUMN bio_visibility,
+    DROP COLUMN location_visibility,
+    DROP COLUMN website_visibility,
+    DROP COLUMN pronouns_visibility;
@@ -0,0 +1,11 @@
+-- Use the database
+USE engineerpro;
+
+-- Who can see each profile field: public, followers or only_me
+ALTER TABLE `user`
+    ADD COLUMN email_visibility VARCHAR(20) NOT NULL DEFAULT 'only_me',
+    ADD COLUMN date_of_birth_visibility VARCHAR(20) NOT NULL DEFAULT 'only_me',
+    ADD COLUMN bio_visibility VARCHAR(20) NOT NULL DEFAULT 'public',
+    ADD COLUMN location_visibility VARCHAR(20) NOT NULL DEFAULT 'public',
+    ADD COLUMN website_visibility VARCHAR(20) NOT NULL DEFAULT 'public',
+    ADD COLUMN pronouns_visibility VARCHAR(20) NOT NULL DEFAULT 'public';
@@ -0,0 +1,7 @@
+USE engineerpro;
+
+DROP TABLE IF EXISTS `message`;
+
+DROP TABLE IF EXISTS `conversation_member`;
+
+DROP TABLE IF EXISTS `conversation`;
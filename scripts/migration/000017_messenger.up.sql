@@ -0,0 +1,43 @@
+-- Use the database
+USE engineerpro;
+
+-- Create the conversation table, a conversation between two users with first_user_id < second_user_id
+CREATE TABLE IF NOT EXISTS `conversation` (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    first_user_id BIGINT NOT NULL,
+    second_user_id BIGINT NOT NULL,
+    last_message_id BIGINT NOT NULL DEFAULT 0,
+    UNIQUE INDEX idx_conversation_users (first_user_id, second_user_id),
+    INDEX idx_conversation_second_user_id (second_user_id),
+    FOREIGN KEY (first_user_id) REFERENCES `user`(id),
+    FOREIGN KEY (second_user_id) REFERENCES `user`(id)
+);
+
+-- Create the conversation_member table, the state of a conversation for each of its users
+CREATE TABLE IF NOT EXISTS `conversation_member` (
+    conversation_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    last_read_message_id BIGINT NOT NULL DEFAULT 0,
+    -- Messages up to this one were deleted by the user, the conversation is hidden until a newer message
+    cleared_message_id BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (conversation_id, user_id),
+    INDEX idx_conversation_member_user_id (user_id),
+    FOREIGN KEY (conversation_id) REFERENCES `conversation`(id),
+    FOREIGN KEY (user_id) REFERENCES `user`(id)
+);
+
+-- Create the message table
+CREATE TABLE IF NOT EXISTS `message` (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    conversation_id BIGINT NOT NULL,
+    sender_id BIGINT NOT NULL,
+    content_text TEXT NOT NULL,
+    INDEX idx_message_conversation_id (conversation_id, id),
+    FOREIGN KEY (conversation_id) REFERENCES `conversation`(id),
+    FOREIGN KEY (sender_id) REFERENCES `user`(id)
+);
@@ -0,0 +1,30 @@
+-- Use the database
+USE engineerpro;
+
+-- Create the uploaded_image table, the S3 keys handed out to each user for uploading images.
+-- Images are only deleted on behalf of the user who uploaded them.
+CREATE TABLE IF NOT EXISTS `uploaded_image` (
+    s3_key VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    user_id BIGINT NOT NULL,
+    PRIMARY KEY (s3_key),
+    INDEX idx_uploaded_image_user_id (user_id)
+);
+
+-- Images uploaded before this table existed belong to the first user who referenced them,
+-- otherwise they would never be deleted. Paths of a post are separated by spaces.
+INSERT IGNORE INTO `uploaded_image` (s3_key, created_at, user_id)
+SELECT image.s3_key, image.created_at, image.user_id FROM (
+    SELECT SUBSTRING_INDEX(paths.path, '/', -1) AS s3_key, post.created_at, post.user_id
+    FROM post, JSON_TABLE(CONCAT('["', REPLACE(post.content_image_path, ' ', '","'), '"]'),
+        '$[*]' COLUMNS (path VARCHAR(1000) PATH '$')) AS paths
+    WHERE post.content_image_path != ''
+    UNION ALL
+    SELECT SUBSTRING_INDEX(content_image_path, '/', -1), created_at, user_id FROM story WHERE content_image_path != ''
+    UNION ALL
+    SELECT SUBSTRING_INDEX(profile_picture, '/', -1), created_at, id FROM `user` WHERE profile_picture != ''
+    UNION ALL
+    SELECT SUBSTRING_INDEX(cover_picture, '/', -1), created_at, id FROM `user` WHERE cover_picture != ''
+) AS image
+WHERE CHAR_LENGTH(image.s3_key) BETWEEN 1 AND 100
+ORDER BY image.created_at;